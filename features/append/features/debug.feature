@@ -9,33 +9,33 @@ Feature: display debug statistics
   Scenario: result
     When I run "git-town append new --debug"
     Then it runs the commands
-      | BRANCH   | TYPE     | COMMAND                                              |
-      |          | backend  | git version                                          |
-      |          | backend  | git config -lz --global                              |
-      |          | backend  | git config -lz --local                               |
-      |          | backend  | git rev-parse --show-toplevel                        |
-      |          | backend  | git remote                                           |
-      |          | backend  | git status                                           |
-      |          | backend  | git rev-parse --abbrev-ref HEAD                      |
-      | existing | frontend | git fetch --prune --tags                             |
-      |          | backend  | git branch -vva                                      |
-      |          | backend  | git rev-parse --verify --abbrev-ref @{-1}            |
-      |          | backend  | git status --porcelain --ignore-submodules           |
-      | existing | frontend | git checkout main                                    |
-      |          | backend  | git rev-parse --short HEAD                           |
-      | main     | frontend | git rebase origin/main                               |
-      |          | backend  | git rev-list --left-right main...origin/main         |
-      | main     | frontend | git checkout existing                                |
-      |          | backend  | git rev-parse --short HEAD                           |
-      | existing | frontend | git merge --no-edit origin/existing                  |
-      |          | backend  | git rev-parse --short HEAD                           |
-      | existing | frontend | git merge --no-edit main                             |
-      |          | backend  | git rev-list --left-right existing...origin/existing |
-      | existing | frontend | git branch new existing                              |
-      |          | backend  | git config git-town-branch.new.parent existing       |
-      | existing | frontend | git checkout new                                     |
-      |          | backend  | git show-ref --quiet refs/heads/existing             |
-      |          | backend  | git rev-parse --verify --abbrev-ref @{-1}            |
+      | BRANCH   | TYPE     | COMMAND                                                                                                                                                             |
+      |          | backend  | git version                                                                                                                                                         |
+      |          | backend  | git config -lz --global                                                                                                                                             |
+      |          | backend  | git config -lz --local                                                                                                                                              |
+      |          | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |          | backend  | git remote                                                                                                                                                          |
+      |          | backend  | git status                                                                                                                                                          |
+      |          | backend  | git rev-parse --abbrev-ref HEAD                                                                                                                                     |
+      | existing | frontend | git fetch --prune --tags                                                                                                                                            |
+      |          | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |          | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |          | backend  | git status --porcelain --ignore-submodules                                                                                                                          |
+      | existing | frontend | git checkout main                                                                                                                                                   |
+      |          | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | main     | frontend | git rebase origin/main                                                                                                                                              |
+      |          | backend  | git rev-list --left-right main...origin/main                                                                                                                        |
+      | main     | frontend | git checkout existing                                                                                                                                               |
+      |          | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | existing | frontend | git merge --no-edit origin/existing                                                                                                                                 |
+      |          | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | existing | frontend | git merge --no-edit main                                                                                                                                            |
+      |          | backend  | git rev-list --left-right existing...origin/existing                                                                                                                |
+      | existing | frontend | git branch new existing                                                                                                                                             |
+      |          | backend  | git config git-town-branch.new.parent existing                                                                                                                      |
+      | existing | frontend | git checkout new                                                                                                                                                    |
+      |          | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/                                                                                                                            |
+      |          | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
     And it prints:
       """
       Ran 26 shell commands.
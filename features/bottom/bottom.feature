@@ -0,0 +1,37 @@
+Feature: switch to the first branch in the stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+
+  Scenario: top of the stack
+    Given the current branch is "gamma"
+    When I run "git-town bottom"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | gamma  | git checkout alpha |
+    And the current branch is now "alpha"
+    And the previous Git branch is now "gamma"
+
+  Scenario: with uncommitted changes
+    Given the current branch is "gamma"
+    And an uncommitted file
+    When I run "git-town bottom"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | gamma  | git add -A         |
+      |        | git stash          |
+      |        | git checkout alpha |
+      | alpha  | git stash pop      |
+    And the current branch is now "alpha"
+    And the uncommitted file still exists
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town bottom"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "main" is not part of a stack of feature branches
+      """
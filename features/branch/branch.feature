@@ -0,0 +1,64 @@
+Feature: display the branch hierarchy with sync status
+
+  Background:
+    Given a perennial branch "production"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a local feature branch "gamma"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | main commit  |
+      | alpha  | local         | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And the current branch is "beta"
+
+  Scenario: text output
+    When I run "git-town branch"
+    Then it prints:
+      """
+      main (main)  [up to date]
+          alpha  [1 ahead of origin/alpha, 1 ahead of main, 1 behind main, needs sync]
+      *     beta  [up to date, 1 ahead of alpha, 1 behind alpha, needs sync]
+          gamma  [no tracking branch, 0 ahead of main, 1 behind main, needs sync]
+        production (perennial)  [up to date]
+      """
+
+  Scenario: JSON output
+    When I run "git-town branch --json"
+    Then it prints:
+      """
+                  "isCurrent": true,
+                  "isMain": false,
+                  "isPerennial": false,
+                  "name": "beta",
+                  "needsSync": true,
+                  "parent": "alpha",
+                  "proposal": null,
+                  "syncStatus": "up to date",
+                  "trackingBranch": "origin/beta"
+      """
+
+  Scenario: branch with an unknown parent
+    Given a branch "other"
+    When I run "git-town branch"
+    Then it prints:
+      """
+      main (main)  [up to date]
+          alpha  [1 ahead of origin/alpha, 1 ahead of main, 1 behind main, needs sync]
+      *     beta  [up to date, 1 ahead of alpha, 1 behind alpha, needs sync]
+          gamma  [no tracking branch, 0 ahead of main, 1 behind main, needs sync]
+        production (perennial)  [up to date]
+        other  [no tracking branch, needs sync]
+      """
+
+  Scenario: Git Town is not configured
+    Given Git Town is not configured
+    When I run "git-town branch --json"
+    Then it prints:
+      """
+          "isMain": false,
+          "isPerennial": false,
+          "name": "main",
+          "needsSync": false,
+          "parent": "",
+      """
@@ -0,0 +1,60 @@
+Feature: display the branches shipped within a range of commits
+
+  Background:
+    Given the tags
+      | NAME | LOCATION |
+      | v1.0 | local    |
+    And the feature branches "alpha" and "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | main commit  |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And I ran "git-town ship alpha -m 'alpha done'"
+    And I ran "git-town ship beta -m 'beta done'"
+
+  Scenario: Markdown
+    When I run "git-town changelog v1.0..main"
+    Then it runs no commands
+    And it prints:
+      """
+      ## Changes
+
+      - alpha done
+      - beta done
+      """
+    And it does not print "main commit"
+
+  Scenario: JSON
+    When I run "git-town changelog v1.0.. --json"
+    Then it prints something like:
+      """
+      \[
+        \{
+          "title": "Changes",
+          "entries": \[
+            \{
+              "branch": "alpha",
+              "labels": \[\],
+              "proposal": 0,
+              "sha": "[0-9a-f]+",
+              "title": "alpha done"
+            \},
+            \{
+              "branch": "beta",
+      """
+
+  Scenario: no shipped branches in the range
+    When I run "git-town changelog v1.0..v1.0"
+    Then it prints:
+      """
+      No shipped branches found.
+      """
+
+  Scenario: invalid range
+    When I run "git-town changelog v1.0"
+    Then it runs no commands
+    And it prints the error:
+      """
+      please provide the range of commits as <from>..<to>, for example "v1.0..main", not "v1.0"
+      """
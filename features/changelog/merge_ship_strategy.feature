@@ -0,0 +1,33 @@
+Feature: display the branches shipped with merge commits
+
+  Background:
+    Given the tags
+      | NAME | LOCATION |
+      | v1.0 | local    |
+    And branch "main" ships with the "merge" strategy
+    And the feature branches "alpha" and "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And I ran "git-town ship alpha -m 'alpha done'"
+    And I ran "git-town ship beta"
+
+  Scenario: result
+    When I run "git-town changelog v1.0..main"
+    Then it runs no commands
+    And it prints:
+      """
+      ## Changes
+
+      - alpha done
+      - Merge branch 'beta'
+      """
+    And it does not print "alpha commit"
+    And it does not print "beta commit"
+    And the commit "Merge branch 'beta'" in branch "main" now has the message:
+      """
+      Merge branch 'beta'
+
+      Git-Town-Branch: beta
+      """
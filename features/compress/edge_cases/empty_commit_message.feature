@@ -0,0 +1,35 @@
+@skipWindows
+Feature: abort compressing by entering an empty commit message
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    When I run "git-town compress" and enter an empty commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                       |
+      | feature | git fetch --prune --tags                      |
+      |         | git reset --soft main                         |
+      |         | git commit -e -m "commit 1\\n\\ncommit 2"     |
+      |         | git reset --hard {{ sha-initial 'commit 2' }} |
+    And it prints the error:
+      """
+      aborted because commit exited with error
+      """
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it prints the error:
+      """
+      nothing to undo
+      """
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -0,0 +1,78 @@
+Feature: cannot compress branches that have nothing to compress or are out of sync
+
+  Scenario: on the main branch
+    Given the current branch is "main"
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      you can only compress feature branches
+      """
+
+  Scenario: branch without commits
+    Given the current branch is a feature branch "feature"
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" has no commits to compress
+      """
+
+  Scenario: branch with a single commit
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" has only one commit, use the --message flag to change its commit message
+      """
+
+  Scenario: branch behind its tracking branch
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE       |
+      | feature | local, origin | commit 1      |
+      |         |               | commit 2      |
+      |         | origin        | origin commit |
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" is not in sync, please run "git town sync" before compressing it
+      """
+
+  Scenario: branch behind its parent
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE     |
+      | main    | local, origin | main commit |
+      | feature | local, origin | commit 1    |
+      |         |               | commit 2    |
+    When I run "git-town compress"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" is not in sync, please run "git town sync" before compressing it
+      """
+
+  Scenario: stack with commit message
+    Given the current branch is a feature branch "feature"
+    When I run "git-town compress --stack -m done"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --message and --stack flags cannot be combined because each compressed branch needs its own commit message
+      """
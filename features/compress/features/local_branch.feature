@@ -0,0 +1,29 @@
+Feature: compress the commits of a local feature branch
+
+  Background:
+    Given the current branch is a local feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local    | commit 1 | file_1    | content 1    |
+      |         |          | commit 2 | file_2    | content 2    |
+    When I run "git-town compress" and enter "compressed" for the commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git fetch --prune --tags                  |
+      |         | git reset --soft main                     |
+      |         | git commit -e -m "commit 1\\n\\ncommit 2" |
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION | MESSAGE    |
+      | feature | local    | compressed |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                       |
+      | feature | git reset --hard {{ sha-initial 'commit 2' }} |
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
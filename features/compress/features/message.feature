@@ -0,0 +1,35 @@
+Feature: compress the commits of a feature branch with the given commit message
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    When I run "git-town compress -m compressed"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                       |
+      | feature | git fetch --prune --tags                                                      |
+      |         | git reset --soft main                                                         |
+      |         | git commit -m compressed                                                      |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'commit 2' }} |
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE    |
+      | feature | local, origin | compressed |
+    And these committed files exist now
+      | BRANCH  | NAME   | CONTENT   |
+      | feature | file_1 | content 1 |
+      |         | file_2 | content 2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                 |
+      | feature | git push --force-with-lease origin {{ sha-initial 'commit 2' }}:feature |
+      |         | git reset --hard {{ sha-initial 'commit 2' }}                           |
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -0,0 +1,91 @@
+Feature: compress all branches in the stack
+
+  Background:
+    Given a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | alpha  | local, origin | alpha 1 | alpha_1   | alpha 1      |
+      |        |               | alpha 2 | alpha_2   | alpha 2      |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta 1  | beta_1    | beta 1       |
+      |        |               | beta 2  | beta_2    | beta 2       |
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | gamma  | local, origin | gamma 1 | gamma_1   | gamma 1      |
+      | other  | local, origin | other 1 | other_1   | other 1      |
+      |        |               | other 2 | other_2   | other 2      |
+    And the current branch is "beta"
+    When I run "git-town compress --stack" and close the editor
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                    |
+      | beta   | git fetch --prune --tags                                                   |
+      |        | git checkout alpha                                                         |
+      | alpha  | git reset --soft main                                                      |
+      |        | git commit -e -m "alpha 1\\n\\nalpha 2"                                    |
+      |        | git push --force-with-lease=alpha:{{ sha-in-origin-before-run 'alpha 2' }} |
+      |        | git checkout beta                                                          |
+      | beta   | git reset --soft alpha                                                     |
+      |        | git commit -e -m "beta 1\\n\\nbeta 2"                                      |
+      |        | git push --force-with-lease=beta:{{ sha-in-origin-before-run 'beta 2' }}   |
+    And the current branch is still "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | beta 1  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      |        |               | gamma 1 |
+      | other  | local, origin | other 1 |
+      |        |               | other 2 |
+    And these committed files exist now
+      | BRANCH | NAME    | CONTENT |
+      | alpha  | alpha_1 | alpha 1 |
+      |        | alpha_2 | alpha 2 |
+      | beta   | alpha_1 | alpha 1 |
+      |        | alpha_2 | alpha 2 |
+      |        | beta_1  | beta 1  |
+      |        | beta_2  | beta 2  |
+      | gamma  | alpha_1 | alpha 1 |
+      |        | alpha_2 | alpha 2 |
+      |        | beta_1  | beta 1  |
+      |        | beta_2  | beta 2  |
+      |        | gamma_1 | gamma 1 |
+      | other  | other_1 | other 1 |
+      |        | other_2 | other 2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                              |
+      | beta   | git push --force-with-lease origin {{ sha-initial 'beta 2' }}:beta   |
+      |        | git reset --hard {{ sha-initial 'beta 2' }}                          |
+      |        | git checkout alpha                                                   |
+      | alpha  | git push --force-with-lease origin {{ sha-initial 'alpha 2' }}:alpha |
+      |        | git reset --hard {{ sha-initial 'alpha 2' }}                         |
+      |        | git checkout beta                                                    |
+    And the current branch is still "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE |
+      | alpha  | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      | beta   | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      | gamma  | local, origin | alpha 1 |
+      |        |               | alpha 2 |
+      |        |               | beta 1  |
+      |        |               | beta 2  |
+      |        |               | gamma 1 |
+      | other  | local, origin | other 1 |
+      |        |               | other 2 |
+    And the initial branches and hierarchy exist
@@ -0,0 +1,76 @@
+Feature: compress a branch that has a child branch
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | parent | local, origin | parent 1 | parent_1  | parent 1     |
+      |        |               | parent 2 | parent_2  | parent 2     |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE | FILE NAME | FILE CONTENT |
+      | child  | local, origin | child 1 | child_1   | child 1      |
+    And the current branch is "parent"
+    When I run "git-town compress" and close the editor
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                      |
+      | parent | git fetch --prune --tags                                                     |
+      |        | git reset --soft main                                                        |
+      |        | git commit -e -m "parent 1\\n\\nparent 2"                                    |
+      |        | git push --force-with-lease=parent:{{ sha-in-origin-before-run 'parent 2' }} |
+    And the current branch is still "parent"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE  |
+      | child  | local, origin | parent 1 |
+      |        |               | parent 2 |
+      |        |               | child 1  |
+      | parent | local, origin | parent 1 |
+
+  Scenario: sync the child branch
+    Given the current branch is "child"
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                    |
+      | child  | git fetch --prune --tags                                                   |
+      |        | git checkout main                                                          |
+      | main   | git rebase origin/main                                                     |
+      |        | git checkout parent                                                        |
+      | parent | git rebase origin/parent                                                   |
+      |        | git rebase main                                                            |
+      |        | git checkout child                                                         |
+      | child  | git rebase origin/child                                                    |
+      |        | git rebase --onto parent {{ sha-initial 'parent 2' }}                      |
+      |        | git push --force-with-lease=child:{{ sha-in-origin-before-run 'child 1' }} |
+    And all branches are now synchronized
+    And the current branch is still "child"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE  |
+      | child  | local, origin | parent 1 |
+      |        |               | child 1  |
+      | parent | local, origin | parent 1 |
+    And these committed files exist now
+      | BRANCH | NAME     | CONTENT  |
+      | child  | child_1  | child 1  |
+      |        | parent_1 | parent 1 |
+      |        | parent_2 | parent 2 |
+      | parent | parent_1 | parent 1 |
+      |        | parent_2 | parent 2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                |
+      | parent | git push --force-with-lease origin {{ sha-initial 'parent 2' }}:parent |
+      |        | git reset --hard {{ sha-initial 'parent 2' }}                          |
+    And the current branch is still "parent"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE  |
+      | child  | local, origin | parent 1 |
+      |        |               | parent 2 |
+      |        |               | child 1  |
+      | parent | local, origin | parent 1 |
+      |        |               | parent 2 |
+    And the initial branches and hierarchy exist
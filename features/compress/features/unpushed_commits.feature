@@ -0,0 +1,41 @@
+Feature: compress a feature branch that is ahead of its tracking branch
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         | local         | commit 2 | file_2    | content 2    |
+    When I run "git-town compress" and close the editor
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                       |
+      | feature | git fetch --prune --tags                                                      |
+      |         | git reset --soft main                                                         |
+      |         | git commit -e -m "commit 1\\n\\ncommit 2"                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'commit 1' }} |
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+    And the commit "commit 1" in branch "feature" now has the message:
+      """
+      commit 1
+
+      commit 2
+      """
+    And these committed files exist now
+      | BRANCH  | NAME   | CONTENT   |
+      | feature | file_1 | content 1 |
+      |         | file_2 | content 2 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                 |
+      | feature | git push --force-with-lease origin {{ sha-initial 'commit 1' }}:feature |
+      |         | git reset --hard {{ sha-initial 'commit 2' }}                           |
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -0,0 +1,53 @@
+Feature: configure the downstream branches of perennial branches
+
+  Background:
+    Given the perennial branches "release" and "develop"
+
+  Scenario: not configured
+    When I run "git-town config downstream release"
+    Then it prints:
+      """
+      (not set)
+      """
+
+  Scenario: configured
+    Given perennial branch "release" merges into "develop main"
+    When I run "git-town config downstream release"
+    Then it prints:
+      """
+      develop
+      main
+      """
+
+  Scenario: set the downstream branches
+    When I run "git-town config downstream release develop main"
+    Then perennial branch "release" now merges into "develop main"
+
+  Scenario: remove the downstream branches
+    Given perennial branch "release" merges into "develop"
+    When I run "git-town config downstream release ''"
+    Then perennial branch "release" now merges into ""
+
+  Scenario: feature branch
+    Given a feature branch "feature"
+    When I run "git-town config downstream release feature"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "feature" is not a perennial branch
+      """
+    And perennial branch "release" now merges into ""
+
+  Scenario: merge into itself
+    When I run "git-town config downstream release release"
+    Then it prints the error:
+      """
+      branch "release" cannot merge into itself
+      """
+
+  Scenario: non-existing downstream branch
+    When I run "git-town config downstream release zonk"
+    Then it prints the error:
+      """
+      there is no branch "zonk"
+      """
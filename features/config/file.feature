@@ -0,0 +1,107 @@
+Feature: share the configuration through a file in the repository
+
+  Scenario: settings in the configuration file
+    Given the configuration file:
+      """
+      main-branch-name: main
+      perennial-branch-names: [qa, staging]
+      push-new-branches: true
+      sync-strategy: rebase
+      """
+    And Git Town is not configured
+    When I run "git-town config"
+    Then it prints:
+      """
+      Branches:
+        main branch: main (.git-town.yml)
+        perennial branches: qa, staging (.git-town.yml)
+        perennial regex: (not set)
+        observed branches: (not set)
+        prototype branches: (not set)
+
+      Configuration:
+        offline: no (default)
+        pull branch strategy: rebase (default)
+        run pre-push hook: yes (default)
+        push new branches: yes (.git-town.yml)
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        sync strategy: rebase (.git-town.yml)
+        sync with upstream: yes (default)
+      """
+
+  Scenario: the Git configuration overrides the configuration file
+    Given the configuration file:
+      """
+      perennial-branch-names: [qa]
+      sync-strategy: rebase
+      """
+    And the perennial branches are "staging"
+    And global setting "sync-strategy" is "merge"
+    When I run "git-town config"
+    Then it prints:
+      """
+      Branches:
+        main branch: main (local Git config)
+        perennial branches: staging (local Git config)
+        perennial regex: (not set)
+        observed branches: (not set)
+        prototype branches: (not set)
+      """
+    And it prints:
+      """
+        sync strategy: merge (global Git config)
+      """
+
+  Scenario: export the configuration
+    Given the perennial branches are "qa" and "staging"
+    And setting "push-hook" is "false"
+    And global setting "sync-strategy" is "rebase"
+    And global setting "github-token" is "123456"
+    When I run "git-town config export"
+    Then it prints:
+      """
+      wrote the configuration into .git-town.yml
+      """
+    And the configuration file is now:
+      """
+      main-branch-name: main
+      perennial-branch-names:
+        - qa
+        - staging
+      push-hook: false
+      sync-strategy: rebase
+      """
+
+  Scenario: unknown setting in the configuration file
+    Given the configuration file:
+      """
+      main-branch: main
+      sync-strategy: rebase
+      """
+    When I run "git-town config"
+    Then it prints:
+      """
+        sync strategy: rebase (.git-town.yml)
+      """
+    And it does not print "Warning"
+
+  Scenario: invalid value in the configuration file
+    Given the configuration file:
+      """
+      push-new-branches: [main]
+      sync-strategy: rebase
+      """
+    When I run "git-town config"
+    Then it prints:
+      """
+      Warning: invalid value for "push-new-branches" in .git-town.yml, ignoring the affected settings
+      """
+    And it prints:
+      """
+        push new branches: no (default)
+      """
+    And it prints:
+      """
+        sync strategy: rebase (.git-town.yml)
+      """
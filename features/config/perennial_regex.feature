@@ -0,0 +1,22 @@
+Feature: perennial regex
+
+  Scenario: display the perennial regex
+    Given setting "perennial-regex" is "^release/"
+    When I run "git-town config"
+    Then it prints:
+      """
+      Branches:
+        main branch: main (local Git config)
+        perennial branches: (not set)
+        perennial regex: ^release/ (local Git config)
+        observed branches: (not set)
+        prototype branches: (not set)
+      """
+
+  Scenario: invalid perennial regex
+    Given setting "perennial-regex" is "release/("
+    When I run "git-town sync"
+    Then it prints the error:
+      """
+      invalid perennial regex "release/(": error parsing regexp: missing closing ): `release/(`
+      """
@@ -6,9 +6,10 @@ Feature: enter Git Town configuration
     And a branch "production"
     And the main branch is "main"
     When I run "git-town config setup" and answer the prompts:
-      | PROMPT                                     | ANSWER                      |
-      | Please specify the main development branch | [ENTER]                     |
-      | Please specify perennial branches          | [SPACE][DOWN][SPACE][ENTER] |
+      | PROMPT                                           | ANSWER                      |
+      | Please specify the main development branch       | [ENTER]                     |
+      | Please specify perennial branches                | [SPACE][DOWN][SPACE][ENTER] |
+      | Please specify a regular expression for the name | [ENTER]                     |
     Then the main branch is now "main"
     And the perennial branches are now "production"
 
@@ -16,16 +17,59 @@ Feature: enter Git Town configuration
     Given the branches "dev" and "production"
     And Git Town is not configured
     When I run "git-town config setup" and answer the prompts:
-      | PROMPT                                     | ANSWER                      |
-      | Please specify the main development branch | [DOWN][ENTER]               |
-      | Please specify perennial branches          | [SPACE][DOWN][SPACE][ENTER] |
+      | PROMPT                                           | ANSWER                      |
+      | Please specify the main development branch       | [DOWN][ENTER]               |
+      | Please specify perennial branches                | [SPACE][DOWN][SPACE][ENTER] |
+      | Please specify a regular expression for the name | [ENTER]                     |
     Then the main branch is now "main"
     And the perennial branches are now "dev" and "production"
 
   Scenario: don't ask for perennial branches if no branches that could be perennial exist
     Given Git Town is not configured
     When I run "git-town config setup" and answer the prompts:
-      | PROMPT                                     | ANSWER        |
-      | Please specify the main development branch | [DOWN][ENTER] |
+      | PROMPT                                           | ANSWER        |
+      | Please specify the main development branch       | [DOWN][ENTER] |
+      | Please specify a regular expression for the name | [ENTER]       |
     Then the main branch is now "main"
     And there are still no perennial branches
+
+  Scenario: enter a perennial regex
+    Given a branch "release/1"
+    When I run "git-town config setup" and answer the prompts:
+      | PROMPT                                           | ANSWER           |
+      | Please specify the main development branch       | [ENTER]          |
+      | Please specify perennial branches                | [ENTER]          |
+      | Please specify a regular expression for the name | ^release/[ENTER] |
+    Then local setting "perennial-regex" is now "^release/"
+
+  Scenario: enter an invalid perennial regex
+    When I run "git-town config setup" and answer the prompts:
+      | PROMPT                                           | ANSWER           |
+      | Please specify the main development branch       | [ENTER]          |
+      | Please specify a regular expression for the name | release/([ENTER] |
+      | Please specify a regular expression for the name | ^release/[ENTER] |
+    Then it prints:
+      """
+      invalid perennial regex "release/(": error parsing regexp: missing closing ): `release/(`
+      """
+    And local setting "perennial-regex" is now "^release/"
+
+  Scenario: remove the perennial regex
+    Given local setting "perennial-regex" is "^release/"
+    When I run "git-town config setup" and answer the prompts:
+      | PROMPT                                           | ANSWER  |
+      | Please specify the main development branch       | [ENTER] |
+      | Please specify a regular expression for the name | [ENTER] |
+    Then local setting "perennial-regex" no longer exists
+
+  Scenario: replace an invalid perennial regex
+    Given local setting "perennial-regex" is "release/("
+    When I run "git-town config setup" and answer the prompts:
+      | PROMPT                                           | ANSWER           |
+      | Please specify the main development branch       | [ENTER]          |
+      | Please specify a regular expression for the name | ^release/[ENTER] |
+    Then it prints:
+      """
+      invalid perennial regex "release/(": error parsing regexp: missing closing ): `release/(`
+      """
+    And local setting "perennial-regex" is now "^release/"
@@ -0,0 +1,61 @@
+Feature: configure whether shipping deletes the remote branch of the shipped branch
+
+  Background:
+    Given a perennial branch "release"
+
+  Scenario: default
+    When I run "git-town config ship-delete-remote-branch"
+    Then it prints:
+      """
+      yes
+      """
+
+  Scenario: disable deleting remote branches
+    When I run "git-town config ship-delete-remote-branch no"
+    Then local setting "ship-delete-remote-branch" is now "false"
+    And shipping into branch "release" no longer has its own setting for deleting the remote branch
+
+  Scenario: disable deleting remote branches when shipping into a branch
+    When I run "git-town config ship-delete-remote-branch --branch release no"
+    Then shipping into branch "release" now keeps the remote branch
+    And local setting "ship-delete-remote-branch" no longer exists
+
+  Scenario: display the setting of a branch
+    Given shipping into branch "release" keeps the remote branch
+    When I run "git-town config ship-delete-remote-branch --branch release"
+    Then it prints:
+      """
+      no
+      """
+
+  Scenario: display the setting of a branch that uses the general setting
+    Given local setting "ship-delete-remote-branch" is "false"
+    When I run "git-town config ship-delete-remote-branch --branch release"
+    Then it prints:
+      """
+      no
+      """
+
+  Scenario: remove the setting of a branch
+    Given shipping into branch "release" keeps the remote branch
+    When I run "git-town config ship-delete-remote-branch --branch release ''"
+    Then shipping into branch "release" no longer has its own setting for deleting the remote branch
+
+  Scenario: invalid value
+    When I run "git-town config ship-delete-remote-branch --branch release zonk"
+    Then it prints the error:
+      """
+      invalid argument: "zonk". Please provide either "yes" or "no".
+      """
+    And shipping into branch "release" no longer has its own setting for deleting the remote branch
+
+  Scenario: view the configuration
+    Given shipping into branch "release" keeps the remote branch
+    When I run "git-town config"
+    Then it prints:
+      """
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        ship into release removes the remote branch: no (local Git config)
+        sync strategy: merge (default)
+      """
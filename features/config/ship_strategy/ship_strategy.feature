@@ -0,0 +1,60 @@
+Feature: configure how to ship feature branches into a branch
+
+  Background:
+    Given a perennial branch "release"
+
+  Scenario: default
+    When I run "git-town config ship-strategy"
+    Then it prints:
+      """
+      squash-merge
+      """
+
+  Scenario: set the ship strategy of the main branch
+    When I run "git-town config ship-strategy merge"
+    Then branch "main" now ships with the "merge" strategy
+    And branch "release" no longer has its own ship strategy
+
+  Scenario: set the ship strategy of a branch
+    When I run "git-town config ship-strategy --branch release merge"
+    Then branch "release" now ships with the "merge" strategy
+    And branch "main" no longer has its own ship strategy
+
+  Scenario: display the ship strategy of a branch
+    Given branch "release" ships with the "merge" strategy
+    When I run "git-town config ship-strategy --branch release"
+    Then it prints:
+      """
+      merge
+      """
+
+  Scenario: remove the ship strategy of a branch
+    Given branch "release" ships with the "merge" strategy
+    When I run "git-town config ship-strategy --branch release ''"
+    Then branch "release" no longer has its own ship strategy
+
+  Scenario: invalid value
+    When I run "git-town config ship-strategy --branch release zonk"
+    Then it prints the error:
+      """
+      unknown ship strategy: "zonk"
+      """
+    And branch "release" no longer has its own ship strategy
+
+  Scenario: non-existing branch
+    When I run "git-town config ship-strategy --branch zonk merge"
+    Then it prints the error:
+      """
+      there is no branch "zonk"
+      """
+
+  Scenario: view the configuration
+    Given branch "release" ships with the "merge" strategy
+    When I run "git-town config"
+    Then it prints:
+      """
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        ship strategy into release: merge (local Git config)
+        sync strategy: merge (default)
+      """
@@ -0,0 +1,65 @@
+Feature: configure the sync strategy of individual branches
+
+  Background:
+    Given the feature branches "private" and "shared"
+
+  Scenario: set the sync strategy of a branch
+    When I run "git-town config sync-strategy --branch private rebase"
+    Then branch "private" now uses the "rebase" sync strategy
+    And local setting "sync-strategy" no longer exists
+
+  Scenario: remove the sync strategy of a branch
+    Given branch "private" uses the "rebase" sync strategy
+    When I run "git-town config sync-strategy --branch private ''"
+    Then branch "private" no longer has its own sync strategy
+
+  Scenario: display the sync strategy of a branch
+    Given setting "sync-strategy" is "merge"
+    And branch "private" uses the "rebase" sync strategy
+    When I run "git-town config sync-strategy --branch private"
+    Then it prints:
+      """
+      rebase
+      """
+
+  Scenario: display the sync strategy of a branch without its own sync strategy
+    Given setting "sync-strategy" is "rebase"
+    When I run "git-town config sync-strategy --branch shared"
+    Then it prints:
+      """
+      rebase
+      """
+
+  Scenario: invalid value
+    When I run "git-town config sync-strategy --branch private zonk"
+    Then it prints the error:
+      """
+      unknown sync strategy: "zonk"
+      """
+    And branch "private" no longer has its own sync strategy
+
+  Scenario: non-existing branch
+    When I run "git-town config sync-strategy --branch zonk rebase"
+    Then it prints the error:
+      """
+      there is no branch "zonk"
+      """
+
+  Scenario: combined with the global flag
+    When I run "git-town config sync-strategy --global --branch private rebase"
+    Then it prints the error:
+      """
+      the --branch and --global flags cannot be combined
+      """
+
+  Scenario: view the configuration
+    Given branch "private" uses the "rebase" sync strategy
+    And branch "shared" uses the "merge" sync strategy
+    When I run "git-town config"
+    Then it prints:
+      """
+        sync strategy: merge (default)
+        sync strategy of private: rebase (local Git config)
+        sync strategy of shared: merge (local Git config)
+        sync with upstream: yes (default)
+      """
@@ -7,17 +7,21 @@ Feature: show the configuration
     Then it prints:
       """
       Branches:
-        main branch: main
-        perennial branches: qa, staging
+        main branch: main (local Git config)
+        perennial branches: qa, staging (local Git config)
+        perennial regex: (not set)
+        observed branches: (not set)
+        prototype branches: (not set)
 
       Configuration:
-        offline: no
-        pull branch strategy: rebase
-        run pre-push hook: yes
-        push new branches: no
-        ship removes the remote branch: yes
-        sync strategy: merge
-        sync with upstream: yes
+        offline: no (default)
+        pull branch strategy: rebase (default)
+        run pre-push hook: yes (default)
+        push new branches: no (default)
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        sync strategy: merge (default)
+        sync with upstream: yes (default)
 
       Hosting:
         hosting service override: (not set)
@@ -35,17 +39,21 @@ Feature: show the configuration
     Then it prints:
       """
       Branches:
-        main branch: main
-        perennial branches: qa, staging
+        main branch: main (local Git config)
+        perennial branches: qa, staging (local Git config)
+        perennial regex: (not set)
+        observed branches: (not set)
+        prototype branches: (not set)
 
       Configuration:
-        offline: no
-        pull branch strategy: rebase
-        run pre-push hook: yes
-        push new branches: no
-        ship removes the remote branch: yes
-        sync strategy: merge
-        sync with upstream: yes
+        offline: no (default)
+        pull branch strategy: rebase (default)
+        run pre-push hook: yes (default)
+        push new branches: no (default)
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        sync strategy: merge (default)
+        sync with upstream: yes (default)
 
       Hosting:
         hosting service override: (not set)
@@ -71,15 +79,19 @@ Feature: show the configuration
       Branches:
         main branch: (not set)
         perennial branches: (not set)
+        perennial regex: (not set)
+        observed branches: (not set)
+        prototype branches: (not set)
 
       Configuration:
-        offline: no
-        pull branch strategy: rebase
-        run pre-push hook: yes
-        push new branches: no
-        ship removes the remote branch: yes
-        sync strategy: merge
-        sync with upstream: yes
+        offline: no (default)
+        pull branch strategy: rebase (default)
+        run pre-push hook: yes (default)
+        push new branches: no (default)
+        ship records changelog trailers: yes (default)
+        ship removes the remote branch: yes (default)
+        sync strategy: merge (default)
+        sync with upstream: yes (default)
 
       Hosting:
         hosting service override: (not set)
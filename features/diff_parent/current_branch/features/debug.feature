@@ -4,13 +4,13 @@ Feature: display debug statistics
     And the current branch is a feature branch "feature"
     When I run "git-town diff-parent --debug"
     Then it runs the commands
-      | BRANCH  | TYPE     | COMMAND                       |
-      |         | backend  | git version                   |
-      |         | backend  | git config -lz --global       |
-      |         | backend  | git config -lz --local        |
-      |         | backend  | git rev-parse --show-toplevel |
-      |         | backend  | git branch -vva               |
-      | feature | frontend | git diff main..feature        |
+      | BRANCH  | TYPE     | COMMAND                                                                                                                                                             |
+      |         | backend  | git version                                                                                                                                                         |
+      |         | backend  | git config -lz --global                                                                                                                                             |
+      |         | backend  | git config -lz --local                                                                                                                                              |
+      |         | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      | feature | frontend | git diff main..feature                                                                                                                                              |
     And it prints:
       """
       Ran 6 shell commands.
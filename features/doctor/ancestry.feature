@@ -0,0 +1,35 @@
+@skipWindows
+Feature: repair parent branches that the commit history contradicts
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | alpha  | local, origin | alpha commit | alpha_file |
+      | beta   | local, origin | beta commit  | beta_file  |
+    And Git Town believes the parent of "beta" is "alpha"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And setting "gitea-token" is "123456"
+    And the current branch is "beta"
+    When I run "git-town doctor" and answer the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+
+  Scenario: result
+    Then it prints:
+      """
+      - branch "beta" doesn't descend from its parent "alpha", the commit history suggests "main" (confidence: high)
+        fix: make "beta" a child of "main"
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
@@ -0,0 +1,24 @@
+@skipWindows
+Feature: repair invalid configuration entries of branches
+
+  Background:
+    Given a perennial branch "release"
+    And branch "release" ships with the "zonk" strategy
+
+  Scenario: apply the fixes
+    When I run "git-town doctor" and answer the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+    Then it prints:
+      """
+      - the local Git configuration contains the invalid value "zonk" for "git-town-branch.release.ship-strategy"
+        fix: remove it to use the default value
+      """
+    And branch "release" no longer has its own ship strategy
+
+  Scenario: undo
+    Given I ran "git-town doctor" and answered the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+    When I run "git-town undo"
+    Then branch "release" now ships with the "zonk" strategy
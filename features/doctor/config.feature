@@ -0,0 +1,38 @@
+@skipWindows
+Feature: repair invalid configuration entries
+
+  Background:
+    Given setting "sync-strategy" is "zonk"
+    And global setting "push-hook" is "maybe"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And setting "gitea-token" is "123456"
+
+  Scenario: apply the fixes
+    When I run "git-town doctor" and answer the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+    Then it prints:
+      """
+      - the local Git configuration contains the invalid value "zonk" for "git-town.sync-strategy"
+        fix: remove it to use the default value
+      - the global Git configuration contains the invalid value "maybe" for "git-town.push-hook"
+        fix: remove it to use the default value
+      """
+    And local setting "sync-strategy" no longer exists
+    And global setting "push-hook" no longer exists
+
+  Scenario: decline the fixes
+    When I run "git-town doctor" and answer the prompts:
+      | PROMPT             | ANSWER        |
+      | Apply these fixes? | [DOWN][ENTER] |
+    Then it runs no commands
+    And local setting "sync-strategy" is now "zonk"
+    And global setting "push-hook" is still "maybe"
+
+  Scenario: undo
+    Given I ran "git-town doctor" and answered the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+    When I run "git-town undo"
+    Then local setting "sync-strategy" is now "zonk"
+    And global setting "push-hook" is still "maybe"
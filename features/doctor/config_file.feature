@@ -0,0 +1,30 @@
+@skipWindows
+Feature: report problems with the configuration file
+
+  Background:
+    Given the origin is "git@gitea.com:git-town/git-town.git"
+    And setting "gitea-token" is "123456"
+
+  Scenario: unreadable configuration file
+    Given the configuration file:
+      """
+      main-branch-name: [main
+      """
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      - .git-town.yml contains invalid YAML: yaml: line 1: did not find expected ',' or ']'
+        hint: correct it in .git-town.yml
+      """
+
+  Scenario: invalid value in the configuration file
+    Given the configuration file:
+      """
+      sync-strategy: zonk
+      """
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      - .git-town.yml contains the invalid value "zonk" for "sync-strategy"
+        hint: correct it in .git-town.yml
+      """
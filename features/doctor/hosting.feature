@@ -0,0 +1,31 @@
+@skipWindows
+Feature: verify the code hosting setup
+
+  Scenario: healthy setup
+    Given the origin is "git@gitea.com:git-town/git-town.git"
+    And setting "gitea-token" is "123456"
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      Git Town found no problems
+      """
+
+  Scenario: missing API token
+    Given the origin is "git@gitlab.com:git-town/git-town.git"
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      - there is no API token for GitLab, Git Town cannot ship or update proposals via the API
+        hint: store your API token in "git-town.gitlab-token"
+      """
+
+  Scenario: unknown code hosting platform
+    When I run "git-town doctor"
+    Then it prints:
+      """
+      - cannot determine the code hosting platform of origin
+      """
+    And it prints:
+      """
+        hint: set "git-town.code-hosting-driver" if you use a code hosting platform
+      """
@@ -0,0 +1,40 @@
+@skipWindows
+Feature: repair the branch lineage
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And Git Town believes the parent of "alpha" is "beta"
+    And Git Town believes the parent of "gone" is "main"
+    And the origin is "git@gitea.com:git-town/git-town.git"
+    And setting "gitea-token" is "123456"
+    And the current branch is "gamma"
+    When I run "git-town doctor" and answer the prompts:
+      | PROMPT             | ANSWER  |
+      | Apply these fixes? | [ENTER] |
+
+  Scenario: result
+    Then it prints:
+      """
+      - the lineage contains the branch "gone" that doesn't exist
+        fix: remove the lineage entry of "gone"
+      - the branches alpha, beta form a cycle in the lineage
+        fix: make "alpha" a child of "main"
+      """
+    And the current branch is still "gamma"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+      | gamma  | beta   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is still "gamma"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | beta   |
+      | beta   | alpha  |
+      | gamma  | beta   |
+      | gone   | main   |
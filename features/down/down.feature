@@ -0,0 +1,36 @@
+Feature: switch to the parent branch
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+
+  Scenario: feature branch
+    Given the current branch is "beta" and the previous branch is "main"
+    When I run "git-town down"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+    And the current branch is now "alpha"
+    And the previous Git branch is now "beta"
+
+  Scenario: with uncommitted changes
+    Given the current branch is "beta"
+    And an uncommitted file
+    When I run "git-town down"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git add -A         |
+      |        | git stash          |
+      |        | git checkout alpha |
+      | alpha  | git stash pop      |
+    And the current branch is now "alpha"
+    And the uncommitted file still exists
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town down"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "main" has no parent branch
+      """
@@ -11,9 +11,11 @@ Feature: already existing branch
       there is already a branch "existing"
       """
 
-  Scenario: the branch to create already exists at the origin remote
+  Scenario: the branch to create already exists at the origin remote, decline to observe it
     Given a remote feature branch "existing"
-    When I run "git-town hack existing"
+    When I run "git-town hack existing" and answer the prompts:
+      | PROMPT                                                                                    | ANSWER        |
+      | Branch "existing" exists only at the "origin" remote. Check it out as an observed branch? | [DOWN][ENTER] |
     Then it runs the commands
       | BRANCH | COMMAND                  |
       | main   | git fetch --prune --tags |
@@ -21,3 +23,30 @@ Feature: already existing branch
       """
       there is already a branch "existing" at the "origin" remote
       """
+
+  Scenario: the branch to create already exists at the origin remote, observe it
+    Given a remote feature branch "existing"
+    When I run "git-town hack existing" and answer the prompts:
+      | PROMPT                                                                                    | ANSWER  |
+      | Branch "existing" exists only at the "origin" remote. Check it out as an observed branch? | [ENTER] |
+    Then it runs the commands
+      | BRANCH | COMMAND                             |
+      | main   | git fetch --prune --tags            |
+      |        | git branch existing origin/existing |
+      |        | git checkout existing               |
+    And the current branch is now "existing"
+    And local setting "observed-branches" is now "existing"
+
+  Scenario: undo observing the remote branch
+    Given a remote feature branch "existing"
+    And I ran "git-town hack existing" and answered the prompts:
+      | PROMPT                                                                                    | ANSWER  |
+      | Branch "existing" exists only at the "origin" remote. Check it out as an observed branch? | [ENTER] |
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH   | COMMAND                |
+      | existing | git checkout main      |
+      | main     | git branch -D existing |
+    And the current branch is now "main"
+    And local setting "observed-branches" no longer exists
+    And the initial branches and hierarchy exist
@@ -12,7 +12,9 @@ Feature: help for commands
       | COMMAND                     |
       | aliases                     |
       | append                      |
+      | changelog                   |
       | completions                 |
+      | compress                    |
       | config                      |
       | config main-branch          |
       | config push-new-branches    |
@@ -8,6 +8,6 @@ Feature: ask for missing configuration
       | Please specify the main development branch | [ENTER] |
     Then it prints the error:
       """
-      you can only kill feature branches
+      you can only kill feature and observed branches
       """
     And the main branch is now "main"
@@ -12,31 +12,30 @@ Feature: display debug statistics
   Scenario: result
     When I run "git-town kill --debug"
     Then it runs the commands
-      | BRANCH  | TYPE     | COMMAND                                           |
-      |         | backend  | git version                                       |
-      |         | backend  | git config -lz --global                           |
-      |         | backend  | git config -lz --local                            |
-      |         | backend  | git rev-parse --show-toplevel                     |
-      |         | backend  | git remote                                        |
-      |         | backend  | git status                                        |
-      |         | backend  | git rev-parse --abbrev-ref HEAD                   |
-      | current | frontend | git fetch --prune --tags                          |
-      |         | backend  | git branch -vva                                   |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}         |
-      |         | backend  | git status --porcelain --ignore-submodules        |
-      | current | frontend | git push origin :current                          |
-      |         | frontend | git checkout main                                 |
-      |         | backend  | git rev-parse --short current                     |
-      |         | backend  | git log main..current                             |
-      | main    | frontend | git branch -D current                             |
-      |         | backend  | git config --unset git-town-branch.current.parent |
-      |         | backend  | git show-ref --quiet refs/heads/other             |
-      |         | backend  | git show-ref --quiet refs/heads/current           |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}         |
-      |         | backend  | git checkout other                                |
-      |         | backend  | git checkout main                                 |
+      | BRANCH  | TYPE     | COMMAND                                                                                                                                                             |
+      |         | backend  | git version                                                                                                                                                         |
+      |         | backend  | git config -lz --global                                                                                                                                             |
+      |         | backend  | git config -lz --local                                                                                                                                              |
+      |         | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |         | backend  | git remote                                                                                                                                                          |
+      |         | backend  | git status                                                                                                                                                          |
+      |         | backend  | git rev-parse --abbrev-ref HEAD                                                                                                                                     |
+      | current | frontend | git fetch --prune --tags                                                                                                                                            |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |         | backend  | git status --porcelain --ignore-submodules                                                                                                                          |
+      | current | frontend | git push origin :current                                                                                                                                            |
+      |         | frontend | git checkout main                                                                                                                                                   |
+      |         | backend  | git rev-parse --short current                                                                                                                                       |
+      |         | backend  | git log main..current                                                                                                                                               |
+      | main    | frontend | git branch -D current                                                                                                                                               |
+      |         | backend  | git config --unset git-town-branch.current.parent                                                                                                                   |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/                                                                                                                               |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |         | backend  | git checkout other                                                                                                                                                  |
+      |         | backend  | git checkout main                                                                                                                                                   |
     And it prints:
       """
-      Ran 22 shell commands.
+      Ran 21 shell commands.
       """
     And the current branch is now "main"
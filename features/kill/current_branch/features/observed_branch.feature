@@ -0,0 +1,16 @@
+Feature: kill the current observed branch
+
+  Scenario: result
+    Given an observed branch "observed"
+    And the current branch is "observed"
+    When I run "git-town kill"
+    Then it runs the commands
+      | BRANCH   | COMMAND                  |
+      | observed | git fetch --prune --tags |
+      |          | git checkout main        |
+      | main     | git branch -D observed   |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY | BRANCHES       |
+      | local      | main           |
+      | origin     | main, observed |
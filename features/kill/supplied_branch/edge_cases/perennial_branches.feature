@@ -8,7 +8,7 @@ Feature: does not kill perennial branches
       | feature | git fetch --prune --tags |
     And it prints the error:
       """
-      you can only kill feature branches
+      you can only kill feature and observed branches
       """
     And the current branch is still "feature"
     And the initial branches and hierarchy exist
@@ -22,5 +22,5 @@ Feature: does not kill perennial branches
       | main   | git fetch --prune --tags |
     And it prints the error:
       """
-      you can only kill feature branches
+      you can only kill feature and observed branches
       """
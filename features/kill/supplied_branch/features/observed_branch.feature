@@ -0,0 +1,31 @@
+Feature: kill an observed branch
+
+  Background:
+    Given an observed branch "observed"
+    And a feature branch "feature"
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE         |
+      | observed | local, origin | observed commit |
+    And the current branch is "feature"
+    When I run "git-town kill observed"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+      |         | git branch -D observed   |
+    And the current branch is still "feature"
+    And the branches are now
+      | REPOSITORY | BRANCHES                |
+      | local      | main, feature           |
+      | origin     | main, feature, observed |
+    And local setting "observed-branches" no longer exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git branch observed {{ sha 'observed commit' }} |
+    And the current branch is still "feature"
+    And the initial branches and hierarchy exist
+    And local setting "observed-branches" is now "observed"
@@ -0,0 +1,29 @@
+Feature: remove the prototype configuration when killing a prototype branch
+
+  Background:
+    Given the feature branches "feature" and "prototype"
+    And branch "prototype" is a prototype branch
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | prototype | local    | prototype commit |
+    And the current branch is "feature"
+    When I run "git-town kill prototype"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                    |
+      | feature | git fetch --prune --tags   |
+      |         | git push origin :prototype |
+      |         | git branch -D prototype    |
+    And the current branch is still "feature"
+    And branch "prototype" is no longer a prototype branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                           |
+      | feature | git branch prototype {{ sha 'prototype commit' }} |
+      |         | git push -u origin prototype                      |
+    And the current branch is still "feature"
+    And the initial branches and hierarchy exist
+    And branch "prototype" is still a prototype branch
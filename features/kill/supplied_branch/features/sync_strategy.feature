@@ -0,0 +1,27 @@
+Feature: remove the sync strategy of a killed branch
+
+  Background:
+    Given the feature branches "dead" and "other"
+    And branch "dead" uses the "rebase" sync strategy
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     |
+      | dead   | local, origin | dead commit |
+    And the current branch is "other"
+    When I run "git-town kill dead"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | other  | git fetch --prune --tags |
+      |        | git push origin :dead    |
+      |        | git branch -D dead       |
+    And branch "dead" no longer has its own sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                 |
+      | other  | git branch dead {{ sha 'dead commit' }} |
+      |        | git push -u origin dead                 |
+    And the initial branches and hierarchy exist
+    And branch "dead" now uses the "rebase" sync strategy
@@ -0,0 +1,49 @@
+Feature: display the persistent command log
+
+  Scenario: command log enabled
+    Given setting "command-log" is "true"
+    And I ran "git-town sync"
+    When I run "git-town log-show --command sync"
+    Then it prints something like:
+      """
+      git town sync  RebaseBranchStep  frontend  exit 0  .*
+        git rebase origin/main
+          Current branch main is up to date.
+      """
+    And it prints something like:
+      """
+      git town sync  \(no step\)  backend  exit 0  .*
+        git rev-parse --show-toplevel
+      """
+
+  Scenario: filter by date
+    Given setting "command-log" is "true"
+    And I ran "git-town sync"
+    When I run "git-town log-show --since 2999-01-01"
+    Then it prints:
+      """
+      The command log is empty.
+      """
+    And it does not print "To enable it"
+
+  Scenario: command log disabled
+    Given I ran "git-town sync"
+    When I run "git-town log-show"
+    Then it prints:
+      """
+      The command log is empty.
+      To enable it, run "git config git-town.command-log true".
+      """
+
+  Scenario: invalid command-log setting
+    Given setting "command-log" is "zonk"
+    When I run "git-town log-show"
+    Then it prints:
+      """
+      Warning: invalid value for git-town.command-log: "zonk". Please provide either "yes" or "no", the command log remains disabled
+      """
+    And it prints:
+      """
+      The command log is empty.
+      To enable it, run "git config git-town.command-log true".
+      """
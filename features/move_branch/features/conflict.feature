@@ -0,0 +1,59 @@
+Feature: conflicts while moving a branch
+
+  Background:
+    Given a feature branch "old"
+    And a feature branch "new"
+    And a feature branch "feature" as a child of "old"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | feature | local, origin | conflicting feature commit | conflicting_file | feature content |
+      | new     | local, origin | conflicting new commit     | conflicting_file | new content     |
+      | old     | local, origin | old commit                 | old_file         | old content     |
+    And the current branch is "feature"
+    When I run "git-town move-branch new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                      |
+      | feature | git fetch --prune --tags                     |
+      |         | git rebase --onto new {{ sha 'old commit' }} |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To abort, run "git-town abort".
+      To continue after having resolved conflicts, run "git-town continue".
+      """
+    And a rebase is now in progress
+
+  Scenario: abort
+    When I run "git-town abort"
+    Then it runs the commands
+      | BRANCH  | COMMAND            |
+      | feature | git rebase --abort |
+    And the current branch is still "feature"
+    And no rebase is in progress
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                                         |
+      | feature | git rebase --continue                                                                           |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'conflicting feature commit' }} |
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE                    |
+      | feature | local, origin | conflicting new commit     |
+      |         |               | conflicting feature commit |
+      | new     | local, origin | conflicting new commit     |
+      | old     | local, origin | old commit                 |
+    And this branch lineage exists now
+      | BRANCH  | PARENT |
+      | feature | new    |
+      | new     | main   |
+      | old     | main   |
@@ -0,0 +1,56 @@
+Feature: move a branch and its descendants onto a new parent
+
+  Background:
+    Given a feature branch "old"
+    And a feature branch "new"
+    And a feature branch "feature" as a child of "old"
+    And a feature branch "child" as a child of "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    |
+      | child   | local, origin | child commit   | child_file   |
+      | feature | local, origin | feature commit | feature_file |
+      | new     | local, origin | new commit     | new_file     |
+      | old     | local, origin | old commit     | old_file     |
+    And the current branch is "feature"
+    When I run "git-town move-branch new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags                                                            |
+      |         | git rebase --onto new {{ sha 'old commit' }}                                        |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+      |         | git checkout child                                                                  |
+      | child   | git rebase --onto feature {{ sha-initial 'feature commit' }}                        |
+      |         | git push --force-with-lease=child:{{ sha-in-origin-before-run 'child commit' }}     |
+      |         | git checkout feature                                                                |
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | child   | local, origin | new commit     |
+      |         |               | feature commit |
+      |         |               | child commit   |
+      | feature | local, origin | new commit     |
+      |         |               | feature commit |
+      | new     | local, origin | new commit     |
+      | old     | local, origin | old commit     |
+    And this branch lineage exists now
+      | BRANCH  | PARENT  |
+      | child   | feature |
+      | feature | new     |
+      | new     | main    |
+      | old     | main    |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                       |
+      | feature | git checkout child                                                            |
+      | child   | git push --force-with-lease origin {{ sha-initial 'child commit' }}:child     |
+      |         | git reset --hard {{ sha-initial 'child commit' }}                             |
+      |         | git checkout feature                                                          |
+      | feature | git push --force-with-lease origin {{ sha-initial 'feature commit' }}:feature |
+      |         | git reset --hard {{ sha-initial 'feature commit' }}                           |
+    And the current branch is still "feature"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -7,35 +7,35 @@ Feature: display debug statistics
     And the origin is "git@github.com:git-town/git-town.git"
     When I run "git-town new-pull-request --debug"
     Then it runs the commands
-      | BRANCH  | TYPE     | COMMAND                                                            |
-      |         | backend  | git version                                                        |
-      |         | backend  | git config -lz --global                                            |
-      |         | backend  | git config -lz --local                                             |
-      |         | backend  | git rev-parse --show-toplevel                                      |
-      |         | backend  | git remote                                                         |
-      |         | backend  | git status                                                         |
-      |         | backend  | git rev-parse --abbrev-ref HEAD                                    |
-      | feature | frontend | git fetch --prune --tags                                           |
-      |         | backend  | git branch -vva                                                    |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                          |
-      |         | backend  | git status --porcelain --ignore-submodules                         |
-      | feature | frontend | git checkout main                                                  |
-      |         | backend  | git rev-parse --short HEAD                                         |
-      | main    | frontend | git rebase origin/main                                             |
-      |         | backend  | git rev-list --left-right main...origin/main                       |
-      | main    | frontend | git checkout feature                                               |
-      |         | backend  | git rev-parse --short HEAD                                         |
-      | feature | frontend | git merge --no-edit origin/feature                                 |
-      |         | backend  | git rev-parse --short HEAD                                         |
-      | feature | frontend | git merge --no-edit main                                           |
-      |         | backend  | git rev-list --left-right feature...origin/feature                 |
-      |         | backend  | git show-ref --quiet refs/heads/main                               |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                          |
-      |         | backend  | which wsl-open                                                     |
-      |         | backend  | which garcon-url-handler                                           |
-      |         | backend  | which xdg-open                                                     |
-      |         | backend  | which open                                                         |
-      | <none>  | frontend | open https://github.com/git-town/git-town/compare/feature?expand=1 |
+      | BRANCH  | TYPE     | COMMAND                                                                                                                                                             |
+      |         | backend  | git version                                                                                                                                                         |
+      |         | backend  | git config -lz --global                                                                                                                                             |
+      |         | backend  | git config -lz --local                                                                                                                                              |
+      |         | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |         | backend  | git remote                                                                                                                                                          |
+      |         | backend  | git status                                                                                                                                                          |
+      |         | backend  | git rev-parse --abbrev-ref HEAD                                                                                                                                     |
+      | feature | frontend | git fetch --prune --tags                                                                                                                                            |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |         | backend  | git status --porcelain --ignore-submodules                                                                                                                          |
+      | feature | frontend | git checkout main                                                                                                                                                   |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | main    | frontend | git rebase origin/main                                                                                                                                              |
+      |         | backend  | git rev-list --left-right main...origin/main                                                                                                                        |
+      | main    | frontend | git checkout feature                                                                                                                                                |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | feature | frontend | git merge --no-edit origin/feature                                                                                                                                  |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | feature | frontend | git merge --no-edit main                                                                                                                                            |
+      |         | backend  | git rev-list --left-right feature...origin/feature                                                                                                                  |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/                                                                                                                                |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |         | backend  | which wsl-open                                                                                                                                                      |
+      |         | backend  | which garcon-url-handler                                                                                                                                            |
+      |         | backend  | which xdg-open                                                                                                                                                      |
+      |         | backend  | which open                                                                                                                                                          |
+      | <none>  | frontend | open https://github.com/git-town/git-town/compare/feature?expand=1                                                                                                  |
     And it prints:
       """
       Ran 28 shell commands.
@@ -0,0 +1,28 @@
+@skipWindows
+Feature: propose a prototype branch
+
+  Background:
+    Given tool "open" is installed
+    And the current branch is a feature branch "prototype"
+    And branch "prototype" is a prototype branch
+    And the origin is "git@github.com:git-town/git-town.git"
+
+  Scenario: convert the prototype branch
+    When I run "git-town new-pull-request" and answer the prompts:
+      | PROMPT                                                                                                 | ANSWER  |
+      | Branch "prototype" is a prototype branch that Git Town doesn't push. Convert it into a feature branch? | [ENTER] |
+    Then "open" launches a new pull request with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/prototype?expand=1
+      """
+    And branch "prototype" is no longer a prototype branch
+
+  Scenario: keep the prototype branch
+    When I run "git-town new-pull-request" and answer the prompts:
+      | PROMPT                                                                                                 | ANSWER        |
+      | Branch "prototype" is a prototype branch that Git Town doesn't push. Convert it into a feature branch? | [DOWN][ENTER] |
+    Then it prints the error:
+      """
+      branch "prototype" is a prototype branch, please convert it into a feature branch first
+      """
+    And branch "prototype" is still a prototype branch
@@ -0,0 +1,54 @@
+Feature: observe a branch
+
+  Scenario: observe the current branch
+    Given a feature branch "feature"
+    And the current branch is "feature"
+    When I run "git-town observe"
+    Then it prints:
+      """
+      branch "feature" is now observed
+      """
+    And local setting "observed-branches" is now "feature"
+    And no branch hierarchy exists now
+
+  Scenario: undo observing a branch
+    Given a feature branch "feature"
+    And the current branch is "feature"
+    And I ran "git-town observe"
+    When I run "git-town undo"
+    Then local setting "observed-branches" no longer exists
+    And this branch lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+
+  Scenario: observe the given branch
+    Given a feature branch "feature"
+    When I run "git-town observe feature"
+    Then it prints:
+      """
+      branch "feature" is now observed
+      """
+    And local setting "observed-branches" is now "feature"
+
+  Scenario: branch is already observed
+    Given an observed branch "observed"
+    When I run "git-town observe observed"
+    Then it prints the error:
+      """
+      branch "observed" is already observed
+      """
+
+  Scenario: main branch
+    When I run "git-town observe main"
+    Then it prints the error:
+      """
+      branch "main" is not a feature branch, only feature branches can be observed
+      """
+    And local setting "observed-branches" no longer exists
+
+  Scenario: non-existing branch
+    When I run "git-town observe zonk"
+    Then it prints the error:
+      """
+      there is no branch "zonk"
+      """
@@ -0,0 +1,67 @@
+Feature: mark a feature branch as a prototype branch
+
+  Scenario: mark the current branch
+    Given a feature branch "feature"
+    And the current branch is "feature"
+    When I run "git-town prototype"
+    Then it prints:
+      """
+      branch "feature" is now a prototype branch
+      """
+    And branch "feature" is now a prototype branch
+
+  Scenario: mark the given branch
+    Given a feature branch "feature"
+    When I run "git-town prototype feature"
+    Then it prints:
+      """
+      branch "feature" is now a prototype branch
+      """
+    And branch "feature" is now a prototype branch
+
+  Scenario: branch is already a prototype branch
+    Given a feature branch "feature"
+    And branch "feature" is a prototype branch
+    When I run "git-town prototype feature"
+    Then it prints the error:
+      """
+      branch "feature" is already a prototype branch
+      """
+
+  Scenario: main branch
+    When I run "git-town prototype main"
+    Then it prints the error:
+      """
+      branch "main" is not a feature branch, only feature branches can be prototypes
+      """
+
+  Scenario: convert back into a feature branch
+    Given a feature branch "feature"
+    And branch "feature" is a prototype branch
+    When I run "git-town prototype --remove feature"
+    Then it prints:
+      """
+      branch "feature" is now a feature branch
+      """
+    And branch "feature" is no longer a prototype branch
+
+  Scenario: convert a branch that isn't a prototype branch
+    Given a feature branch "feature"
+    When I run "git-town prototype --remove feature"
+    Then it prints the error:
+      """
+      branch "feature" is not a prototype branch
+      """
+
+  Scenario: undo marking a branch as a prototype branch
+    Given a feature branch "feature"
+    And I ran "git-town prototype feature"
+    When I run "git-town undo"
+    Then branch "feature" is no longer a prototype branch
+
+  Scenario: undo converting a prototype branch back into a feature branch
+    Given a feature branch "feature"
+    And branch "feature" is a prototype branch
+    And I ran "git-town prototype --remove feature"
+    When I run "git-town undo"
+    Then branch "feature" is now a prototype branch
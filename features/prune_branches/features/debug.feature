@@ -12,30 +12,29 @@ Feature: display debug statistics
   Scenario: result
     When I run "git-town prune-branches --debug"
     Then it runs the commands
-      | BRANCH | TYPE     | COMMAND                                       |
-      |        | backend  | git version                                   |
-      |        | backend  | git config -lz --global                       |
-      |        | backend  | git config -lz --local                        |
-      |        | backend  | git rev-parse --show-toplevel                 |
-      |        | backend  | git remote                                    |
-      |        | backend  | git status                                    |
-      |        | backend  | git rev-parse --abbrev-ref HEAD               |
-      | old    | frontend | git fetch --prune --tags                      |
-      |        | backend  | git branch -vva                               |
-      |        | backend  | git rev-parse --verify --abbrev-ref @{-1}     |
-      | old    | frontend | git checkout main                             |
-      |        | backend  | git config --unset git-town-branch.old.parent |
-      |        | backend  | git rev-parse --short old                     |
-      |        | backend  | git log main..old                             |
-      | main   | frontend | git branch -D old                             |
-      |        | backend  | git show-ref --quiet refs/heads/main          |
-      |        | backend  | git show-ref --quiet refs/heads/old           |
-      |        | backend  | git rev-parse --verify --abbrev-ref @{-1}     |
-      |        | backend  | git checkout main                             |
-      |        | backend  | git checkout main                             |
+      | BRANCH | TYPE     | COMMAND                                                                                                                                                             |
+      |        | backend  | git version                                                                                                                                                         |
+      |        | backend  | git config -lz --global                                                                                                                                             |
+      |        | backend  | git config -lz --local                                                                                                                                              |
+      |        | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |        | backend  | git remote                                                                                                                                                          |
+      |        | backend  | git status                                                                                                                                                          |
+      |        | backend  | git rev-parse --abbrev-ref HEAD                                                                                                                                     |
+      | old    | frontend | git fetch --prune --tags                                                                                                                                            |
+      |        | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |        | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      | old    | frontend | git checkout main                                                                                                                                                   |
+      |        | backend  | git config --unset git-town-branch.old.parent                                                                                                                       |
+      |        | backend  | git rev-parse --short old                                                                                                                                           |
+      |        | backend  | git log main..old                                                                                                                                                   |
+      | main   | frontend | git branch -D old                                                                                                                                                   |
+      |        | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/                                                                                                                                |
+      |        | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |        | backend  | git checkout main                                                                                                                                                   |
+      |        | backend  | git checkout main                                                                                                                                                   |
     And it prints:
       """
-      Ran 20 shell commands.
+      Ran 19 shell commands.
       """
     And the current branch is now "main"
     And the branches are now
@@ -49,15 +48,15 @@ Feature: display debug statistics
     Given I ran "git-town prune-branches"
     When I run "git-town undo --debug"
     Then it runs the commands
-      | BRANCH | TYPE     | COMMAND                                    |
-      |        | backend  | git version                                |
-      |        | backend  | git config -lz --global                    |
-      |        | backend  | git config -lz --local                     |
-      |        | backend  | git rev-parse --show-toplevel              |
-      |        | backend  | git branch -vva                            |
-      | main   | frontend | git branch old {{ sha 'old commit' }}      |
-      |        | backend  | git config git-town-branch.old.parent main |
-      | main   | frontend | git checkout old                           |
+      | BRANCH | TYPE     | COMMAND                                                                                                                                                             |
+      |        | backend  | git version                                                                                                                                                         |
+      |        | backend  | git config -lz --global                                                                                                                                             |
+      |        | backend  | git config -lz --local                                                                                                                                              |
+      |        | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |        | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      | main   | frontend | git branch old {{ sha 'old commit' }}                                                                                                                               |
+      |        | backend  | git config git-town-branch.old.parent main                                                                                                                          |
+      | main   | frontend | git checkout old                                                                                                                                                    |
     And it prints:
       """
       Ran 8 shell commands.
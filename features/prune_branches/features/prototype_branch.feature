@@ -0,0 +1,32 @@
+Feature: remove the prototype configuration when pruning a prototype branch
+
+  Background:
+    Given the feature branches "active" and "old"
+    And branch "old" is a prototype branch
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | active | local, origin | active commit |
+      | old    | local, origin | old commit    |
+    And origin deletes the "old" branch
+    And the current branch is "active"
+    When I run "git-town prune-branches"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | active | git fetch --prune --tags |
+      |        | git branch -D old        |
+    And the current branch is still "active"
+    And the branches are now
+      | REPOSITORY    | BRANCHES     |
+      | local, origin | main, active |
+    And branch "old" is no longer a prototype branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | active | git branch old {{ sha 'old commit' }} |
+    And the current branch is still "active"
+    And the initial branches and hierarchy exist
+    And branch "old" is still a prototype branch
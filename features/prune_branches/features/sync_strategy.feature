@@ -0,0 +1,30 @@
+Feature: remove the sync strategy of a pruned branch
+
+  Background:
+    Given the feature branches "active" and "old"
+    And branch "old" uses the "rebase" sync strategy
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | active | local, origin | active commit |
+      | old    | local, origin | old commit    |
+    And origin deletes the "old" branch
+    And the current branch is "active"
+    When I run "git-town prune-branches"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | active | git fetch --prune --tags |
+      |        | git branch -D old        |
+    And the branches are now
+      | REPOSITORY    | BRANCHES     |
+      | local, origin | main, active |
+    And branch "old" no longer has its own sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | active | git branch old {{ sha 'old commit' }} |
+    And the initial branches and hierarchy exist
+    And branch "old" now uses the "rebase" sync strategy
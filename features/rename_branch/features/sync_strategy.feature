@@ -0,0 +1,36 @@
+Feature: rename a branch that uses its own sync strategy
+
+  Background:
+    Given the current branch is a feature branch "old"
+    And branch "old" uses the "rebase" sync strategy
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | old    | local, origin | old commit |
+    When I run "git-town rename-branch old new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | old    | git fetch --prune --tags |
+      |        | git branch new old       |
+      |        | git checkout new         |
+      | new    | git push -u origin new   |
+      |        | git push origin :old     |
+      |        | git branch -D old        |
+    And the current branch is now "new"
+    And branch "new" now uses the "rebase" sync strategy
+    And branch "old" no longer has its own sync strategy
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                               |
+      | new    | git branch old {{ sha 'old commit' }} |
+      |        | git push -u origin old                |
+      |        | git push origin :new                  |
+      |        | git checkout old                      |
+      | old    | git branch -D new                     |
+    And the current branch is now "old"
+    And branch "old" now uses the "rebase" sync strategy
+    And branch "new" no longer has its own sync strategy
+    And the initial branches and hierarchy exist
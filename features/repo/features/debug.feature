@@ -5,17 +5,17 @@ Feature: display debug statistics
     And tool "open" is installed
     When I run "git-town repo --debug"
     Then it runs the commands
-      | BRANCH | TYPE     | COMMAND                                   |
-      |        | backend  | git version                               |
-      |        | backend  | git config -lz --global                   |
-      |        | backend  | git config -lz --local                    |
-      |        | backend  | git rev-parse --show-toplevel             |
-      |        | backend  | git branch -vva                           |
-      |        | backend  | which wsl-open                            |
-      |        | backend  | which garcon-url-handler                  |
-      |        | backend  | which xdg-open                            |
-      |        | backend  | which open                                |
-      | <none> | frontend | open https://github.com/git-town/git-town |
+      | BRANCH | TYPE     | COMMAND                                                                                                                                                             |
+      |        | backend  | git version                                                                                                                                                         |
+      |        | backend  | git config -lz --global                                                                                                                                             |
+      |        | backend  | git config -lz --local                                                                                                                                              |
+      |        | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |        | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |        | backend  | which wsl-open                                                                                                                                                      |
+      |        | backend  | which garcon-url-handler                                                                                                                                            |
+      |        | backend  | which xdg-open                                                                                                                                                      |
+      |        | backend  | which open                                                                                                                                                          |
+      | <none> | frontend | open https://github.com/git-town/git-town                                                                                                                           |
     And it prints:
       """
       Ran 10 shell commands.
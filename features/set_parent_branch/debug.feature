@@ -8,14 +8,14 @@ Feature: display debug statistics
       | PROMPT                                      | ANSWER        |
       | Please specify the parent branch of 'child' | [DOWN][ENTER] |
     Then it runs the commands
-      | BRANCH | TYPE    | COMMAND                                         |
-      |        | backend | git version                                     |
-      |        | backend | git config -lz --global                         |
-      |        | backend | git config -lz --local                          |
-      |        | backend | git rev-parse --show-toplevel                   |
-      |        | backend | git branch -vva                                 |
-      |        | backend | git config --unset git-town-branch.child.parent |
-      |        | backend | git config git-town-branch.child.parent main    |
+      | BRANCH | TYPE    | COMMAND                                                                                                                                                             |
+      |        | backend | git version                                                                                                                                                         |
+      |        | backend | git config -lz --global                                                                                                                                             |
+      |        | backend | git config -lz --local                                                                                                                                              |
+      |        | backend | git rev-parse --show-toplevel                                                                                                                                       |
+      |        | backend | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |        | backend | git config --unset git-town-branch.child.parent                                                                                                                     |
+      |        | backend | git config git-town-branch.child.parent main                                                                                                                        |
     And it prints:
       """
       Ran 7 shell commands.
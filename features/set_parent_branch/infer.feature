@@ -0,0 +1,69 @@
+@skipWindows
+Feature: infer the parent of a feature branch
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   |
+      | parent | local, origin | parent commit | parent_file |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  |
+      | child  | local, origin | child commit | child_file |
+    And Git Town does not know the parent of "child"
+    And the current branch is "child"
+
+  Scenario: apply the inferred parent
+    When I run "git-town set-parent --yes"
+    Then it prints:
+      """
+      branch "child": parent "parent" at distance 1 (confidence: high)
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+
+  Scenario: confirm the inferred parent
+    When I run "git-town set-parent --infer" and answer the prompts:
+      | PROMPT                                      | ANSWER  |
+      | Please specify the parent branch of 'child' | [ENTER] |
+    Then it prints:
+      """
+      branch "child": parent "parent" at distance 1 (confidence: high)
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+
+  Scenario: several equally near branches
+    Given a feature branch "sibling" as a child of "parent"
+    When I run "git-town set-parent --yes"
+    Then it prints:
+      """
+      branch "child": parent "parent", equally near as sibling (confidence: medium)
+      """
+    And this branch lineage exists now
+      | BRANCH  | PARENT |
+      | child   | parent |
+      | parent  | main   |
+      | sibling | parent |
+
+  Scenario: infer the parents of all branches without a parent
+    Given a branch "other"
+    And the current branch is "main"
+    When I run "git-town set-parent --yes"
+    Then it prints:
+      """
+      branch "child": parent "parent" at distance 1 (confidence: high)
+      """
+    And it prints:
+      """
+      branch "other": parent "main" at distance 0 (confidence: high)
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | other  | main   |
+      | parent | main   |
@@ -9,19 +9,19 @@ Feature: commit message with double-quotes
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                              |
-      | feature | git fetch --prune --tags             |
-      |         | git checkout main                    |
-      | main    | git rebase origin/main               |
-      |         | git checkout feature                 |
-      | feature | git merge --no-edit origin/feature   |
-      |         | git merge --no-edit main             |
-      |         | git checkout main                    |
-      | main    | git merge --squash feature           |
-      |         | git commit -m "with "double quotes"" |
-      |         | git push                             |
-      |         | git push origin :feature             |
-      |         | git branch -D feature                |
+      | BRANCH  | COMMAND                                                            |
+      | feature | git fetch --prune --tags                                           |
+      |         | git checkout main                                                  |
+      | main    | git rebase origin/main                                             |
+      |         | git checkout feature                                               |
+      | feature | git merge --no-edit origin/feature                                 |
+      |         | git merge --no-edit main                                           |
+      |         | git checkout main                                                  |
+      | main    | git merge --squash feature                                         |
+      |         | git commit -m "with "double quotes"\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                           |
+      |         | git push origin :feature                                           |
+      |         | git branch -D feature                                              |
     And the current branch is now "main"
     And the branches are now
       | REPOSITORY    | BRANCHES |
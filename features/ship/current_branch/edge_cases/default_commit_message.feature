@@ -18,7 +18,7 @@ Feature: must provide a commit message
       |         | git merge --no-edit main           |
       |         | git checkout main                  |
       | main    | git merge --squash feature         |
-      |         | git commit                         |
+      |         | git commit -t .git/SQUASH_MSG      |
       |         | git reset --hard                   |
       |         | git checkout feature               |
       | feature | git checkout main                  |
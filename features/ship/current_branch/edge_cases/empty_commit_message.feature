@@ -19,7 +19,7 @@ Feature: abort the ship by empty commit message
       |         | git merge --no-edit main           |
       |         | git checkout main                  |
       | main    | git merge --squash feature         |
-      |         | git commit                         |
+      |         | git commit -t .git/SQUASH_MSG      |
       |         | git reset --hard                   |
       |         | git checkout feature               |
       | feature | git checkout main                  |
@@ -0,0 +1,20 @@
+Feature: ship with an invalid commit message template
+
+  Background:
+    Given setting "ship-message-template" is "{{ .Branch"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    When I run "git-town ship"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot parse the ship message template
+      """
+    And the current branch is still "feature"
+    And now the initial commits exist
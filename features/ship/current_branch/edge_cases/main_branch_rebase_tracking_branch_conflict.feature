@@ -41,18 +41,18 @@ Feature: handle conflicts between the main branch and its tracking branch
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue" and close the editor
     Then it runs the commands
-      | BRANCH  | COMMAND                            |
-      | main    | git rebase --continue              |
-      |         | git push                           |
-      |         | git checkout feature               |
-      | feature | git merge --no-edit origin/feature |
-      |         | git merge --no-edit main           |
-      |         | git checkout main                  |
-      | main    | git merge --squash feature         |
-      |         | git commit -m "feature done"       |
-      |         | git push                           |
-      |         | git push origin :feature           |
-      |         | git branch -D feature              |
+      | BRANCH  | COMMAND                                                    |
+      | main    | git rebase --continue                                      |
+      |         | git push                                                   |
+      |         | git checkout feature                                       |
+      | feature | git merge --no-edit origin/feature                         |
+      |         | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
     And the current branch is now "main"
     And the branches are now
       | REPOSITORY    | BRANCHES |
@@ -69,15 +69,15 @@ Feature: handle conflicts between the main branch and its tracking branch
     And I run "git rebase --continue" and close the editor
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                            |
-      | main    | git push                           |
-      |         | git checkout feature               |
-      | feature | git merge --no-edit origin/feature |
-      |         | git merge --no-edit main           |
-      |         | git checkout main                  |
-      | main    | git merge --squash feature         |
-      |         | git commit -m "feature done"       |
-      |         | git push                           |
-      |         | git push origin :feature           |
-      |         | git branch -D feature              |
+      | BRANCH  | COMMAND                                                    |
+      | main    | git push                                                   |
+      |         | git checkout feature                                       |
+      | feature | git merge --no-edit origin/feature                         |
+      |         | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
     And the current branch is now "main"
@@ -0,0 +1,84 @@
+Feature: shipping a stack stops at the first branch with conflicts
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                   | FILE NAME        | FILE CONTENT   |
+      | alpha  | local    | alpha commit              | alpha_file       | alpha content  |
+      | beta   | local    | conflicting local commit  | conflicting_file | local content  |
+      |        | origin   | conflicting origin commit | conflicting_file | origin content |
+    And the current branch is "beta"
+    When I run "git-town ship --stack" and enter these commit messages:
+      | MESSAGE    |
+      | alpha done |
+      | beta done  |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash alpha         |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git push origin :alpha           |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit alpha        |
+      |        | git branch -D alpha              |
+      |        | git merge --no-edit origin/beta  |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is now "beta"
+    And a merge is now in progress
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and enter "beta done" for the commit message
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | beta   | git commit --no-edit          |
+      |        | git merge --no-edit main      |
+      |        | git checkout main             |
+      | main   | git merge --squash beta       |
+      |        | git commit -t .git/SQUASH_MSG |
+      |        | git push                      |
+      |        | git push origin :beta         |
+      |        | git branch -D beta            |
+    And the current branch is now "main"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE    |
+      | main   | local, origin | alpha done |
+      |        |               | beta done  |
+    And no branch hierarchy exists now
+
+  Scenario: abort
+    When I run "git-town abort"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                       |
+      | beta   | git merge --abort                                             |
+      |        | git branch alpha {{ sha 'alpha commit' }}                     |
+      |        | git reset --hard {{ sha-initial 'conflicting local commit' }} |
+      |        | git checkout main                                             |
+      | main   | git push -u origin alpha                                      |
+      |        | git revert {{ sha 'alpha done' }}                             |
+      |        | git push                                                      |
+      |        | git checkout alpha                                            |
+      | alpha  | git checkout main                                             |
+      | main   | git checkout beta                                             |
+    And the current branch is now "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE                   |
+      | main   | local, origin | alpha done                |
+      |        |               | Revert "alpha done"       |
+      | alpha  | local, origin | alpha commit              |
+      | beta   | local         | conflicting local commit  |
+      |        | origin        | conflicting origin commit |
+    And the initial branches and hierarchy exist
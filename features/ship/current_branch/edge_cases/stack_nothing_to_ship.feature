@@ -0,0 +1,102 @@
+Feature: shipping a stack keeps the shipped branches when a later branch has no shippable changes
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+    And the current branch is "beta"
+    When I run "git-town ship --stack" and enter "alpha done" for the commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | beta   | git fetch --prune --tags                  |
+      |        | git checkout main                         |
+      | main   | git rebase origin/main                    |
+      |        | git checkout alpha                        |
+      | alpha  | git merge --no-edit origin/alpha          |
+      |        | git merge --no-edit main                  |
+      |        | git checkout main                         |
+      | main   | git merge --squash alpha                  |
+      |        | git commit -t .git/SQUASH_MSG             |
+      |        | git push                                  |
+      |        | git push origin :alpha                    |
+      |        | git checkout beta                         |
+      | beta   | git merge --no-edit alpha                 |
+      |        | git branch -D alpha                       |
+      |        | git merge --no-edit origin/beta           |
+      |        | git merge --no-edit main                  |
+      |        | git reset --hard {{ sha 'alpha commit' }} |
+    And it prints the error:
+      """
+      the branch "beta" has no shippable changes
+      """
+    And it prints the error:
+      """
+      To try this part of the command again, run "git-town continue".
+      """
+    And the current branch is still "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | alpha done   |
+      | beta   | local         | alpha commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | beta   | main   |
+
+  Scenario: add commits and continue
+    Given the commits
+      | BRANCH | LOCATION | MESSAGE     | FILE NAME | FILE CONTENT |
+      | beta   | local    | beta commit | beta_file | beta content |
+    When I run "git-town continue" and enter "beta done" for the commit message
+    Then it runs the commands
+      | BRANCH | COMMAND                         |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+      |        | git checkout main               |
+      | main   | git merge --squash beta         |
+      |        | git commit -t .git/SQUASH_MSG   |
+      |        | git push                        |
+      |        | git push origin :beta           |
+      |        | git branch -D beta              |
+    And the current branch is now "main"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE    |
+      | main   | local, origin | alpha done |
+      |        |               | beta done  |
+    And no branch hierarchy exists now
+
+  Scenario: skip
+    When I run "git-town skip"
+    Then it runs no commands
+    And the current branch is still "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | alpha done   |
+      | beta   | local         | alpha commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | beta   | main   |
+
+  Scenario: abort
+    When I run "git-town abort"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | beta   | git branch alpha {{ sha 'alpha commit' }}   |
+      |        | git reset --hard {{ sha 'Initial commit' }} |
+      |        | git checkout main                           |
+      | main   | git push -u origin alpha                    |
+      |        | git revert {{ sha 'alpha done' }}           |
+      |        | git push                                    |
+      |        | git checkout alpha                          |
+      | alpha  | git checkout main                           |
+      | main   | git checkout beta                           |
+    And the current branch is now "beta"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE             |
+      | main   | local, origin | alpha done          |
+      |        |               | Revert "alpha done" |
+      | alpha  | local, origin | alpha commit        |
+    And the initial branches and hierarchy exist
@@ -0,0 +1,16 @@
+Feature: cannot ship a stack with a single commit message
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    When I run "git-town ship --stack -m done"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --message and --stack flags cannot be combined because each shipped branch needs its own commit message
+      """
+    And the current branch is still "beta"
+    And the initial branches and hierarchy exist
@@ -0,0 +1,19 @@
+Feature: ship without recording the shipped branch in commit trailers
+
+  Background:
+    Given setting "ship-changelog-trailers" is "false"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | feature | local    | feature commit |
+    When I run "git-town ship -m 'feature done'"
+
+  Scenario: result
+    Then now these commits exist
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | feature done |
+    And the commit "feature done" in branch "main" now has the message:
+      """
+      feature done
+      """
+    And no branch hierarchy exists now
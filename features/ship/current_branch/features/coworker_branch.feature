@@ -9,19 +9,19 @@ Feature: ship a coworker's feature branch
   Scenario: result (commit message via CLI)
     When I run "git-town ship -m 'feature done'"
     Then it runs the commands
-      | BRANCH  | COMMAND                                                                 |
-      | feature | git fetch --prune --tags                                                |
-      |         | git checkout main                                                       |
-      | main    | git rebase origin/main                                                  |
-      |         | git checkout feature                                                    |
-      | feature | git merge --no-edit origin/feature                                      |
-      |         | git merge --no-edit main                                                |
-      |         | git checkout main                                                       |
-      | main    | git merge --squash feature                                              |
-      |         | git commit -m "feature done" --author "coworker <coworker@example.com>" |
-      |         | git push                                                                |
-      |         | git push origin :feature                                                |
-      |         | git branch -D feature                                                   |
+      | BRANCH  | COMMAND                                                                                               |
+      | feature | git fetch --prune --tags                                                                              |
+      |         | git checkout main                                                                                     |
+      | main    | git rebase origin/main                                                                                |
+      |         | git checkout feature                                                                                  |
+      | feature | git merge --no-edit origin/feature                                                                    |
+      |         | git merge --no-edit main                                                                              |
+      |         | git checkout main                                                                                     |
+      | main    | git merge --squash feature                                                                            |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" --author "coworker <coworker@example.com>" |
+      |         | git push                                                                                              |
+      |         | git push origin :feature                                                                              |
+      |         | git branch -D feature                                                                                 |
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE      | AUTHOR                          |
       | main   | local, origin | feature done | coworker <coworker@example.com> |
@@ -30,19 +30,19 @@ Feature: ship a coworker's feature branch
   Scenario: result (commit message via editor)
     When I run "git-town ship" and enter "feature done" for the commit message
     Then it runs the commands
-      | BRANCH  | COMMAND                                               |
-      | feature | git fetch --prune --tags                              |
-      |         | git checkout main                                     |
-      | main    | git rebase origin/main                                |
-      |         | git checkout feature                                  |
-      | feature | git merge --no-edit origin/feature                    |
-      |         | git merge --no-edit main                              |
-      |         | git checkout main                                     |
-      | main    | git merge --squash feature                            |
-      |         | git commit --author "coworker <coworker@example.com>" |
-      |         | git push                                              |
-      |         | git push origin :feature                              |
-      |         | git branch -D feature                                 |
+      | BRANCH  | COMMAND                                                                  |
+      | feature | git fetch --prune --tags                                                 |
+      |         | git checkout main                                                        |
+      | main    | git rebase origin/main                                                   |
+      |         | git checkout feature                                                     |
+      | feature | git merge --no-edit origin/feature                                       |
+      |         | git merge --no-edit main                                                 |
+      |         | git checkout main                                                        |
+      | main    | git merge --squash feature                                               |
+      |         | git commit -t .git/SQUASH_MSG --author "coworker <coworker@example.com>" |
+      |         | git push                                                                 |
+      |         | git push origin :feature                                                 |
+      |         | git branch -D feature                                                    |
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE      | AUTHOR                          |
       | main   | local, origin | feature done | coworker <coworker@example.com> |
@@ -0,0 +1,54 @@
+Feature: ship hotfixes into a perennial branch that merges into downstream branches
+
+  Background:
+    Given the perennial branches "release" and "develop"
+    And perennial branch "release" merges into "develop"
+    And a feature branch "hotfix" as a child of "release"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | hotfix | local, origin | hotfix commit |
+    And the current branch is "hotfix"
+    When I run "git-town ship -m 'hotfix done'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                  |
+      | hotfix  | git fetch --prune --tags                                 |
+      |         | git checkout release                                     |
+      | release | git rebase origin/release                                |
+      |         | git checkout hotfix                                      |
+      | hotfix  | git merge --no-edit origin/hotfix                        |
+      |         | git merge --no-edit release                              |
+      |         | git checkout release                                     |
+      | release | git merge --squash hotfix                                |
+      |         | git commit -m "hotfix done\\n\\nGit-Town-Branch: hotfix" |
+      |         | git push                                                 |
+      |         | git push origin :hotfix                                  |
+      |         | git branch -D hotfix                                     |
+      |         | git checkout develop                                     |
+      | develop | git rebase origin/develop                                |
+      |         | git merge --no-edit release                              |
+      |         | git push                                                 |
+      |         | git checkout release                                     |
+    And the current branch is now "release"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE     |
+      | develop | local, origin | hotfix done |
+      | release | local, origin | hotfix done |
+    And no branch hierarchy exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                     |
+      | release | git checkout develop                        |
+      | develop | git checkout release                        |
+      | release | git branch hotfix {{ sha 'hotfix commit' }} |
+      |         | git push -u origin hotfix                   |
+      |         | git revert {{ sha 'hotfix done' }}          |
+      |         | git push                                    |
+      |         | git checkout hotfix                         |
+      | hotfix  | git checkout release                        |
+      | release | git checkout hotfix                         |
+    And the current branch is now "hotfix"
+    And the initial branches and hierarchy exist
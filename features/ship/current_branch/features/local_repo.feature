@@ -10,12 +10,12 @@ Feature: ship a feature branch in a local repo
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | feature | git merge --no-edit main     |
-      |         | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git branch -D feature        |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git branch -D feature                                      |
     And the current branch is now "main"
     And the branches are now
       | REPOSITORY | BRANCHES |
@@ -0,0 +1,59 @@
+Feature: ship with a commit message template
+
+  Background:
+    Given setting "ship-message-template" is:
+      """
+      {{ .Branch }} into {{ .Parent }}
+      {{ range .Commits }}
+      - {{ . }}{{ end }}
+      """
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE          |
+      | feature | local, origin | feature commit 1 |
+      |         |               | feature commit 2 |
+    When I run "git-town ship"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                                                      |
+      | feature | git fetch --prune --tags                                                                                     |
+      |         | git checkout main                                                                                            |
+      | main    | git rebase origin/main                                                                                       |
+      |         | git checkout feature                                                                                         |
+      | feature | git merge --no-edit origin/feature                                                                           |
+      |         | git merge --no-edit main                                                                                     |
+      |         | git checkout main                                                                                            |
+      | main    | git merge --squash feature                                                                                   |
+      |         | git commit -m "feature into main\\n\\n- feature commit 1\\n- feature commit 2\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                                                                     |
+      |         | git push origin :feature                                                                                     |
+      |         | git branch -D feature                                                                                        |
+    And the current branch is now "main"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE           |
+      | main   | local, origin | feature into main |
+    And the commit "feature into main" in branch "main" now has the message:
+      """
+      feature into main
+
+      - feature commit 1
+      - feature commit 2
+
+      Git-Town-Branch: feature
+      """
+    And no branch hierarchy exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | main    | git branch feature {{ sha 'feature commit 2' }} |
+      |         | git push -u origin feature                      |
+      |         | git revert {{ sha 'feature into main' }}        |
+      |         | git push                                        |
+      |         | git checkout feature                            |
+      | feature | git checkout main                               |
+      | main    | git checkout feature                            |
+    And the current branch is now "feature"
+    And the initial branches and hierarchy exist
@@ -16,6 +16,13 @@ Feature: ship a coworker's feature branch
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE      | AUTHOR                            |
       | main   | local, origin | feature done | developer <developer@example.com> |
+    And the commit "feature done" in branch "main" now has the message:
+      """
+      feature done
+
+      Git-Town-Branch: feature
+      Co-authored-by: coworker <coworker@example.com>
+      """
     And no branch hierarchy exists now
 
   Scenario: choose a coworker as the author
@@ -25,6 +32,30 @@ Feature: ship a coworker's feature branch
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE      | AUTHOR                          |
       | main   | local, origin | feature done | coworker <coworker@example.com> |
+    And the commit "feature done" in branch "main" now has the message:
+      """
+      feature done
+
+      Git-Town-Branch: feature
+      Co-authored-by: developer <developer@example.com>
+      """
+    And no branch hierarchy exists now
+
+  Scenario: commit message template
+    Given setting "ship-message-template" is "{{ .Branch }} done"
+    When I run "git-town ship" and answer the prompts:
+      | PROMPT                                        | ANSWER  |
+      | Please choose an author for the squash commit | [ENTER] |
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE      | AUTHOR                            |
+      | main   | local, origin | feature done | developer <developer@example.com> |
+    And the commit "feature done" in branch "main" now has the message:
+      """
+      feature done
+
+      Git-Town-Branch: feature
+      Co-authored-by: coworker <coworker@example.com>
+      """
     And no branch hierarchy exists now
 
   Scenario:  undo
@@ -50,3 +81,15 @@ Feature: ship a coworker's feature branch
       |         |               | developer commit 2    |
       |         |               | coworker commit       |
     And the initial branches and hierarchy exist
+
+  Scenario: without changelog trailers
+    Given setting "ship-changelog-trailers" is "false"
+    When I run "git-town ship -m 'feature done'" and answer the prompts:
+      | PROMPT                                        | ANSWER  |
+      | Please choose an author for the squash commit | [ENTER] |
+    And the commit "feature done" in branch "main" now has the message:
+      """
+      feature done
+
+      Co-authored-by: coworker <coworker@example.com>
+      """
@@ -10,16 +10,16 @@ Feature: offline mode
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                            |
-      | feature | git checkout main                  |
-      | main    | git rebase origin/main             |
-      |         | git checkout feature               |
-      | feature | git merge --no-edit origin/feature |
-      |         | git merge --no-edit main           |
-      |         | git checkout main                  |
-      | main    | git merge --squash feature         |
-      |         | git commit -m "feature done"       |
-      |         | git branch -D feature              |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git checkout main                                          |
+      | main    | git rebase origin/main                                     |
+      |         | git checkout feature                                       |
+      | feature | git merge --no-edit origin/feature                         |
+      |         | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git branch -D feature                                      |
     And the current branch is now "main"
     And now these commits exist
       | BRANCH  | LOCATION | MESSAGE        |
@@ -11,18 +11,18 @@ Feature: ship a parent branch
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                           |
-      | parent | git fetch --prune --tags          |
-      |        | git checkout main                 |
-      | main   | git rebase origin/main            |
-      |        | git checkout parent               |
-      | parent | git merge --no-edit origin/parent |
-      |        | git merge --no-edit main          |
-      |        | git checkout main                 |
-      | main   | git merge --squash parent         |
-      |        | git commit -m "parent done"       |
-      |        | git push                          |
-      |        | git branch -D parent              |
+      | BRANCH | COMMAND                                                  |
+      | parent | git fetch --prune --tags                                 |
+      |        | git checkout main                                        |
+      | main   | git rebase origin/main                                   |
+      |        | git checkout parent                                      |
+      | parent | git merge --no-edit origin/parent                        |
+      |        | git merge --no-edit main                                 |
+      |        | git checkout main                                        |
+      | main   | git merge --squash parent                                |
+      |        | git commit -m "parent done\\n\\nGit-Town-Branch: parent" |
+      |        | git push                                                 |
+      |        | git branch -D parent                                     |
     And the current branch is now "main"
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE       |
@@ -0,0 +1,36 @@
+Feature: ship into a branch that deletes remote branches although the general setting keeps them
+
+  Background:
+    Given setting "ship-delete-remote-branch" is "false"
+    And a perennial branch "release"
+    And shipping into branch "release" deletes the remote branch
+    And a feature branch "fix" as a child of "release"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | fix    | local, origin | fix commit |
+    And the current branch is "fix"
+    When I run "git-town ship -m 'fix done'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                            |
+      | fix     | git fetch --prune --tags                           |
+      |         | git checkout release                               |
+      | release | git rebase origin/release                          |
+      |         | git checkout fix                                   |
+      | fix     | git merge --no-edit origin/fix                     |
+      |         | git merge --no-edit release                        |
+      |         | git checkout release                               |
+      | release | git merge --squash fix                             |
+      |         | git commit -m "fix done\\n\\nGit-Town-Branch: fix" |
+      |         | git push                                           |
+      |         | git push origin :fix                               |
+      |         | git branch -D fix                                  |
+    And the current branch is now "release"
+    And the branches are now
+      | REPOSITORY    | BRANCHES      |
+      | local, origin | main, release |
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | release | local, origin | fix done |
+    And no branch hierarchy exists now
@@ -0,0 +1,56 @@
+Feature: ship into a perennial branch that has its own ship settings
+
+  Background:
+    Given a perennial branch "release"
+    And branch "release" ships with the "merge" strategy
+    And shipping into branch "release" keeps the remote branch
+    And a feature branch "fix" as a child of "release"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE    |
+      | fix    | local, origin | fix commit |
+    And the current branch is "fix"
+    When I run "git-town ship -m 'fix done'"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                       |
+      | fix     | git fetch --prune --tags                                      |
+      |         | git checkout release                                          |
+      | release | git rebase origin/release                                     |
+      |         | git checkout fix                                              |
+      | fix     | git merge --no-edit origin/fix                                |
+      |         | git merge --no-edit release                                   |
+      |         | git checkout release                                          |
+      | release | git merge --no-ff -m "fix done\\n\\nGit-Town-Branch: fix" fix |
+      |         | git push                                                      |
+      |         | git branch -D fix                                             |
+    And the current branch is now "release"
+    And the branches are now
+      | REPOSITORY | BRANCHES           |
+      | local      | main, release      |
+      | origin     | main, fix, release |
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE    |
+      | fix     | origin        | fix commit |
+      | release | local, origin | fix commit |
+      |         |               | fix done   |
+    And no branch hierarchy exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                               |
+      | release | git branch fix {{ sha 'fix commit' }} |
+      |         | git revert -m 1 {{ sha 'fix done' }}  |
+      |         | git push                              |
+      |         | git checkout fix                      |
+      | fix     | git checkout release                  |
+      | release | git checkout fix                      |
+    And the current branch is now "fix"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE           |
+      | fix     | local, origin | fix commit        |
+      | release | local, origin | fix commit        |
+      |         |               | fix done          |
+      |         |               | Revert "fix done" |
+    And the initial branches and hierarchy exist
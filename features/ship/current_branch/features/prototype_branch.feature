@@ -0,0 +1,63 @@
+Feature: ship a prototype branch
+
+  Background:
+    Given a feature branch "prototype"
+    And branch "prototype" is a prototype branch
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | prototype | local    | prototype commit |
+    And the current branch is "prototype"
+
+  Scenario: convert the prototype branch and ship it
+    When I run "git-town ship -m done" and answer the prompts:
+      | PROMPT                                                                                                 | ANSWER  |
+      | Branch "prototype" is a prototype branch that Git Town doesn't push. Convert it into a feature branch? | [ENTER] |
+    Then it runs the commands
+      | BRANCH    | COMMAND                                              |
+      | prototype | git fetch --prune --tags                             |
+      |           | git checkout main                                    |
+      | main      | git rebase origin/main                               |
+      |           | git checkout prototype                               |
+      | prototype | git merge --no-edit origin/prototype                 |
+      |           | git merge --no-edit main                             |
+      |           | git checkout main                                    |
+      | main      | git merge --squash prototype                         |
+      |           | git commit -m "done\\n\\nGit-Town-Branch: prototype" |
+      |           | git push                                             |
+      |           | git push origin :prototype                           |
+      |           | git branch -D prototype                              |
+    And the current branch is now "main"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE |
+      | main   | local, origin | done    |
+    And branch "prototype" is no longer a prototype branch
+
+  Scenario: keep the prototype branch
+    When I run "git-town ship -m done" and answer the prompts:
+      | PROMPT                                                                                                 | ANSWER        |
+      | Branch "prototype" is a prototype branch that Git Town doesn't push. Convert it into a feature branch? | [DOWN][ENTER] |
+    Then it runs the commands
+      | BRANCH    | COMMAND                  |
+      | prototype | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "prototype" is a prototype branch, please convert it into a feature branch first
+      """
+    And branch "prototype" is still a prototype branch
+
+  Scenario: undo
+    Given I ran "git-town ship -m done" and answered the prompts:
+      | PROMPT                                                                                                 | ANSWER  |
+      | Branch "prototype" is a prototype branch that Git Town doesn't push. Convert it into a feature branch? | [ENTER] |
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                           |
+      | main      | git branch prototype {{ sha 'prototype commit' }} |
+      |           | git push -u origin prototype                      |
+      |           | git revert {{ sha 'done' }}                       |
+      |           | git push                                          |
+      |           | git checkout prototype                            |
+      | prototype | git checkout main                                 |
+      | main      | git checkout prototype                            |
+    And the current branch is now "prototype"
+    And branch "prototype" is still a prototype branch
@@ -10,19 +10,19 @@ Feature: "rebase" sync strategy
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                    |
-      | feature | git fetch --prune --tags   |
-      |         | git checkout main          |
-      | main    | git rebase origin/main     |
-      |         | git checkout feature       |
-      | feature | git rebase origin/feature  |
-      |         | git rebase main            |
-      |         | git checkout main          |
-      | main    | git merge --squash feature |
-      |         | git commit                 |
-      |         | git push                   |
-      |         | git push origin :feature   |
-      |         | git branch -D feature      |
+      | BRANCH  | COMMAND                       |
+      | feature | git fetch --prune --tags      |
+      |         | git checkout main             |
+      | main    | git rebase origin/main        |
+      |         | git checkout feature          |
+      | feature | git rebase origin/feature     |
+      |         | git rebase main               |
+      |         | git checkout main             |
+      | main    | git merge --squash feature    |
+      |         | git commit -t .git/SQUASH_MSG |
+      |         | git push                      |
+      |         | git push origin :feature      |
+      |         | git branch -D feature         |
     And the current branch is now "main"
     And the branches are now
       | REPOSITORY    | BRANCHES |
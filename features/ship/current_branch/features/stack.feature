@@ -0,0 +1,114 @@
+Feature: ship a branch together with all its ancestor branches
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+      | gamma  | local, origin | gamma commit | gamma_file | gamma content |
+      | other  | local, origin | other commit | other_file | other content |
+    And the current branch is "gamma"
+    When I run "git-town ship --stack" and enter these commit messages:
+      | MESSAGE    |
+      | alpha done |
+      | beta done  |
+      | gamma done |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | gamma  | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash alpha         |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git push origin :alpha           |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit alpha        |
+      |        | git branch -D alpha              |
+      |        | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash beta          |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git checkout gamma               |
+      | gamma  | git merge --no-edit beta         |
+      |        | git branch -D beta               |
+      |        | git merge --no-edit origin/gamma |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash gamma         |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git push origin :gamma           |
+      |        | git branch -D gamma              |
+    And the current branch is now "main"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | alpha done   |
+      |        |               | beta done    |
+      |        |               | gamma done   |
+      | beta   | origin        | beta commit  |
+      | other  | local, origin | other commit |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | other  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                     |
+      | main   | git branch gamma {{ sha 'Merge branch 'main' into gamma' }} |
+      |        | git push -u origin gamma                                    |
+      |        | git revert {{ sha 'gamma done' }}                           |
+      |        | git push                                                    |
+      |        | git checkout gamma                                          |
+      | gamma  | git reset --hard {{ sha 'Merge branch 'beta' into gamma' }} |
+      |        | git branch beta {{ sha 'Merge branch 'main' into beta' }}   |
+      |        | git reset --hard {{ sha-initial 'gamma commit' }}           |
+      |        | git checkout main                                           |
+      | main   | git revert {{ sha 'beta done' }}                            |
+      |        | git push                                                    |
+      |        | git checkout beta                                           |
+      | beta   | git reset --hard {{ sha 'Merge branch 'alpha' into beta' }} |
+      |        | git branch alpha {{ sha 'alpha commit' }}                   |
+      |        | git reset --hard {{ sha-initial 'beta commit' }}            |
+      |        | git checkout main                                           |
+      | main   | git push -u origin alpha                                    |
+      |        | git revert {{ sha 'alpha done' }}                           |
+      |        | git push                                                    |
+      |        | git checkout alpha                                          |
+      | alpha  | git checkout main                                           |
+      | main   | git checkout gamma                                          |
+    And the current branch is now "gamma"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | alpha done                     |
+      |        |               | beta done                      |
+      |        |               | gamma done                     |
+      |        |               | Revert "gamma done"            |
+      |        |               | Revert "beta done"             |
+      |        |               | Revert "alpha done"            |
+      | alpha  | local, origin | alpha commit                   |
+      | beta   | local, origin | beta commit                    |
+      | gamma  | local, origin | gamma commit                   |
+      |        | origin        | beta commit                    |
+      |        |               | alpha commit                   |
+      |        |               | Merge branch 'alpha' into beta |
+      |        |               | alpha done                     |
+      |        |               | Merge branch 'main' into beta  |
+      |        |               | Merge branch 'beta' into gamma |
+      |        |               | beta done                      |
+      |        |               | Merge branch 'main' into gamma |
+      | other  | local, origin | other commit                   |
+    And the initial branches and hierarchy exist
@@ -0,0 +1,57 @@
+Feature: ship a stack of branches that use the "merge" sync strategy and contain outdated changes of their parents
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE        | FILE NAME  | FILE CONTENT    |
+      | alpha  | local, origin | alpha commit 1 | alpha_file | alpha content 1 |
+      | beta   | local, origin | beta commit    | beta_file  | beta content    |
+    And the current branch is "beta"
+    And I ran "git-town sync"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE        | FILE NAME  | FILE CONTENT    |
+      | alpha  | local, origin | alpha commit 2 | alpha_file | alpha content 2 |
+    When I run "git-town ship --stack" and enter these commit messages:
+      | MESSAGE    |
+      | alpha done |
+      | beta done  |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash alpha         |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git push origin :alpha           |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit alpha        |
+      |        | git branch -D alpha              |
+      |        | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit main         |
+      |        | git checkout main                |
+      | main   | git merge --squash beta          |
+      |        | git commit -t .git/SQUASH_MSG    |
+      |        | git push                         |
+      |        | git push origin :beta            |
+      |        | git branch -D beta               |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE    |
+      | main   | local, origin | alpha done |
+      |        |               | beta done  |
+    And these committed files exist now
+      | BRANCH | NAME       | CONTENT         |
+      | main   | alpha_file | alpha content 2 |
+      |        | beta_file  | beta content    |
+    And no branch hierarchy exists now
@@ -0,0 +1,49 @@
+Feature: ship a stack of branches that use the "rebase" sync strategy
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+    And the current branch is "beta"
+    When I run "git-town ship --stack" and enter these commit messages:
+      | MESSAGE    |
+      | alpha done |
+      | beta done  |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                 |
+      | beta   | git fetch --prune --tags                                |
+      |        | git checkout main                                       |
+      | main   | git rebase origin/main                                  |
+      |        | git checkout alpha                                      |
+      | alpha  | git rebase origin/alpha                                 |
+      |        | git rebase main                                         |
+      |        | git checkout main                                       |
+      | main   | git merge --squash alpha                                |
+      |        | git commit -t .git/SQUASH_MSG                           |
+      |        | git push                                                |
+      |        | git push origin :alpha                                  |
+      |        | git branch -D alpha                                     |
+      |        | git checkout beta                                       |
+      | beta   | git rebase origin/beta                                  |
+      |        | git rebase --onto main {{ sha-initial 'alpha commit' }} |
+      |        | git checkout main                                       |
+      | main   | git merge --squash beta                                 |
+      |        | git commit -t .git/SQUASH_MSG                           |
+      |        | git push                                                |
+      |        | git push origin :beta                                   |
+      |        | git branch -D beta                                      |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE    |
+      | main   | local, origin | alpha done |
+      |        |               | beta done  |
+    And no branch hierarchy exists now
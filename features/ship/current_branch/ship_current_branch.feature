@@ -18,7 +18,7 @@ Feature: enter the commit message interactively via the editor
       |         | git merge --no-edit main           |
       |         | git checkout main                  |
       | main    | git merge --squash feature         |
-      |         | git commit                         |
+      |         | git commit -t .git/SQUASH_MSG      |
       |         | git push                           |
       |         | git push origin :feature           |
       |         | git branch -D feature              |
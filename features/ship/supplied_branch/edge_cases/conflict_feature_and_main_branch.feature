@@ -56,16 +56,16 @@ Feature: handle conflicts between the supplied feature branch and the main branc
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | feature | git commit --no-edit         |
-      |         | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git push                     |
-      |         | git push origin :feature     |
-      |         | git branch -D feature        |
-      |         | git checkout other           |
-      | other   | git stash pop                |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git commit --no-edit                                       |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
     And the branches are now
@@ -84,15 +84,15 @@ Feature: handle conflicts between the supplied feature branch and the main branc
     And I run "git commit --no-edit"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | feature | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git push                     |
-      |         | git push origin :feature     |
-      |         | git branch -D feature        |
-      |         | git checkout other           |
-      | other   | git stash pop                |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
 
@@ -51,17 +51,17 @@ Feature: handle conflicts between the supplied feature branch and its tracking b
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | feature | git commit --no-edit         |
-      |         | git merge --no-edit main     |
-      |         | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git push                     |
-      |         | git push origin :feature     |
-      |         | git branch -D feature        |
-      |         | git checkout other           |
-      | other   | git stash pop                |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git commit --no-edit                                       |
+      |         | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
     And the branches are now
@@ -79,16 +79,16 @@ Feature: handle conflicts between the supplied feature branch and its tracking b
     And I run "git commit --no-edit"
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | feature | git merge --no-edit main     |
-      |         | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git push                     |
-      |         | git push origin :feature     |
-      |         | git branch -D feature        |
-      |         | git checkout other           |
-      | other   | git stash pop                |
+      | BRANCH  | COMMAND                                                    |
+      | feature | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
 
@@ -11,23 +11,23 @@ Feature: ship the supplied feature branch from a subfolder
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                            |
-      | other   | git fetch --prune --tags           |
-      |         | git add -A                         |
-      |         | git stash                          |
-      |         | git checkout main                  |
-      | main    | git rebase origin/main             |
-      |         | git checkout feature               |
-      | feature | git merge --no-edit origin/feature |
-      |         | git merge --no-edit main           |
-      |         | git checkout main                  |
-      | main    | git merge --squash feature         |
-      |         | git commit -m "feature done"       |
-      |         | git push                           |
-      |         | git push origin :feature           |
-      |         | git branch -D feature              |
-      |         | git checkout other                 |
-      | other   | git stash pop                      |
+      | BRANCH  | COMMAND                                                    |
+      | other   | git fetch --prune --tags                                   |
+      |         | git add -A                                                 |
+      |         | git stash                                                  |
+      |         | git checkout main                                          |
+      | main    | git rebase origin/main                                     |
+      |         | git checkout feature                                       |
+      | feature | git merge --no-edit origin/feature                         |
+      |         | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git push                                                   |
+      |         | git push origin :feature                                   |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
     And the branches are now
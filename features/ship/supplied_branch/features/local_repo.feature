@@ -12,17 +12,17 @@ Feature: ship the supplied feature branch in a local repo
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                      |
-      | other   | git add -A                   |
-      |         | git stash                    |
-      |         | git checkout feature         |
-      | feature | git merge --no-edit main     |
-      |         | git checkout main            |
-      | main    | git merge --squash feature   |
-      |         | git commit -m "feature done" |
-      |         | git branch -D feature        |
-      |         | git checkout other           |
-      | other   | git stash pop                |
+      | BRANCH  | COMMAND                                                    |
+      | other   | git add -A                                                 |
+      |         | git stash                                                  |
+      |         | git checkout feature                                       |
+      | feature | git merge --no-edit main                                   |
+      |         | git checkout main                                          |
+      | main    | git merge --squash feature                                 |
+      |         | git commit -m "feature done\\n\\nGit-Town-Branch: feature" |
+      |         | git branch -D feature                                      |
+      |         | git checkout other                                         |
+      | other   | git stash pop                                              |
     And the current branch is now "other"
     And the uncommitted file still exists
     And the branches are now
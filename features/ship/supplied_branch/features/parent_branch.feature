@@ -12,19 +12,19 @@ Feature: ship a parent branch
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                           |
-      | child  | git fetch --prune --tags          |
-      |        | git checkout main                 |
-      | main   | git rebase origin/main            |
-      |        | git checkout parent               |
-      | parent | git merge --no-edit origin/parent |
-      |        | git merge --no-edit main          |
-      |        | git checkout main                 |
-      | main   | git merge --squash parent         |
-      |        | git commit -m "parent done"       |
-      |        | git push                          |
-      |        | git branch -D parent              |
-      |        | git checkout child                |
+      | BRANCH | COMMAND                                                  |
+      | child  | git fetch --prune --tags                                 |
+      |        | git checkout main                                        |
+      | main   | git rebase origin/main                                   |
+      |        | git checkout parent                                      |
+      | parent | git merge --no-edit origin/parent                        |
+      |        | git merge --no-edit main                                 |
+      |        | git checkout main                                        |
+      | main   | git merge --squash parent                                |
+      |        | git commit -m "parent done\\n\\nGit-Town-Branch: parent" |
+      |        | git push                                                 |
+      |        | git branch -D parent                                     |
+      |        | git checkout child                                       |
     And the current branch is now "child"
     And now these commits exist
       | BRANCH | LOCATION      | MESSAGE       |
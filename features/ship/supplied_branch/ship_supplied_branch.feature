@@ -22,7 +22,7 @@ Feature: ship the supplied feature branch
       |         | git merge --no-edit main           |
       |         | git checkout main                  |
       | main    | git merge --squash feature         |
-      |         | git commit                         |
+      |         | git commit -t .git/SQUASH_MSG      |
       |         | git push                           |
       |         | git push origin :feature           |
       |         | git branch -D feature              |
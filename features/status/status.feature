@@ -32,3 +32,80 @@ Feature: describe the status of the current/last Git Town command
       """
       No status file found for this repository.
       """
+
+  Scenario: details of a Git Town command in progress
+    Given the feature branches "alpha" and "beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                  | FILE NAME        | FILE CONTENT  |
+      | main   | local    | conflicting main commit  | conflicting_file | main content  |
+      | alpha  | local    | conflicting alpha commit | conflicting_file | alpha content |
+    And the current branch is "alpha"
+    And I run "git-town sync --all"
+    When I run "git-town status"
+    Then it prints:
+      """
+      You can run "git town abort" to abort it.
+      You can run "git town continue" to finish it.
+      You can run "git town skip" to skip the currently failing step.
+
+      Failed step on branch "alpha":
+        MergeStep {"Branch":"main"}
+
+      Files with merge conflicts:
+        conflicting_file
+
+      Steps that "git town continue" will run:
+        alpha:
+          ContinueMergeStep
+          PushCurrentBranchStep {"CurrentBranch":"alpha","NoPushHook":false,"Undoable":false}
+        beta:
+          CheckoutStep {"Branch":"beta"}
+          MergeStep {"Branch":"origin/beta"}
+          MergeStep {"Branch":"main"}
+          PushCurrentBranchStep {"CurrentBranch":"beta","NoPushHook":false,"Undoable":false}
+        alpha:
+          CheckoutStep {"Branch":"alpha"}
+          PushTagsStep
+          PreserveCheckoutHistoryStep {"InitialBranch":"alpha","InitialPreviouslyCheckedOutBranch":"main","MainBranch":"main"}
+
+      Steps that undo the changes made so far:
+        ResetCurrentBranchToSHAStep
+      """
+
+  Scenario: JSON output
+    Given the feature branches "alpha" and "beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                  | FILE NAME        | FILE CONTENT  |
+      | main   | local    | conflicting main commit  | conflicting_file | main content  |
+      | alpha  | local    | conflicting alpha commit | conflicting_file | alpha content |
+    And the current branch is "alpha"
+    And I run "git-town sync --all"
+    When I run "git-town status --json"
+    Then it prints:
+      """
+      {
+        "CanAbort": true,
+        "CanContinue": true,
+        "CanSkip": true,
+        "CanUndo": false,
+        "Command": "sync",
+        "ConflictingFiles": [
+          "conflicting_file"
+        ],
+        "EndBranch": "alpha",
+        "FailedBranches": [],
+        "FailedStep": {
+          "data": {
+            "Branch": "main"
+          },
+          "type": "MergeStep"
+        },
+        "RemainingSteps": [
+          {
+            "Branch": "alpha",
+            "Steps": [
+              {
+                "data": {},
+                "type": "ContinueMergeStep"
+              },
+      """
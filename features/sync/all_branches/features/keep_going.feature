@@ -0,0 +1,133 @@
+Feature: sync all branches and skip the branches with conflicts
+
+  Background:
+    Given the feature branches "alpha", "beta", and "gamma"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME        | FILE CONTENT  |
+      | main   | origin        | main commit  | conflicting_file | main content  |
+      | alpha  | local, origin | alpha commit | feature1_file    | alpha content |
+      | beta   | local, origin | beta commit  | conflicting_file | beta content  |
+      | gamma  | local, origin | gamma commit | feature2_file    | gamma content |
+    And the current branch is "main"
+    When I run "git-town sync --all --keep-going"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | main   | git fetch --prune --tags         |
+      |        | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit main         |
+      |        | git merge --abort                |
+      |        | git checkout gamma               |
+      | gamma  | git merge --no-edit origin/gamma |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout main                |
+      | main   | git push --tags                  |
+    And it prints:
+      """
+      These branches need manual syncing:
+      - beta
+      To sync them one at a time, run "git town continue".
+      To sync a particular one of them, run "git town continue <branch>".
+      """
+    And the current branch is still "main"
+    And no merge is in progress
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      | gamma  | local, origin | gamma commit                   |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into gamma |
+
+  Scenario: continue syncing the failed branch
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND                         |
+      | main   | git checkout beta               |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+    And it prints the error:
+      """
+      To abort, run "git-town abort".
+      To continue after having resolved conflicts, run "git-town continue".
+      """
+    And the current branch is now "beta"
+    And a merge is now in progress
+
+  Scenario: continue syncing the given failed branch
+    When I run "git-town continue beta"
+    Then it runs the commands
+      | BRANCH | COMMAND                         |
+      | main   | git checkout beta               |
+      | beta   | git merge --no-edit origin/beta |
+      |        | git merge --no-edit main        |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is now "beta"
+    And a merge is now in progress
+
+  Scenario: continue syncing a branch that doesn't need manual syncing
+    When I run "git-town continue alpha"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "alpha" doesn't need manual syncing
+      """
+
+  Scenario: resolve and continue
+    When I run "git-town continue"
+    And I resolve the conflict in "conflicting_file"
+    And I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND              |
+      | beta   | git commit --no-edit |
+      |        | git push             |
+      |        | git checkout main    |
+    And the current branch is now "main"
+    And no merge is in progress
+    And these committed files exist now
+      | BRANCH | NAME             | CONTENT          |
+      | main   | conflicting_file | main content     |
+      | alpha  | conflicting_file | main content     |
+      |        | feature1_file    | alpha content    |
+      | beta   | conflicting_file | resolved content |
+      | gamma  | conflicting_file | main content     |
+      |        | feature2_file    | gamma content    |
+
+  Scenario: undo after syncing the failed branch
+    When I run "git-town continue"
+    And I resolve the conflict in "conflicting_file"
+    And I run "git-town continue"
+    And I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | main   | git checkout beta  |
+      | beta   | git checkout main  |
+      | main   | git checkout gamma |
+      | gamma  | git checkout beta  |
+      | beta   | git checkout alpha |
+      | alpha  | git checkout main  |
+    And the current branch is still "main"
+
+  Scenario: nothing left to continue
+    When I run "git-town continue"
+    And I resolve the conflict in "conflicting_file"
+    And I run "git-town continue"
+    And I run "git-town continue"
+    Then it prints the error:
+      """
+      nothing to continue
+      """
@@ -0,0 +1,66 @@
+Feature: skip a branch with conflicts that has new commits at origin
+
+  Background:
+    Given the feature branches "alpha" and "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME        | FILE CONTENT   |
+      | main   | origin        | main commit   | conflicting_file | main content   |
+      | alpha  | local, origin | alpha commit  | conflicting_file | alpha content  |
+      |        | origin        | origin commit | origin_file      | origin content |
+      | beta   | local, origin | beta commit   | beta_file        | beta content   |
+    And the current branch is "main"
+    When I run "git-town sync --all --keep-going"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                   |
+      | main   | git fetch --prune --tags                  |
+      |        | git rebase origin/main                    |
+      |        | git checkout alpha                        |
+      | alpha  | git merge --no-edit origin/alpha          |
+      |        | git merge --no-edit main                  |
+      |        | git merge --abort                         |
+      |        | git reset --hard {{ sha 'alpha commit' }} |
+      |        | git checkout beta                         |
+      | beta   | git merge --no-edit origin/beta           |
+      |        | git merge --no-edit main                  |
+      |        | git push                                  |
+      |        | git checkout main                         |
+      | main   | git push --tags                           |
+    And it prints:
+      """
+      These branches need manual syncing:
+      - alpha
+      To sync them one at a time, run "git town continue".
+      """
+    And the current branch is still "main"
+    And no merge is in progress
+
+  Scenario: resolve and continue
+    When I run "git-town continue"
+    And I resolve the conflict in "conflicting_file"
+    And I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND              |
+      | alpha  | git commit --no-edit |
+      |        | git push             |
+      |        | git checkout main    |
+    And the current branch is now "main"
+    And no merge is in progress
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | main commit                    |
+      | alpha  | local, origin | alpha commit                   |
+      |        |               | origin commit                  |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into alpha |
+      | beta   | local, origin | beta commit                    |
+      |        |               | main commit                    |
+      |        |               | Merge branch 'main' into beta  |
+    And these committed files exist now
+      | BRANCH | NAME             | CONTENT          |
+      | main   | conflicting_file | main content     |
+      | alpha  | conflicting_file | resolved content |
+      |        | origin_file      | origin content   |
+      | beta   | beta_file        | beta content     |
+      |        | conflicting_file | main content     |
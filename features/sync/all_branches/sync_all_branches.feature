@@ -44,25 +44,25 @@ Feature: sync all feature branches
     Given setting "sync-strategy" is "rebase"
     When I run "git-town sync --all"
     Then it runs the commands
-      | BRANCH     | COMMAND                      |
-      | alpha      | git fetch --prune --tags     |
-      |            | git checkout main            |
-      | main       | git rebase origin/main       |
-      |            | git checkout alpha           |
-      | alpha      | git rebase origin/alpha      |
-      |            | git rebase main              |
-      |            | git push --force-with-lease  |
-      |            | git checkout beta            |
-      | beta       | git rebase origin/beta       |
-      |            | git rebase main              |
-      |            | git push --force-with-lease  |
-      |            | git checkout production      |
-      | production | git rebase origin/production |
-      |            | git push                     |
-      |            | git checkout qa              |
-      | qa         | git rebase origin/qa         |
-      |            | git push                     |
-      |            | git checkout alpha           |
-      | alpha      | git push --tags              |
+      | BRANCH     | COMMAND                                                                         |
+      | alpha      | git fetch --prune --tags                                                        |
+      |            | git checkout main                                                               |
+      | main       | git rebase origin/main                                                          |
+      |            | git checkout alpha                                                              |
+      | alpha      | git rebase origin/alpha                                                         |
+      |            | git rebase main                                                                 |
+      |            | git push --force-with-lease=alpha:{{ sha-in-origin-before-run 'alpha commit' }} |
+      |            | git checkout beta                                                               |
+      | beta       | git rebase origin/beta                                                          |
+      |            | git rebase main                                                                 |
+      |            | git push --force-with-lease=beta:{{ sha-in-origin-before-run 'beta commit' }}   |
+      |            | git checkout production                                                         |
+      | production | git rebase origin/production                                                    |
+      |            | git push                                                                        |
+      |            | git checkout qa                                                                 |
+      | qa         | git rebase origin/qa                                                            |
+      |            | git push                                                                        |
+      |            | git checkout alpha                                                              |
+      | alpha      | git push --tags                                                                 |
     And the current branch is still "alpha"
     And all branches are now synchronized
@@ -0,0 +1,46 @@
+Feature: sync a feature branch that uses the "merge" sync strategy while the general sync strategy is "rebase"
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And the current branch is a feature branch "feature"
+    And branch "feature" uses the "merge" sync strategy
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | local    | local main commit     |
+      |         | origin   | origin main commit    |
+      | feature | local    | local feature commit  |
+      |         | origin   | origin feature commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git push                           |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+      |         | git push                           |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE                                                    |
+      | main    | local, origin | origin main commit                                         |
+      |         |               | local main commit                                          |
+      | feature | local, origin | local feature commit                                       |
+      |         |               | origin feature commit                                      |
+      |         |               | Merge remote-tracking branch 'origin/feature' into feature |
+      |         |               | origin main commit                                         |
+      |         |               | local main commit                                          |
+      |         |               | Merge branch 'main' into feature                           |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND              |
+      | feature | git checkout main    |
+      | main    | git checkout feature |
+    And the current branch is still "feature"
+    And the initial branches and hierarchy exist
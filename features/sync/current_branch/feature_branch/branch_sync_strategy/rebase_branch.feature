@@ -0,0 +1,35 @@
+Feature: sync a feature branch that uses the "rebase" sync strategy while the general sync strategy is "merge"
+
+  Background:
+    Given setting "sync-strategy" is "merge"
+    And the current branch is a feature branch "feature"
+    And branch "feature" uses the "rebase" sync strategy
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | local    | local main commit     |
+      |         | origin   | origin main commit    |
+      | feature | local    | local feature commit  |
+      |         | origin   | origin feature commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                                    |
+      | feature | git fetch --prune --tags                                                                   |
+      |         | git checkout main                                                                          |
+      | main    | git rebase origin/main                                                                     |
+      |         | git push                                                                                   |
+      |         | git checkout feature                                                                       |
+      | feature | git rebase origin/feature                                                                  |
+      |         | git rebase main                                                                            |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'origin feature commit' }} |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE               |
+      | main    | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      | feature | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      |         |               | origin feature commit |
+      |         |               | local feature commit  |
@@ -70,10 +70,10 @@ Feature: handle conflicts between the current feature branch and the main branch
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue" and enter "resolved commit" for the commit message
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git rebase --continue       |
-      |         | git push --force-with-lease |
-      |         | git stash pop               |
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git rebase --continue                                                               |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+      |         | git stash pop                                                                       |
     And all branches are now synchronized
     And the current branch is still "feature"
     And no rebase is in progress
@@ -89,6 +89,6 @@ Feature: handle conflicts between the current feature branch and the main branch
     And I run "git rebase --continue" and enter "resolved commit" for the commit message
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git push --force-with-lease |
-      |         | git stash pop               |
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+      |         | git stash pop                                                                       |
@@ -56,14 +56,14 @@ Feature: handle conflicts between the main branch and its tracking branch
     When I resolve the conflict in "conflicting_file"
     And I run "git-town continue" and close the editor
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | main    | git rebase --continue       |
-      |         | git push                    |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
-      |         | git stash pop               |
+      | BRANCH  | COMMAND                                                                             |
+      | main    | git rebase --continue                                                               |
+      |         | git push                                                                            |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Initial commit' }} |
+      |         | git stash pop                                                                       |
     And all branches are now synchronized
     And the current branch is still "feature"
     And no rebase is in progress
@@ -78,13 +78,13 @@ Feature: handle conflicts between the main branch and its tracking branch
     And I run "git rebase --continue" and close the editor
     And I run "git-town continue"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | main    | git push                    |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
-      |         | git stash pop               |
+      | BRANCH  | COMMAND                                                                             |
+      | main    | git push                                                                            |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Initial commit' }} |
+      |         | git stash pop                                                                       |
     And all branches are now synchronized
     And the current branch is still "feature"
     And no rebase is in progress
@@ -0,0 +1,38 @@
+Feature: origin rejects the force-push of the current feature branch
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | origin        | main commit    |
+      | feature | local, origin | feature commit |
+    And origin rejects force-pushes
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags                                                            |
+      |         | git checkout main                                                                   |
+      | main    | git rebase origin/main                                                              |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+    And it prints the error:
+      """
+      cannot force-push branch "feature": exit status 1
+      """
+    And it does not print "somebody else pushed new commits"
+
+  Scenario: continue
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+    And it prints the error:
+      """
+      cannot force-push branch "feature": exit status 1
+      """
+    And the current branch is still "feature"
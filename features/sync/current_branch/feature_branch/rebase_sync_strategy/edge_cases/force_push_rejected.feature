@@ -0,0 +1,62 @@
+Feature: a coworker pushes to the current feature branch while Git Town syncs it
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE                   | FILE NAME        | FILE CONTENT    |
+      | main    | local         | conflicting local commit  | conflicting_file | local content   |
+      |         | origin        | conflicting origin commit | conflicting_file | origin content  |
+      | feature | local, origin | feature commit            | feature_file     | feature content |
+    And I ran "git-town sync"
+    And I resolve the conflict in "conflicting_file"
+    And a coworker clones the repository
+    And the coworker is on the "feature" branch
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE         | FILE NAME     | FILE CONTENT     |
+      | feature | coworker | coworker commit | coworker_file | coworker content |
+    And the coworker runs "git push"
+    When I run "git-town continue" and enter "resolved commit" for the commit message
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                             |
+      | main    | git rebase --continue                                                               |
+      |         | git push                                                                            |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+    And it prints the error:
+      """
+      cannot force-push branch "feature" because somebody else pushed new commits to it since Git Town fetched it.
+      Continuing pulls these commits into the branch and pushes it again.
+      """
+    And the current branch is still "feature"
+
+  Scenario: continue
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | feature | git fetch origin feature    |
+      |         | git rebase origin/feature   |
+      |         | git rebase main             |
+      |         | git push --force-with-lease |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And now these commits exist
+      | BRANCH  | LOCATION                | MESSAGE                   |
+      | main    | local, coworker, origin | conflicting origin commit |
+      |         | local, origin           | resolved commit           |
+      | feature | local, origin           | conflicting origin commit |
+      |         |                         | resolved commit           |
+      |         |                         | feature commit            |
+      |         |                         | coworker commit           |
+      |         | coworker                | feature commit            |
+      |         |                         | coworker commit           |
+    And these committed files exist now
+      | BRANCH  | NAME             | CONTENT          |
+      | main    | conflicting_file | resolved content |
+      | feature | conflicting_file | resolved content |
+      |         | coworker_file    | coworker content |
+      |         | feature_file     | feature content  |
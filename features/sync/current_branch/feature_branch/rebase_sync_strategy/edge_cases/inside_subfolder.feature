@@ -14,23 +14,23 @@ Feature: sync inside a folder that doesn't exist on the main branch
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                     |
-      | alpha  | git fetch --prune --tags    |
-      |        | git add -A                  |
-      |        | git stash                   |
-      |        | git checkout main           |
-      | main   | git rebase origin/main      |
-      |        | git checkout alpha          |
-      | alpha  | git rebase origin/alpha     |
-      |        | git rebase main             |
-      |        | git push --force-with-lease |
-      |        | git checkout beta           |
-      | beta   | git rebase origin/beta      |
-      |        | git rebase main             |
-      |        | git push --force-with-lease |
-      |        | git checkout alpha          |
-      | alpha  | git push --tags             |
-      |        | git stash pop               |
+      | BRANCH | COMMAND                                                                          |
+      | alpha  | git fetch --prune --tags                                                         |
+      |        | git add -A                                                                       |
+      |        | git stash                                                                        |
+      |        | git checkout main                                                                |
+      | main   | git rebase origin/main                                                           |
+      |        | git checkout alpha                                                               |
+      | alpha  | git rebase origin/alpha                                                          |
+      |        | git rebase main                                                                  |
+      |        | git push --force-with-lease=alpha:{{ sha-in-origin-before-run 'folder commit' }} |
+      |        | git checkout beta                                                                |
+      | beta   | git rebase origin/beta                                                           |
+      |        | git rebase main                                                                  |
+      |        | git push --force-with-lease=beta:{{ sha-in-origin-before-run 'beta commit' }}    |
+      |        | git checkout alpha                                                               |
+      | alpha  | git push --tags                                                                  |
+      |        | git stash pop                                                                    |
     And all branches are now synchronized
     And the current branch is still "alpha"
     And the uncommitted file still exists
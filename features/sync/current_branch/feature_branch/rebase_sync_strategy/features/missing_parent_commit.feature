@@ -0,0 +1,26 @@
+Feature: sync a branch whose recorded parent commit no longer exists
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | origin        | main commit    |
+      | feature | local, origin | feature commit |
+    And branch "feature" was last synced onto commit "1234567890123456789012345678901234567890"
+    And the current branch is "feature"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags                                                            |
+      |         | git checkout main                                                                   |
+      | main    | git rebase origin/main                                                              |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'feature commit' }} |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And branch "feature" was now last synced onto the "main commit" commit
@@ -17,19 +17,19 @@ Feature: nested feature branches
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                     |
-      | child  | git fetch --prune --tags    |
-      |        | git checkout main           |
-      | main   | git rebase origin/main      |
-      |        | git push                    |
-      |        | git checkout parent         |
-      | parent | git rebase origin/parent    |
-      |        | git rebase main             |
-      |        | git push --force-with-lease |
-      |        | git checkout child          |
-      | child  | git rebase origin/child     |
-      |        | git rebase parent           |
-      |        | git push --force-with-lease |
+      | BRANCH | COMMAND                                                                                  |
+      | child  | git fetch --prune --tags                                                                 |
+      |        | git checkout main                                                                        |
+      | main   | git rebase origin/main                                                                   |
+      |        | git push                                                                                 |
+      |        | git checkout parent                                                                      |
+      | parent | git rebase origin/parent                                                                 |
+      |        | git rebase main                                                                          |
+      |        | git push --force-with-lease=parent:{{ sha-in-origin-before-run 'origin parent commit' }} |
+      |        | git checkout child                                                                       |
+      | child  | git rebase origin/child                                                                  |
+      |        | git rebase parent                                                                        |
+      |        | git push --force-with-lease=child:{{ sha-in-origin-before-run 'origin child commit' }}   |
     And all branches are now synchronized
     And the current branch is still "child"
     And now these commits exist
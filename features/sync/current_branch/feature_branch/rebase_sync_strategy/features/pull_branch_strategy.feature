@@ -14,15 +14,15 @@ Feature: with pull-branch-strategy set to "merge"
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                         |
-      | feature | git fetch --prune --tags        |
-      |         | git checkout main               |
-      | main    | git merge --no-edit origin/main |
-      |         | git push                        |
-      |         | git checkout feature            |
-      | feature | git rebase origin/feature       |
-      |         | git rebase main                 |
-      |         | git push --force-with-lease     |
+      | BRANCH  | COMMAND                                                                                    |
+      | feature | git fetch --prune --tags                                                                   |
+      |         | git checkout main                                                                          |
+      | main    | git merge --no-edit origin/main                                                            |
+      |         | git push                                                                                   |
+      |         | git checkout feature                                                                       |
+      | feature | git rebase origin/feature                                                                  |
+      |         | git rebase main                                                                            |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'origin feature commit' }} |
     And all branches are now synchronized
     And the current branch is still "feature"
     And now these commits exist
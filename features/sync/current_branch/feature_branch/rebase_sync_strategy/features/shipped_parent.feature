@@ -0,0 +1,49 @@
+Feature: sync a branch whose parent branch was shipped
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE         | FILE NAME   | FILE CONTENT |
+      | child  | local, origin | child commit    | child_file  | child        |
+      | parent | local, origin | parent commit 1 | parent_file | one          |
+      |        |               | parent commit 2 | parent_file | two          |
+    And the current branch is "child"
+    And I ran "git-town sync"
+    And I ran "git-town ship parent -m shipped"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                         |
+      | child  | git fetch --prune --tags                                                        |
+      |        | git checkout main                                                               |
+      | main   | git rebase origin/main                                                          |
+      |        | git checkout child                                                              |
+      | child  | git rebase origin/child                                                         |
+      |        | git rebase --onto main {{ sha 'parent commit 2' }}                              |
+      |        | git push --force-with-lease=child:{{ sha-in-origin-before-run 'child commit' }} |
+    And all branches are now synchronized
+    And the current branch is still "child"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE         |
+      | main   | local, origin | shipped         |
+      | child  | local, origin | shipped         |
+      |        |               | child commit    |
+      | parent | origin        | parent commit 1 |
+      |        |               | parent commit 2 |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git checkout main  |
+      | main   | git checkout child |
+    And the current branch is still "child"
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
@@ -12,14 +12,14 @@ Feature: collaborative feature branch syncing
       |         | coworker | coworker commit |
     When I run "git-town sync"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags                                                            |
+      |         | git checkout main                                                                   |
+      | main    | git rebase origin/main                                                              |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Initial commit' }} |
     And now these commits exist
       | BRANCH  | LOCATION      | MESSAGE         |
       | feature | local, origin | my commit       |
@@ -47,14 +47,14 @@ Feature: collaborative feature branch syncing
     Given the current branch is "feature"
     When I run "git-town sync"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                                                                         |
+      | feature | git fetch --prune --tags                                                                                                        |
+      |         | git checkout main                                                                                                               |
+      | main    | git rebase origin/main                                                                                                          |
+      |         | git checkout feature                                                                                                            |
+      | feature | git rebase origin/feature                                                                                                       |
+      |         | git rebase main                                                                                                                 |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Merge remote-tracking branch 'origin/feature' into feature' }} |
     And all branches are now synchronized
     And now these commits exist
       | BRANCH  | LOCATION                | MESSAGE                                                    |
@@ -76,14 +76,14 @@ Feature: collaborative feature branch syncing
       |         | coworker | coworker commit |
     When I run "git-town sync"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags                                                            |
+      |         | git checkout main                                                                   |
+      | main    | git rebase origin/main                                                              |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Initial commit' }} |
     And now these commits exist
       | BRANCH  | LOCATION      | MESSAGE         |
       | feature | local, origin | my commit       |
@@ -93,14 +93,14 @@ Feature: collaborative feature branch syncing
     Given the coworker is on the "feature" branch
     When the coworker runs "git-town sync"
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                        |
+      | feature | git fetch --prune --tags                                                       |
+      |         | git checkout main                                                              |
+      | main    | git rebase origin/main                                                         |
+      |         | git checkout feature                                                           |
+      | feature | git rebase origin/feature                                                      |
+      |         | git rebase main                                                                |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'my commit' }} |
     And all branches are now synchronized
     And now these commits exist
       | BRANCH  | LOCATION                | MESSAGE         |
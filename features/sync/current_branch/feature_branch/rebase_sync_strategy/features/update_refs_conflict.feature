@@ -0,0 +1,86 @@
+Feature: handle conflicts while syncing a stack of branches with "git rebase --update-refs"
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And setting "sync-update-refs" is "true"
+    And offline mode is enabled
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       | FILE NAME        | FILE CONTENT   |
+      | main   | local    | main commit   | conflicting_file | main content   |
+      | parent | local    | parent commit | conflicting_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local    | child commit | child_file | child content |
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | child  | git checkout main             |
+      | main   | git rebase origin/main        |
+      |        | git checkout child            |
+      | child  | git rebase origin/child       |
+      |        | git rebase --update-refs main |
+    And it prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And it prints the error:
+      """
+      To abort, run "git-town abort".
+      To continue after having resolved conflicts, run "git-town continue".
+      """
+    And a rebase is now in progress
+
+  Scenario: abort
+    When I run "git-town abort"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git rebase --abort |
+      |        | git checkout main  |
+      | main   | git checkout child |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local         | main commit   |
+      | child  | local, origin | parent commit |
+      |        | local         | child commit  |
+      | parent | local         | parent commit |
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND               |
+      | child  | git rebase --continue |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these committed files exist now
+      | BRANCH | NAME             | CONTENT          |
+      | main   | conflicting_file | main content     |
+      | child  | child_file       | child content    |
+      |        | conflicting_file | resolved content |
+      | parent | conflicting_file | resolved content |
+
+  Scenario: resolve, continue, and undo
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    And I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                     |
+      | child  | git branch --force parent {{ sha-initial 'parent commit' }} |
+      |        | git reset --hard {{ sha-initial 'child commit' }}           |
+      |        | git checkout main                                           |
+      | main   | git checkout child                                          |
+    And the current branch is still "child"
+    And now these commits exist
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local         | main commit   |
+      | child  | local, origin | parent commit |
+      |        | local         | child commit  |
+      | parent | local         | parent commit |
+    And the initial branches and hierarchy exist
@@ -0,0 +1,32 @@
+Feature: sync a stack of branches with "git rebase --update-refs" without the push hook
+
+  Background:
+    Given setting "sync-strategy" is "rebase"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   |
+      | child  | local, origin | child commit  | child_file  |
+      | parent | local, origin | parent commit | parent_file |
+    And the current branch is "child"
+    And I ran "git-town sync"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And setting "sync-update-refs" is "true"
+    And setting "push-hook" is "false"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                     |
+      | child  | git fetch --prune --tags                                                                                    |
+      |        | git checkout main                                                                                           |
+      | main   | git rebase origin/main                                                                                      |
+      |        | git checkout child                                                                                          |
+      | child  | git rebase origin/child                                                                                     |
+      |        | git rebase --update-refs main                                                                               |
+      |        | git push --force-with-lease=parent:{{ sha-in-origin-before-run 'parent commit' }} --no-verify origin parent |
+      |        | git push --force-with-lease=child:{{ sha-in-origin-before-run 'child commit' }}                             |
+    And all branches are now synchronized
+    And the current branch is still "child"
@@ -12,17 +12,17 @@ Feature: with upstream repo
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git fetch upstream main     |
-      |         | git rebase upstream/main    |
-      |         | git push                    |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                             |
+      | feature | git fetch --prune --tags --multiple --jobs=2 origin upstream                        |
+      |         | git checkout main                                                                   |
+      | main    | git rebase origin/main                                                              |
+      |         | git fetch upstream main                                                             |
+      |         | git rebase upstream/main                                                            |
+      |         | git push                                                                            |
+      |         | git checkout feature                                                                |
+      | feature | git rebase origin/feature                                                           |
+      |         | git rebase main                                                                     |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'Initial commit' }} |
     And all branches are now synchronized
     And the current branch is still "feature"
     And now these commits exist
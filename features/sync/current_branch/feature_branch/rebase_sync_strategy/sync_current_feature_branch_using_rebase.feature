@@ -13,15 +13,15 @@ Feature: sync the current feature branch using the "rebase" sync strategy
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                     |
-      | feature | git fetch --prune --tags    |
-      |         | git checkout main           |
-      | main    | git rebase origin/main      |
-      |         | git push                    |
-      |         | git checkout feature        |
-      | feature | git rebase origin/feature   |
-      |         | git rebase main             |
-      |         | git push --force-with-lease |
+      | BRANCH  | COMMAND                                                                                    |
+      | feature | git fetch --prune --tags                                                                   |
+      |         | git checkout main                                                                          |
+      | main    | git rebase origin/main                                                                     |
+      |         | git push                                                                                   |
+      |         | git checkout feature                                                                       |
+      | feature | git rebase origin/feature                                                                  |
+      |         | git rebase main                                                                            |
+      |         | git push --force-with-lease=feature:{{ sha-in-origin-before-run 'origin feature commit' }} |
     And all branches are now synchronized
     And the current branch is still "feature"
     And now these commits exist
@@ -0,0 +1,32 @@
+Feature: sync the current observed branch
+
+  Background:
+    Given an observed branch "observed"
+    And the commits
+      | BRANCH   | LOCATION      | MESSAGE       | FILE NAME   |
+      | main     | local, origin | main commit   | main_file   |
+      | observed | local         | local commit  | local_file  |
+      |          | origin        | origin commit | origin_file |
+    And the current branch is "observed"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH   | COMMAND                    |
+      | observed | git fetch --prune --tags   |
+      |          | git rebase origin/observed |
+    And the current branch is still "observed"
+    And now these commits exist
+      | BRANCH   | LOCATION      | MESSAGE       |
+      | main     | local, origin | main commit   |
+      | observed | local, origin | origin commit |
+      |          | local         | local commit  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH   | COMMAND                                           |
+      | observed | git reset --hard {{ sha-initial 'local commit' }} |
+    And the current branch is still "observed"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -0,0 +1,49 @@
+Feature: merge the changes of a perennial branch into its downstream branches
+
+  Background:
+    Given the perennial branches "release" and "develop"
+    And perennial branch "release" merges into "develop"
+    And perennial branch "develop" merges into "main"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    |
+      | release | local         | release commit | release_file |
+      | develop | local, origin | develop commit | develop_file |
+    And the current branch is "release"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | release | git fetch --prune --tags    |
+      |         | git rebase origin/release   |
+      |         | git push                    |
+      |         | git checkout develop        |
+      | develop | git rebase origin/develop   |
+      |         | git merge --no-edit release |
+      |         | git push                    |
+      |         | git checkout main           |
+      | main    | git rebase origin/main      |
+      |         | git merge --no-edit develop |
+      |         | git push                    |
+      |         | git checkout release        |
+      | release | git push --tags             |
+    And all branches are now synchronized
+    And the current branch is still "release"
+    And now these commits exist
+      | BRANCH  | LOCATION      | MESSAGE                             |
+      | main    | local, origin | develop commit                      |
+      |         |               | release commit                      |
+      |         |               | Merge branch 'release' into develop |
+      | develop | local, origin | develop commit                      |
+      |         |               | release commit                      |
+      |         |               | Merge branch 'release' into develop |
+      | release | local, origin | release commit                      |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND              |
+      | release | git checkout main    |
+      | main    | git checkout develop |
+      | develop | git checkout release |
+    And the current branch is still "release"
@@ -0,0 +1,23 @@
+Feature: merge the changes of a perennial branch into its downstream branches without running the push hook
+
+  Scenario: push-hook disabled
+    Given the perennial branches "release" and "develop"
+    And perennial branch "release" merges into "develop"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    |
+      | release | local, origin | release commit | release_file |
+    And the current branch is "release"
+    And setting "push-hook" is "false"
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH  | COMMAND                     |
+      | release | git fetch --prune --tags    |
+      |         | git rebase origin/release   |
+      |         | git checkout develop        |
+      | develop | git rebase origin/develop   |
+      |         | git merge --no-edit release |
+      |         | git push --no-verify        |
+      |         | git checkout release        |
+      | release | git push --tags             |
+    And all branches are now synchronized
+    And the current branch is still "release"
@@ -0,0 +1,24 @@
+Feature: sync a branch that is perennial because its name matches the perennial regex
+
+  Background:
+    Given a branch "release/1"
+    And setting "perennial-regex" is "^release/"
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE      | FILE NAME  |
+      | release/1 | local         | local commit | local_file |
+      | main      | local, origin | main commit  | main_file  |
+    And the current branch is "release/1"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                      |
+      | release/1 | git fetch --prune --tags     |
+      |           | git push -u origin release/1 |
+      |           | git push --tags              |
+    And all branches are now synchronized
+    And the current branch is still "release/1"
+    And now these commits exist
+      | BRANCH    | LOCATION      | MESSAGE      |
+      | main      | local, origin | main commit  |
+      | release/1 | local, origin | local commit |
@@ -0,0 +1,22 @@
+Feature: sync a prototype branch without a tracking branch
+
+  Scenario: result
+    Given a local feature branch "prototype"
+    And branch "prototype" is a prototype branch
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE      |
+      | prototype | local    | local commit |
+    And the current branch is "prototype"
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH    | COMMAND                  |
+      | prototype | git fetch --prune --tags |
+      |           | git checkout main        |
+      | main      | git rebase origin/main   |
+      |           | git checkout prototype   |
+      | prototype | git merge --no-edit main |
+    And the current branch is still "prototype"
+    And the branches are now
+      | REPOSITORY | BRANCHES        |
+      | local      | main, prototype |
+      | origin     | main            |
@@ -0,0 +1,43 @@
+Feature: sync the current prototype branch
+
+  Background:
+    Given a feature branch "prototype"
+    And branch "prototype" is a prototype branch
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE       |
+      | main      | local, origin | main commit   |
+      | prototype | local         | local commit  |
+      |           | origin        | origin commit |
+    And the current branch is "prototype"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                              |
+      | prototype | git fetch --prune --tags             |
+      |           | git checkout main                    |
+      | main      | git rebase origin/main               |
+      |           | git checkout prototype               |
+      | prototype | git merge --no-edit origin/prototype |
+      |           | git merge --no-edit main             |
+    And the current branch is still "prototype"
+    And now these commits exist
+      | BRANCH    | LOCATION      | MESSAGE                                                        |
+      | main      | local, origin | main commit                                                    |
+      | prototype | local         | local commit                                                   |
+      |           | local, origin | origin commit                                                  |
+      |           | local         | Merge remote-tracking branch 'origin/prototype' into prototype |
+      |           |               | main commit                                                    |
+      |           |               | Merge branch 'main' into prototype                             |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                                                                                     |
+      | prototype | git reset --hard {{ sha 'Merge remote-tracking branch 'origin/prototype' into prototype' }} |
+      |           | git reset --hard {{ sha 'local commit' }}                                                   |
+      |           | git checkout main                                                                           |
+      | main      | git checkout prototype                                                                      |
+    And the current branch is still "prototype"
+    And now the initial commits exist
+    And the initial branches and hierarchy exist
@@ -12,32 +12,32 @@ Feature: display debug statistics
   Scenario: result
     When I run "git-town sync --debug"
     Then it runs the commands
-      | BRANCH  | TYPE     | COMMAND                                            |
-      |         | backend  | git version                                        |
-      |         | backend  | git config -lz --global                            |
-      |         | backend  | git config -lz --local                             |
-      |         | backend  | git rev-parse --show-toplevel                      |
-      |         | backend  | git remote                                         |
-      |         | backend  | git status                                         |
-      |         | backend  | git rev-parse --abbrev-ref HEAD                    |
-      | feature | frontend | git fetch --prune --tags                           |
-      |         | backend  | git branch -vva                                    |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}          |
-      |         | backend  | git status --porcelain --ignore-submodules         |
-      | feature | frontend | git checkout main                                  |
-      |         | backend  | git rev-parse --short HEAD                         |
-      | main    | frontend | git rebase origin/main                             |
-      |         | backend  | git rev-list --left-right main...origin/main       |
-      | main    | frontend | git push                                           |
-      |         | frontend | git checkout feature                               |
-      |         | backend  | git rev-parse --short HEAD                         |
-      | feature | frontend | git merge --no-edit origin/feature                 |
-      |         | backend  | git rev-parse --short HEAD                         |
-      | feature | frontend | git merge --no-edit main                           |
-      |         | backend  | git rev-list --left-right feature...origin/feature |
-      | feature | frontend | git push                                           |
-      |         | backend  | git show-ref --quiet refs/heads/main               |
-      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}          |
+      | BRANCH  | TYPE     | COMMAND                                                                                                                                                             |
+      |         | backend  | git version                                                                                                                                                         |
+      |         | backend  | git config -lz --global                                                                                                                                             |
+      |         | backend  | git config -lz --local                                                                                                                                              |
+      |         | backend  | git rev-parse --show-toplevel                                                                                                                                       |
+      |         | backend  | git remote                                                                                                                                                          |
+      |         | backend  | git status                                                                                                                                                          |
+      |         | backend  | git rev-parse --abbrev-ref HEAD                                                                                                                                     |
+      | feature | frontend | git fetch --prune --tags                                                                                                                                            |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
+      |         | backend  | git status --porcelain --ignore-submodules                                                                                                                          |
+      | feature | frontend | git checkout main                                                                                                                                                   |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | main    | frontend | git rebase origin/main                                                                                                                                              |
+      |         | backend  | git rev-list --left-right main...origin/main                                                                                                                        |
+      | main    | frontend | git push                                                                                                                                                            |
+      |         | frontend | git checkout feature                                                                                                                                                |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | feature | frontend | git merge --no-edit origin/feature                                                                                                                                  |
+      |         | backend  | git rev-parse --short HEAD                                                                                                                                          |
+      | feature | frontend | git merge --no-edit main                                                                                                                                            |
+      |         | backend  | git rev-list --left-right feature...origin/feature                                                                                                                  |
+      | feature | frontend | git push                                                                                                                                                            |
+      |         | backend  | git for-each-ref --format=%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket) refs/heads/ refs/remotes/ |
+      |         | backend  | git rev-parse --verify --abbrev-ref @{-1}                                                                                                                           |
     And it prints:
       """
       Ran 25 shell commands.
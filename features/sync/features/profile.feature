@@ -0,0 +1,29 @@
+Feature: display profiling information
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE              |
+      | main    | origin   | origin main commit   |
+      | feature | local    | local feature commit |
+
+  Scenario: result
+    When I run "git-town sync --profile"
+    Then it prints something like:
+      """
+      Profile: 2\d shell commands took .+\.
+
+      Commands:
+      \s+DURATION +TYPE +EXIT +STEP +COMMAND
+      """
+    And it prints something like:
+      """
+      frontend  0     \(no step\) +git fetch --prune --tags
+      """
+    And it prints something like:
+      """
+      Steps:
+      .+ \(no step\) .+ commands
+      """
+    And it does not print "Ran 2"
+    And all branches are now synchronized
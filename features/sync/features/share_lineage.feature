@@ -0,0 +1,130 @@
+Feature: share the branch lineage through the origin repository
+
+  Background:
+    Given setting "share-lineage" is "true"
+    And a coworker clones the repository
+    And the coworker's setting "share-lineage" is "true"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the current branch is "child"
+
+  Scenario: share the lineage with a coworker
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                             |
+      | child  | git fetch --prune --tags origin +refs/heads/*:refs/remotes/origin/* +refs/git-town/*:refs/git-town/remotes/origin/* |
+      |        | git checkout main                                                                                                   |
+      | main   | git rebase origin/main                                                                                              |
+      |        | git push --force-with-lease=refs/git-town/lineage: origin refs/git-town/lineage                                     |
+      |        | git checkout parent                                                                                                 |
+      | parent | git merge --no-edit origin/parent                                                                                   |
+      |        | git merge --no-edit main                                                                                            |
+      |        | git checkout child                                                                                                  |
+      | child  | git merge --no-edit origin/child                                                                                    |
+      |        | git merge --no-edit parent                                                                                          |
+    Given the coworker fetches updates
+    And the coworker is on the "parent" branch
+    And the coworker is on the "child" branch
+    When the coworker runs "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                             |
+      | child  | git fetch --prune --tags origin +refs/heads/*:refs/remotes/origin/* +refs/git-town/*:refs/git-town/remotes/origin/* |
+      |        | git checkout main                                                                                                   |
+      | main   | git rebase origin/main                                                                                              |
+      |        | git checkout parent                                                                                                 |
+      | parent | git merge --no-edit origin/parent                                                                                   |
+      |        | git merge --no-edit main                                                                                            |
+      |        | git checkout child                                                                                                  |
+      | child  | git merge --no-edit origin/child                                                                                    |
+      |        | git merge --no-edit parent                                                                                          |
+    And the coworker now has this branch lineage
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+
+  Scenario: undo sharing the lineage
+    Given I ran "git-town sync"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                                  |
+      | child  | git checkout parent                                                                                                      |
+      | parent | git checkout main                                                                                                        |
+      | main   | git push --force-with-lease=refs/git-town/lineage:5259afabb69a8dc911d902181a107675ba30f3c3 origin :refs/git-town/lineage |
+      |        | git checkout child                                                                                                       |
+
+  Scenario: adopt the parent branch that a coworker changed
+    Given I ran "git-town sync"
+    And the coworker fetches updates
+    And the coworker is on the "parent" branch
+    And the coworker is on the "child" branch
+    And the coworker runs "git-town move-branch main"
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                             |
+      | child  | git fetch --prune --tags origin +refs/heads/*:refs/remotes/origin/* +refs/git-town/*:refs/git-town/remotes/origin/* |
+      |        | git checkout main                                                                                                   |
+      | main   | git rebase origin/main                                                                                              |
+      |        | git checkout child                                                                                                  |
+      | child  | git merge --no-edit origin/child                                                                                    |
+      |        | git merge --no-edit main                                                                                            |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | main   |
+
+  Scenario: undo adopting the parent branch that a coworker changed
+    Given I ran "git-town sync"
+    And the coworker fetches updates
+    And the coworker is on the "parent" branch
+    And the coworker is on the "child" branch
+    And the coworker runs "git-town move-branch main"
+    And I ran "git-town sync"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git checkout main  |
+      | main   | git checkout child |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+
+  Scenario: keep the local parent branch that conflicts with the parent that a coworker changed
+    Given I ran "git-town sync"
+    And the coworker fetches updates
+    And the coworker is on the "parent" branch
+    And the coworker is on the "child" branch
+    And the coworker runs "git-town move-branch main"
+    And a feature branch "other"
+    And Git Town believes the parent of "child" is "other"
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                             |
+      | child  | git fetch --prune --tags origin +refs/heads/*:refs/remotes/origin/* +refs/git-town/*:refs/git-town/remotes/origin/* |
+      |        | git checkout main                                                                                                   |
+      | main   | git rebase origin/main                                                                                              |
+      |        | git push --force-with-lease=refs/git-town/lineage:refs/git-town/remotes/origin/lineage origin refs/git-town/lineage |
+      |        | git checkout other                                                                                                  |
+      | other  | git merge --no-edit origin/other                                                                                    |
+      |        | git merge --no-edit main                                                                                            |
+      |        | git checkout child                                                                                                  |
+      | child  | git merge --no-edit origin/child                                                                                    |
+      |        | git merge --no-edit other                                                                                           |
+    And it prints:
+      """
+      branch "child" has parent "other" locally but "main" in the shared lineage, keeping "other"
+      """
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | other  |
+      | other  | main   |
+      | parent | main   |
+    And the coworker fetches updates
+    And the coworker is on the "other" branch
+    And the coworker is on the "child" branch
+    When the coworker runs "git-town sync"
+    Then the coworker now has this branch lineage
+      | BRANCH | PARENT |
+      | child  | other  |
+      | other  | main   |
+      | parent | main   |
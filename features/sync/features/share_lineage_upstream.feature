@@ -0,0 +1,32 @@
+Feature: share the branch lineage through the origin repository of a forked repo
+
+  Background:
+    Given an upstream repo
+    And setting "share-lineage" is "true"
+    And a coworker clones the repository
+    And the coworker's setting "share-lineage" is "true"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the current branch is "child"
+    And I ran "git-town sync"
+    And the coworker fetches updates
+    And the coworker is on the "parent" branch
+    And the coworker is on the "child" branch
+    And the coworker runs "git-town move-branch main"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                                                                             |
+      | child  | git -c remote.origin.fetch=+refs/git-town/*:refs/git-town/remotes/origin/* fetch --prune --tags --multiple --jobs=2 origin upstream |
+      |        | git checkout main                                                                                                                   |
+      | main   | git rebase origin/main                                                                                                              |
+      |        | git fetch upstream main                                                                                                             |
+      |        | git rebase upstream/main                                                                                                            |
+      |        | git checkout child                                                                                                                  |
+      | child  | git merge --no-edit origin/child                                                                                                    |
+      |        | git merge --no-edit main                                                                                                            |
+    And this branch lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | main   |
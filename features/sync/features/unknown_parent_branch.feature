@@ -38,3 +38,19 @@ Feature: enter a parent branch name when prompted
       | BRANCH | PARENT |
       | alpha  | main   |
       | beta   | main   |
+
+  Scenario: preselect the parent branch inferred from the commit history
+    Given the commits
+      | BRANCH | LOCATION | MESSAGE      | FILE NAME  |
+      | alpha  | local    | alpha commit | alpha_file |
+    And a feature branch "gamma" as a child of "alpha"
+    And Git Town does not know the parent of "gamma"
+    And the current branch is "gamma"
+    When I run "git-town sync" and answer the prompts:
+      | PROMPT                                      | ANSWER  |
+      | Please specify the parent branch of 'gamma' | [ENTER] |
+      | Please specify the parent branch of 'alpha' | [ENTER] |
+    Then this branch lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | gamma  | alpha  |
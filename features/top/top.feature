@@ -0,0 +1,45 @@
+Feature: switch to the last branch in the stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+
+  Scenario: linear stack
+    Given the current branch is "alpha"
+    When I run "git-town top"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+    And the previous Git branch is now "alpha"
+
+  Scenario: branching stack
+    Given a feature branch "delta" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town top" and answer the prompts:
+      | PROMPT                                    | ANSWER  |
+      | Branch "alpha" has several child branches | [ENTER] |
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: already at the top
+    Given the current branch is "gamma"
+    When I run "git-town top"
+    Then it runs no commands
+    And the current branch is still "gamma"
+
+  Scenario: cycle in the lineage
+    Given Git Town believes the parent of "alpha" is "beta"
+    And the current branch is "alpha"
+    When I run "git-town top" and answer the prompts:
+      | PROMPT                                   | ANSWER  |
+      | Branch "beta" has several child branches | [ENTER] |
+    Then it runs no commands
+    And it prints the error:
+      """
+      the child branches of branch "alpha" form a cycle, please run "git town doctor" to repair the lineage
+      """
+    And the current branch is still "alpha"
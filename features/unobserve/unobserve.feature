@@ -0,0 +1,25 @@
+Feature: turn an observed branch back into a feature branch
+
+  Scenario: unobserve the current branch
+    Given an observed branch "observed"
+    And the current branch is "observed"
+    When I run "git-town unobserve"
+    Then it prints:
+      """
+      branch "observed" is now a feature branch
+      """
+    And local setting "observed-branches" no longer exists
+
+  Scenario: undo unobserving a branch
+    Given an observed branch "observed"
+    And I ran "git-town unobserve observed"
+    When I run "git-town undo"
+    Then local setting "observed-branches" is now "observed"
+
+  Scenario: branch is not observed
+    Given a feature branch "feature"
+    When I run "git-town unobserve feature"
+    Then it prints the error:
+      """
+      branch "feature" is not observed
+      """
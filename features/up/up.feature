@@ -0,0 +1,66 @@
+Feature: switch to the child branch
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+
+  Scenario: single child branch
+    Given the current branch is "alpha" and the previous branch is "main"
+    When I run "git-town up"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git checkout beta |
+    And the current branch is now "beta"
+    And the previous Git branch is now "alpha"
+
+  Scenario: several child branches
+    Given a feature branch "gamma" as a child of "alpha"
+    And the current branch is "alpha"
+    When I run "git-town up" and answer the prompts:
+      | PROMPT                                    | ANSWER        |
+      | Branch "alpha" has several child branches | [DOWN][ENTER] |
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | alpha  | git checkout gamma |
+    And the current branch is now "gamma"
+
+  Scenario: with uncommitted changes
+    Given the current branch is "alpha"
+    And an uncommitted file
+    When I run "git-town up"
+    Then it runs the commands
+      | BRANCH | COMMAND           |
+      | alpha  | git add -A        |
+      |        | git stash         |
+      |        | git checkout beta |
+      | beta   | git stash pop     |
+    And the current branch is now "beta"
+    And the uncommitted file still exists
+
+  Scenario: no child branch
+    Given the current branch is "beta"
+    When I run "git-town up"
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "beta" has no child branches
+      """
+    And the current branch is still "beta"
+
+  Scenario: undo
+    Given the current branch is "alpha"
+    And I ran "git-town up"
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | beta   | git checkout alpha |
+    And the current branch is now "alpha"
+
+  Scenario: profile
+    Given the current branch is "alpha"
+    When I run "git-town up --profile"
+    Then it prints something like:
+      """
+      frontend  0     CheckoutStep +git checkout beta
+      """
+    And the current branch is now "beta"
@@ -21,6 +21,7 @@ require (
 	golang.org/x/oauth2 v0.4.0
 	// NOTE: updating to v2 makes the integration tests slow
 	gopkg.in/AlecAivazis/survey.v1 v1.8.8
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -45,5 +46,4 @@ require (
 	golang.org/x/time v0.3.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.28.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
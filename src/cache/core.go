@@ -6,6 +6,9 @@ import "github.com/git-town/git-town/v9/src/domain"
 // Bool is a cache for bool variables.
 type Bool = Cache[bool]
 
+// BranchInfos is a cache for domain.BranchInfos variables.
+type BranchInfos = Cache[domain.BranchInfos]
+
 // LocalBranch is a cache for domain.LocalBranchName variables.
 type LocalBranch = Cache[domain.LocalBranchName]
 
@@ -0,0 +1,25 @@
+// Package changelog generates release notes from the branches that "git ship" recorded in the commits it created.
+package changelog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+const (
+	// BranchTrailer is the key of the commit trailer that records the name of a shipped branch.
+	BranchTrailer = "Git-Town-Branch"
+	// ProposalTrailer is the key of the commit trailer that records the proposal of a shipped branch.
+	ProposalTrailer = "Proposal"
+)
+
+// ShipTrailers provides the commit trailers that record the given shipped branch and its proposal.
+// A proposal number of 0 means the branch has no proposal.
+func ShipTrailers(branch domain.LocalBranchName, proposalNumber int) []string {
+	result := []string{BranchTrailer + ": " + branch.String()}
+	if proposalNumber > 0 {
+		result = append(result, fmt.Sprintf("%s: #%d", ProposalTrailer, proposalNumber))
+	}
+	return result
+}
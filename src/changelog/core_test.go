@@ -0,0 +1,27 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipTrailers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with proposal", func(t *testing.T) {
+		t.Parallel()
+		have := changelog.ShipTrailers(domain.NewLocalBranchName("feature"), 123)
+		want := []string{"Git-Town-Branch: feature", "Proposal: #123"}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("without proposal", func(t *testing.T) {
+		t.Parallel()
+		have := changelog.ShipTrailers(domain.NewLocalBranchName("feature"), 0)
+		want := []string{"Git-Town-Branch: feature"}
+		assert.Equal(t, want, have)
+	})
+}
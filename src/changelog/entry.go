@@ -0,0 +1,58 @@
+package changelog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Entry is a shipped branch that the changelog lists.
+type Entry struct {
+	Branch   string   `json:"branch"`   // name of the shipped branch
+	Labels   []string `json:"labels"`   // labels of the proposal of the shipped branch, if loaded
+	Proposal int      `json:"proposal"` // number of the proposal of the shipped branch, 0 if it has none
+	SHA      string   `json:"sha"`      // SHA of the commit that shipped the branch
+	Title    string   `json:"title"`    // subject of the commit that shipped the branch
+}
+
+// ParseEntry provides the changelog entry that the commit with the given SHA and message records,
+// or nil if that commit doesn't record a shipped branch.
+func ParseEntry(sha, message string) *Entry {
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	result := Entry{
+		Branch:   "",
+		Labels:   []string{},
+		Proposal: 0,
+		SHA:      sha,
+		Title:    strings.TrimSpace(lines[0]),
+	}
+	// trailers are in the last paragraph of the commit message
+	for l := len(lines) - 1; l > 0; l-- {
+		key, value, found := strings.Cut(lines[l], ":")
+		if !found {
+			break
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case BranchTrailer:
+			result.Branch = value
+		case ProposalTrailer:
+			number, err := strconv.Atoi(strings.TrimPrefix(value, "#"))
+			if err == nil {
+				result.Proposal = number
+			}
+		}
+	}
+	if result.Branch == "" {
+		return nil
+	}
+	return &result
+}
+
+// Text provides the text that Markdown changelogs display for this entry.
+func (e Entry) Text() string {
+	if e.Proposal > 0 && !strings.Contains(e.Title, fmt.Sprintf("#%d", e.Proposal)) {
+		return fmt.Sprintf("%s (#%d)", e.Title, e.Proposal)
+	}
+	return e.Title
+}
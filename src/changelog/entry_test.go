@@ -0,0 +1,68 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseEntry", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("commit with trailers", func(t *testing.T) {
+			t.Parallel()
+			give := "feature done\n\nsome details\n\nGit-Town-Branch: feature\nProposal: #123\nCo-authored-by: coworker <coworker@example.com>\n"
+			have := changelog.ParseEntry("abc1234", give)
+			want := &changelog.Entry{
+				Branch:   "feature",
+				Labels:   []string{},
+				Proposal: 123,
+				SHA:      "abc1234",
+				Title:    "feature done",
+			}
+			assert.Equal(t, want, have)
+		})
+
+		t.Run("commit without proposal", func(t *testing.T) {
+			t.Parallel()
+			have := changelog.ParseEntry("abc1234", "feature done\n\nGit-Town-Branch: feature")
+			want := &changelog.Entry{
+				Branch:   "feature",
+				Labels:   []string{},
+				Proposal: 0,
+				SHA:      "abc1234",
+				Title:    "feature done",
+			}
+			assert.Equal(t, want, have)
+		})
+
+		t.Run("commit that doesn't ship a branch", func(t *testing.T) {
+			t.Parallel()
+			assert.Nil(t, changelog.ParseEntry("abc1234", "fix: typo"))
+		})
+
+		t.Run("branch trailer outside the last paragraph", func(t *testing.T) {
+			t.Parallel()
+			assert.Nil(t, changelog.ParseEntry("abc1234", "title\n\nGit-Town-Branch: feature\n\nmore text"))
+		})
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			give changelog.Entry
+			want string
+		}{
+			{give: changelog.Entry{Title: "feature done", Proposal: 0}, want: "feature done"},                 //nolint:exhaustruct
+			{give: changelog.Entry{Title: "feature done", Proposal: 123}, want: "feature done (#123)"},        //nolint:exhaustruct
+			{give: changelog.Entry{Title: "feature done (#123)", Proposal: 123}, want: "feature done (#123)"}, //nolint:exhaustruct
+		}
+		for _, test := range tests {
+			assert.Equal(t, test.want, test.give.Text())
+		}
+	})
+}
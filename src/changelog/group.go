@@ -0,0 +1,53 @@
+package changelog
+
+import (
+	"sort"
+)
+
+const (
+	// DefaultGroupTitle is the title of the group that contains all entries if none of them has labels.
+	DefaultGroupTitle = "Changes"
+	// UnlabeledGroupTitle is the title of the group that contains the entries without labels if other entries have labels.
+	UnlabeledGroupTitle = "Other changes"
+)
+
+// Group is a section of the changelog.
+type Group struct {
+	Title   string  `json:"title"`
+	Entries []Entry `json:"entries"`
+}
+
+// GroupEntries groups the given entries by their first label, sorted by label.
+// Entries without labels come last.
+// If no entry has labels, all entries are in a single group.
+func GroupEntries(entries []Entry) []Group {
+	entriesByLabel := map[string][]Entry{}
+	labels := []string{}
+	unlabeled := []Entry{}
+	for _, entry := range entries {
+		if len(entry.Labels) == 0 {
+			unlabeled = append(unlabeled, entry)
+			continue
+		}
+		label := entry.Labels[0]
+		if _, has := entriesByLabel[label]; !has {
+			labels = append(labels, label)
+		}
+		entriesByLabel[label] = append(entriesByLabel[label], entry)
+	}
+	if len(labels) == 0 {
+		if len(unlabeled) == 0 {
+			return []Group{}
+		}
+		return []Group{{Title: DefaultGroupTitle, Entries: unlabeled}}
+	}
+	sort.Strings(labels)
+	result := make([]Group, 0, len(labels)+1)
+	for _, label := range labels {
+		result = append(result, Group{Title: label, Entries: entriesByLabel[label]})
+	}
+	if len(unlabeled) > 0 {
+		result = append(result, Group{Title: UnlabeledGroupTitle, Entries: unlabeled})
+	}
+	return result
+}
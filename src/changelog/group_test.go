@@ -0,0 +1,44 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupEntries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no labels", func(t *testing.T) {
+		t.Parallel()
+		one := changelog.Entry{Title: "one", Labels: []string{}} //nolint:exhaustruct
+		two := changelog.Entry{Title: "two", Labels: []string{}} //nolint:exhaustruct
+		have := changelog.GroupEntries([]changelog.Entry{one, two})
+		want := []changelog.Group{
+			{Title: "Changes", Entries: []changelog.Entry{one, two}},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("labels", func(t *testing.T) {
+		t.Parallel()
+		one := changelog.Entry{Title: "one", Labels: []string{"feature"}}       //nolint:exhaustruct
+		two := changelog.Entry{Title: "two", Labels: []string{}}                //nolint:exhaustruct
+		three := changelog.Entry{Title: "three", Labels: []string{"bug", "ui"}} //nolint:exhaustruct
+		four := changelog.Entry{Title: "four", Labels: []string{"feature"}}     //nolint:exhaustruct
+		have := changelog.GroupEntries([]changelog.Entry{one, two, three, four})
+		want := []changelog.Group{
+			{Title: "bug", Entries: []changelog.Entry{three}},
+			{Title: "feature", Entries: []changelog.Entry{one, four}},
+			{Title: "Other changes", Entries: []changelog.Entry{two}},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		t.Parallel()
+		have := changelog.GroupEntries([]changelog.Entry{})
+		assert.Equal(t, []changelog.Group{}, have)
+	})
+}
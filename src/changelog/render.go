@@ -0,0 +1,29 @@
+package changelog
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// RenderJSON provides the given changelog groups as JSON.
+func RenderJSON(groups []Group) (string, error) {
+	content, err := json.MarshalIndent(groups, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// RenderMarkdown provides the given changelog groups as Markdown.
+func RenderMarkdown(groups []Group) string {
+	sections := make([]string, len(groups))
+	for g, group := range groups {
+		lines := make([]string, 0, len(group.Entries)+2)
+		lines = append(lines, "## "+group.Title, "")
+		for _, entry := range group.Entries {
+			lines = append(lines, "- "+entry.Text())
+		}
+		sections[g] = strings.Join(lines, "\n")
+	}
+	return strings.Join(sections, "\n\n")
+}
@@ -0,0 +1,70 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	groups := []changelog.Group{
+		{Title: "bug", Entries: []changelog.Entry{
+			{Branch: "fix", Labels: []string{"bug"}, Proposal: 12, SHA: "abc1234", Title: "fix the crash"},
+		}},
+		{Title: "Other changes", Entries: []changelog.Entry{
+			{Branch: "docs", Labels: []string{}, Proposal: 0, SHA: "def5678", Title: "improve the docs"},
+		}},
+	}
+
+	t.Run("RenderJSON", func(t *testing.T) {
+		t.Parallel()
+		have, err := changelog.RenderJSON(groups)
+		assert.NoError(t, err)
+		want := `
+[
+  {
+    "title": "bug",
+    "entries": [
+      {
+        "branch": "fix",
+        "labels": [
+          "bug"
+        ],
+        "proposal": 12,
+        "sha": "abc1234",
+        "title": "fix the crash"
+      }
+    ]
+  },
+  {
+    "title": "Other changes",
+    "entries": [
+      {
+        "branch": "docs",
+        "labels": [],
+        "proposal": 0,
+        "sha": "def5678",
+        "title": "improve the docs"
+      }
+    ]
+  }
+]`[1:]
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("RenderMarkdown", func(t *testing.T) {
+		t.Parallel()
+		have := changelog.RenderMarkdown(groups)
+		want := `
+## bug
+
+- fix the crash (#12)
+
+## Other changes
+
+- improve the docs`[1:]
+		assert.Equal(t, want, have)
+	})
+}
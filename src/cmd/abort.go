@@ -20,6 +20,7 @@ const abortDesc = "Aborts the last run git-town command"
 
 func abortCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:     "abort",
 		GroupID: "errors",
@@ -27,18 +28,22 @@ func abortCmd() *cobra.Command {
 		Short:   abortDesc,
 		Long:    long(abortDesc),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAbort(readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runAbort(readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runAbort(debug bool) error {
+func runAbort(debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -28,6 +28,7 @@ See "sync" for information regarding upstream remotes.`
 
 func appendCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:     "append <branch>",
 		GroupID: "lineage",
@@ -35,18 +36,22 @@ func appendCmd() *cobra.Command {
 		Short:   appendDesc,
 		Long:    long(appendDesc, appendHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAppend(args[0], readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runAppend(args[0], readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runAppend(arg string, debug bool) error {
+func runAppend(arg string, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -86,8 +91,10 @@ type appendConfig struct {
 	parentBranch        domain.LocalBranchName
 	previousBranch      domain.LocalBranchName
 	pullBranchStrategy  config.PullBranchStrategy
+	shareLineage        bool
 	shouldNewBranchPush bool
 	shouldSyncUpstream  bool
+	syncStrategies      config.BranchSyncStrategies
 	syncStrategy        config.SyncStrategy
 	targetBranch        domain.LocalBranchName
 }
@@ -139,6 +146,7 @@ func determineAppendConfig(targetBranch domain.LocalBranchName, repo *execute.Op
 	branchNamesToSync := lineage.BranchAndAncestors(branches.Initial)
 	branchesToSync := fc.BranchesSyncStatus(branches.All.Select(branchNamesToSync))
 	syncStrategy := fc.SyncStrategy(repo.Runner.Config.SyncStrategy())
+	branchSyncStrategies := fc.BranchSyncStrategies(repo.Runner.Config.BranchSyncStrategies())
 	shouldSyncUpstream := fc.Bool(repo.Runner.Config.ShouldSyncUpstream())
 	return &appendConfig{
 		branches:            branches,
@@ -152,8 +160,10 @@ func determineAppendConfig(targetBranch domain.LocalBranchName, repo *execute.Op
 		parentBranch:        branches.Initial,
 		previousBranch:      previousBranch,
 		pullBranchStrategy:  pullBranchStrategy,
+		shareLineage:        repo.ShareLineage,
 		shouldNewBranchPush: shouldNewBranchPush,
 		shouldSyncUpstream:  shouldSyncUpstream,
+		syncStrategies:      branchSyncStrategies,
 		syncStrategy:        syncStrategy,
 		targetBranch:        targetBranch,
 	}, false, fc.Err
@@ -172,7 +182,9 @@ func appendStepList(config *appendConfig) (runstate.StepList, error) {
 			pullBranchStrategy: config.pullBranchStrategy,
 			pushBranch:         true,
 			pushHook:           config.pushHook,
+			restackUpstream:    domain.SHA{},
 			shouldSyncUpstream: config.shouldSyncUpstream,
+			syncStrategies:     config.syncStrategies,
 			syncStrategy:       config.syncStrategy,
 		})
 	}
@@ -188,6 +200,7 @@ func appendStepList(config *appendConfig) (runstate.StepList, error) {
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return list.Result()
 }
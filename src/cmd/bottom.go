@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const bottomDesc = "Switches to the first branch in the stack of the current branch"
+
+const bottomHelp = `
+Switches to the ancestor of the current branch that is a child of the main branch or a perennial branch.
+Stashes and restores uncommitted changes.`
+
+func bottomCmd() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:     "bottom",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   bottomDesc,
+		Long:    long(bottomDesc, bottomHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runNavigate("bottom", determineBottomTarget, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func determineBottomTarget(config *navigateConfig) (domain.LocalBranchName, error) {
+	if !config.branches.Types.IsFeatureBranch(config.branches.Initial) {
+		return domain.LocalBranchName{}, fmt.Errorf(messages.NavigateNotInStack, config.branches.Initial)
+	}
+	stack := config.lineage.BranchAndAncestors(config.branches.Initial)
+	// the first element is the main or perennial branch at the root of the stack
+	if len(stack) < 2 {
+		return domain.LocalBranchName{}, fmt.Errorf(messages.NavigateNotInStack, config.branches.Initial)
+	}
+	return stack[1], nil
+}
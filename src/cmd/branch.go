@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/spf13/cobra"
+)
+
+const branchDesc = "Displays the branch hierarchy with the sync status of each branch"
+
+const branchHelp = `
+For each local branch, this command displays:
+- whether it is the current branch (*), the main branch, or a perennial branch
+- how it relates to its tracking branch
+- how many commits it is ahead of and behind its parent branch
+- whether it needs to be synced
+- the number of its open proposal, if the code hosting platform supports this
+
+With the --json flag, this command prints this information as JSON.`
+
+func branchCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addJSONFlag, readJSONFlag := flags.Bool("json", "", "Print the branch hierarchy as JSON")
+	cmd := cobra.Command{
+		Use:     "branch",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   branchDesc,
+		Long:    long(branchDesc, branchHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBranch(readJSONFlag(cmd), readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	addJSONFlag(&cmd)
+	return &cmd
+}
+
+func runBranch(asJSON, debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineBranchConfig(&repo, asJSON)
+	if err != nil || exit {
+		return err
+	}
+	tree, err := branchTree(config, &repo)
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		err = displayBranchTreeJSON(tree)
+		if err != nil {
+			return err
+		}
+	} else {
+		displayBranchTree(tree)
+	}
+	repo.Runner.Stats.PrintAnalysis()
+	return nil
+}
+
+type branchConfig struct {
+	branches  domain.Branches
+	connector hosting.Connector
+	isOffline bool
+	lineage   config.Lineage
+}
+
+func determineBranchConfig(repo *execute.OpenRepoResult, asJSON bool) (*branchConfig, bool, error) {
+	lineage := repo.Runner.Config.Lineage()
+	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
+		Repo:                  repo,
+		Fetch:                 false,
+		HandleUnfinishedState: false,
+		Lineage:               lineage,
+		ValidateIsConfigured:  false,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	originURL := repo.Runner.Config.OriginURL()
+	hostingService, err := repo.Runner.Config.HostingService()
+	if err != nil {
+		return nil, false, err
+	}
+	var log hosting.Log = cli.PrintingLog{}
+	if asJSON {
+		log = cli.SilentLog{}
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		HostingService:  hostingService,
+		GetSHAForBranch: repo.Runner.Backend.SHAForBranch,
+		OriginURL:       originURL,
+		GiteaAPIToken:   repo.Runner.Config.GiteaToken(),
+		GithubAPIToken:  repo.Runner.Config.GitHubToken(),
+		GitlabAPIToken:  repo.Runner.Config.GitLabToken(),
+		MainBranch:      branches.Types.MainBranch,
+		Log:             log,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &branchConfig{
+		branches:  branches,
+		connector: connector,
+		isOffline: repo.IsOffline,
+		lineage:   lineage,
+	}, false, nil
+}
+
+// branchTreeNode describes a branch and its descendents in the branch hierarchy.
+type branchTreeNode struct {
+	AheadOfParent  int                     `json:"aheadOfParent"`
+	AheadOfRemote  int                     `json:"aheadOfRemote"`
+	BehindParent   int                     `json:"behindParent"`
+	BehindRemote   int                     `json:"behindRemote"`
+	Children       []branchTreeNode        `json:"children"`
+	IsCurrent      bool                    `json:"isCurrent"`
+	IsMain         bool                    `json:"isMain"`
+	IsPerennial    bool                    `json:"isPerennial"`
+	Name           domain.LocalBranchName  `json:"name"`
+	NeedsSync      bool                    `json:"needsSync"`
+	Parent         domain.LocalBranchName  `json:"parent"`
+	Proposal       *branchTreeNodeProposal `json:"proposal"`
+	SyncStatus     string                  `json:"syncStatus"`
+	TrackingBranch domain.RemoteBranchName `json:"trackingBranch"`
+}
+
+// branchTreeNodeProposal describes the proposal of a branch in the branch hierarchy.
+type branchTreeNodeProposal struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Title  string `json:"title"`
+}
+
+// branchTree provides the branch hierarchy of all local branches.
+func branchTree(config *branchConfig, repo *execute.OpenRepoResult) ([]branchTreeNode, error) {
+	result := []branchTreeNode{}
+	for _, root := range branchTreeRoots(config) {
+		node, err := newBranchTreeNode(root, config, repo)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, node)
+	}
+	return result, nil
+}
+
+// branchTreeRoots provides the branches to display at the top level of the branch hierarchy:
+// the main branch, the perennial branches, and all local branches without a known local parent.
+// This command only displays information, so it shows branches whose parent isn't configured yet
+// as roots instead of asking the user for their parent.
+func branchTreeRoots(config *branchConfig) domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	if !config.branches.Types.MainBranch.IsEmpty() {
+		result = append(result, config.branches.Types.MainBranch)
+	}
+	perennialBranches := domain.LocalBranchNames{}
+	otherBranches := domain.LocalBranchNames{}
+	for _, branch := range config.branches.All.LocalBranches().Names() {
+		switch {
+		case branch == config.branches.Types.MainBranch:
+			continue
+		case config.branches.Types.IsPerennialBranch(branch):
+			perennialBranches = append(perennialBranches, branch)
+		case config.lineage.HasParents(branch) && config.branches.All.HasLocalBranch(config.lineage.Parent(branch)):
+			continue
+		default:
+			otherBranches = append(otherBranches, branch)
+		}
+	}
+	perennialBranches.Sort()
+	otherBranches.Sort()
+	result = append(result, perennialBranches...)
+	return append(result, otherBranches...)
+}
+
+func newBranchTreeNode(branch domain.LocalBranchName, config *branchConfig, repo *execute.OpenRepoResult) (branchTreeNode, error) {
+	result := branchTreeNode{
+		AheadOfParent:  0,
+		AheadOfRemote:  0,
+		BehindParent:   0,
+		BehindRemote:   0,
+		Children:       []branchTreeNode{},
+		IsCurrent:      branch == config.branches.Initial,
+		IsMain:         config.branches.Types.IsMainBranch(branch),
+		IsPerennial:    config.branches.Types.IsPerennialBranch(branch),
+		Name:           branch,
+		NeedsSync:      false,
+		Parent:         config.lineage.Parent(branch),
+		Proposal:       nil,
+		SyncStatus:     "",
+		TrackingBranch: domain.RemoteBranchName{},
+	}
+	branchInfo := config.branches.All.FindLocalBranch(branch)
+	if branchInfo != nil {
+		result.AheadOfRemote = branchInfo.Ahead
+		result.BehindRemote = branchInfo.Behind
+		result.SyncStatus = branchInfo.SyncStatus.String()
+		result.TrackingBranch = branchInfo.RemoteName
+		result.NeedsSync = branchInfo.SyncStatus != domain.SyncStatusUpToDate
+	}
+	if branchInfo != nil && !result.Parent.IsEmpty() && config.branches.All.HasLocalBranch(result.Parent) {
+		var err error
+		result.AheadOfParent, result.BehindParent, err = repo.Runner.Backend.CommitsAheadAndBehind(branch, result.Parent)
+		if err != nil {
+			return result, err
+		}
+		result.NeedsSync = result.NeedsSync || result.BehindParent > 0
+	}
+	if branchInfo != nil && branchInfo.HasTrackingBranch() && !result.Parent.IsEmpty() && config.connector != nil && !config.isOffline {
+		proposal, err := config.connector.FindProposal(branch, result.Parent)
+		if err != nil {
+			return result, err
+		}
+		if proposal != nil {
+			// the connectors only find open proposals
+			result.Proposal = &branchTreeNodeProposal{
+				Number: proposal.Number,
+				State:  "open",
+				Title:  proposal.Title,
+			}
+		}
+	}
+	for _, child := range config.lineage.Children(branch) {
+		if !config.branches.All.HasLocalBranch(child) || config.branches.Types.IsPerennialBranch(child) {
+			continue
+		}
+		childNode, err := newBranchTreeNode(child, config, repo)
+		if err != nil {
+			return result, err
+		}
+		result.Children = append(result.Children, childNode)
+	}
+	return result, nil
+}
+
+func displayBranchTree(tree []branchTreeNode) {
+	for _, node := range tree {
+		displayBranchTreeNode(node, 0)
+	}
+}
+
+func displayBranchTreeNode(node branchTreeNode, indent int) {
+	marker := " "
+	if node.IsCurrent {
+		marker = "*"
+	}
+	line := marker + " " + strings.Repeat("  ", indent) + node.Name.String()
+	switch {
+	case node.IsMain:
+		line += " (main)"
+	case node.IsPerennial:
+		line += " (perennial)"
+	}
+	details := []string{describeRemoteSyncStatus(node)}
+	if !node.Parent.IsEmpty() {
+		details = append(details, fmt.Sprintf("%d ahead of %s", node.AheadOfParent, node.Parent))
+		if node.BehindParent > 0 {
+			details = append(details, fmt.Sprintf("%d behind %s", node.BehindParent, node.Parent))
+		}
+	}
+	if node.Proposal != nil {
+		details = append(details, fmt.Sprintf("proposal #%d (%s)", node.Proposal.Number, node.Proposal.State))
+	}
+	if node.NeedsSync {
+		details = append(details, "needs sync")
+	}
+	fmt.Println(line + "  [" + strings.Join(details, ", ") + "]")
+	for _, child := range node.Children {
+		displayBranchTreeNode(child, indent+1)
+	}
+}
+
+// describeRemoteSyncStatus provides a human-readable description of how the given branch relates to its tracking branch.
+func describeRemoteSyncStatus(node branchTreeNode) string {
+	switch node.SyncStatus {
+	case domain.SyncStatusAhead.String():
+		return fmt.Sprintf("%d ahead of %s", node.AheadOfRemote, node.TrackingBranch)
+	case domain.SyncStatusBehind.String():
+		return fmt.Sprintf("%d behind %s", node.BehindRemote, node.TrackingBranch)
+	case domain.SyncStatusAheadAndBehind.String():
+		return fmt.Sprintf("%d ahead of and %d behind %s", node.AheadOfRemote, node.BehindRemote, node.TrackingBranch)
+	case domain.SyncStatusDeletedAtRemote.String():
+		return "tracking branch deleted"
+	case domain.SyncStatusLocalOnly.String():
+		return "no tracking branch"
+	}
+	return node.SyncStatus
+}
+
+func displayBranchTreeJSON(tree []branchTreeNode) error {
+	content, err := json.MarshalIndent(tree, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const changelogDesc = "Displays the branches shipped within the given range of commits"
+
+const changelogHelp = `
+"git ship" records the name of the shipped branch and its proposal
+in the commit that it creates on the main branch.
+This command finds these commits in the history of the main branch
+between the two given commits, tags, or branches
+and prints release notes for the shipped branches in Markdown.
+If you omit the end of the range, it ends at the main branch.
+
+With the --json flag, this command prints the release notes as JSON.
+With the --labels flag, this command loads the labels of the proposals
+from your code hosting service and groups the shipped branches by their first label.`
+
+func changelogCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addJSONFlag, readJSONFlag := flags.Bool("json", "", "Print the release notes as JSON")
+	addLabelsFlag, readLabelsFlag := flags.Bool("labels", "", "Group the shipped branches by the labels of their proposals")
+	cmd := cobra.Command{
+		Use:   "changelog <from>..<to>",
+		Args:  cobra.ExactArgs(1),
+		Short: changelogDesc,
+		Long:  long(changelogDesc, changelogHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runChangelog(args[0], readJSONFlag(cmd), readLabelsFlag(cmd), readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	addJSONFlag(&cmd)
+	addLabelsFlag(&cmd)
+	return &cmd
+}
+
+func runChangelog(revisionRange string, asJSON, withLabels, debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		ValidateIsOnline: withLabels,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	config, err := determineChangelogConfig(revisionRange, withLabels, &repo)
+	if err != nil {
+		return err
+	}
+	entries, err := loadChangelogEntries(config)
+	if err != nil {
+		return err
+	}
+	groups := changelog.GroupEntries(entries)
+	if asJSON {
+		text, err := changelog.RenderJSON(groups)
+		if err != nil {
+			return err
+		}
+		fmt.Println(text)
+	} else {
+		displayChangelog(groups)
+	}
+	repo.Runner.Stats.PrintAnalysis()
+	return nil
+}
+
+type changelogConfig struct {
+	connector     hosting.Connector // connector to load proposal labels with, nil if labels aren't requested
+	repo          *execute.OpenRepoResult
+	revisionRange string
+}
+
+func determineChangelogConfig(revisionRange string, withLabels bool, repo *execute.OpenRepoResult) (*changelogConfig, error) {
+	from, to, found := strings.Cut(revisionRange, "..")
+	if !found || from == "" || strings.HasPrefix(to, ".") {
+		return nil, fmt.Errorf(messages.ChangelogRangeInvalid, revisionRange)
+	}
+	if to == "" {
+		to = repo.Runner.Config.MainBranch().String()
+	}
+	var connector hosting.Connector
+	if withLabels {
+		hostingService, err := repo.Runner.Config.HostingService()
+		if err != nil {
+			return nil, err
+		}
+		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			HostingService:  hostingService,
+			GetSHAForBranch: repo.Runner.Backend.SHAForBranch,
+			OriginURL:       repo.Runner.Config.OriginURL(),
+			GiteaAPIToken:   repo.Runner.Config.GiteaToken(),
+			GithubAPIToken:  repo.Runner.Config.GitHubToken(),
+			GitlabAPIToken:  repo.Runner.Config.GitLabToken(),
+			MainBranch:      repo.Runner.Config.MainBranch(),
+			Log:             cli.PrintingLog{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if connector == nil {
+			return nil, hosting.UnsupportedServiceError()
+		}
+	}
+	return &changelogConfig{
+		connector:     connector,
+		repo:          repo,
+		revisionRange: from + ".." + to,
+	}, nil
+}
+
+func loadChangelogEntries(config *changelogConfig) ([]changelog.Entry, error) {
+	commits, err := config.repo.Runner.Backend.FirstParentCommits(config.revisionRange)
+	if err != nil {
+		return []changelog.Entry{}, err
+	}
+	result := []changelog.Entry{}
+	for _, commit := range commits {
+		entry := changelog.ParseEntry(commit.SHA.String(), commit.Message)
+		if entry == nil {
+			continue
+		}
+		if config.connector != nil && entry.Proposal > 0 {
+			entry.Labels, err = config.connector.ProposalLabels(entry.Proposal)
+			if err != nil {
+				return result, fmt.Errorf(messages.ProposalLabelsProblem, entry.Proposal, err)
+			}
+		}
+		result = append(result, *entry)
+	}
+	return result, nil
+}
+
+func displayChangelog(groups []changelog.Group) {
+	if len(groups) == 0 {
+		fmt.Println("No shipped branches found.")
+		return
+	}
+	fmt.Println(changelog.RenderMarkdown(groups))
+}
@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/git-town/git-town/v9/src/validate"
+	"github.com/spf13/cobra"
+)
+
+const compressDesc = "Squashes all commits on the current branch into a single commit"
+
+const compressHelp = `
+Replaces the commits that the current feature branch adds to its parent branch
+with a single commit.
+Opens the editor with the messages of the compressed commits
+so that you can write the message of the new commit.
+Aborts if the branch isn't in sync with its parent or its tracking branch.
+
+With the --stack flag, this command compresses every feature branch in the stack
+of the current branch, starting with the oldest ancestor.
+
+Child branches that don't get compressed remain based on the original commits
+until the next sync moves their own commits onto the compressed branch.
+
+When there is a tracking branch
+- force-pushes the compressed branch to the origin repository`
+
+func compressCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Specify the commit message for the compressed commit")
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Compress all feature branches in the stack of the current branch")
+	cmd := cobra.Command{
+		Use:   "compress",
+		Args:  cobra.NoArgs,
+		Short: compressDesc,
+		Long:  long(compressDesc, compressHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runCompress(readMessageFlag(cmd), readStackFlag(cmd), readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addMessageFlag(&cmd)
+	addProfileFlag(&cmd)
+	addStackFlag(&cmd)
+	return &cmd
+}
+
+func runCompress(message string, stack, debug, profile bool, traceFile string) error {
+	if stack && message != "" {
+		return fmt.Errorf(messages.CompressStackMessage)
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineCompressConfig(message, stack, &repo)
+	if err != nil || exit {
+		return err
+	}
+	stepList, err := compressStepList(config)
+	if err != nil {
+		return err
+	}
+	runState := runstate.RunState{
+		Command:     "compress",
+		RunStepList: stepList,
+	}
+	return runvm.Execute(runvm.ExecuteArgs{
+		RunState:  &runState,
+		Run:       &repo.Runner,
+		Connector: nil,
+		Lineage:   config.lineage,
+		RootDir:   repo.RootDir,
+	})
+}
+
+type compressConfig struct {
+	branches           domain.Branches
+	branchesToCompress []compressBranchConfig // ordered so that parent branches come before their children
+	isOffline          bool
+	lineage            config.Lineage
+	mainBranch         domain.LocalBranchName
+	noPushHook         bool
+	previousBranch     domain.LocalBranchName
+	shareLineage       bool
+}
+
+// compresses indicates whether this compress run compresses the given branch.
+func (cc compressConfig) compresses(branch domain.LocalBranchName) bool {
+	for _, branchToCompress := range cc.branchesToCompress {
+		if branchToCompress.branch.LocalName == branch {
+			return true
+		}
+	}
+	return false
+}
+
+// compressBranchConfig describes a branch that the compress command squashes.
+type compressBranchConfig struct {
+	branch            domain.BranchInfo
+	commitMessage     string
+	editCommitMessage bool // whether the user edits the commit message before committing
+	parent            domain.LocalBranchName
+}
+
+func determineCompressConfig(message string, stack bool, repo *execute.OpenRepoResult) (*compressConfig, bool, error) {
+	lineage := repo.Runner.Config.Lineage()
+	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
+		Repo:                  repo,
+		Fetch:                 true,
+		HandleUnfinishedState: true,
+		Lineage:               lineage,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: true,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	if !branches.Types.IsFeatureBranch(branches.Initial) {
+		return nil, false, errors.New(messages.CompressOnlyFeatureBranches)
+	}
+	mainBranch := repo.Runner.Config.MainBranch()
+	updated, err := validate.KnowsBranchAncestors(branches.Initial, validate.KnowsBranchAncestorsArgs{
+		DefaultBranch: mainBranch,
+		Backend:       &repo.Runner.Backend,
+		AllBranches:   branches.All,
+		Lineage:       lineage,
+		BranchTypes:   branches.Types,
+		MainBranch:    mainBranch,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if updated {
+		lineage = repo.Runner.Config.Lineage()
+	}
+	branchNamesToCompress := domain.LocalBranchNames{branches.Initial}
+	if stack {
+		branchNamesToCompress = stackToCompress(branches.Initial, branches, lineage)
+	}
+	branchesToCompress := []compressBranchConfig{}
+	for _, branchName := range branchNamesToCompress {
+		branch := branches.All.FindLocalBranch(branchName)
+		if branch == nil {
+			return nil, false, fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		parent := lineage.Parent(branchName)
+		commits, err := repo.Runner.Backend.CommitsInFeatureBranch(branchName, parent)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(commits) == 0 || (len(commits) == 1 && message == "") {
+			if stack {
+				continue
+			}
+			if len(commits) == 0 {
+				return nil, false, fmt.Errorf(messages.CompressNoCommits, branchName)
+			}
+			return nil, false, fmt.Errorf(messages.CompressSingleCommit, branchName)
+		}
+		if branch.SyncStatus == domain.SyncStatusBehind || branch.SyncStatus == domain.SyncStatusAheadAndBehind {
+			return nil, false, fmt.Errorf(messages.CompressBranchNotInSync, branchName)
+		}
+		_, behind, err := repo.Runner.Backend.CommitsAheadAndBehind(branchName, parent)
+		if err != nil {
+			return nil, false, err
+		}
+		if behind > 0 {
+			return nil, false, fmt.Errorf(messages.CompressBranchNotInSync, branchName)
+		}
+		commitMessage := message
+		if commitMessage == "" {
+			// read the messages now because compressing the parent branch first changes which commits this branch adds to it
+			commitMessages, err := repo.Runner.Backend.CommitMessagesInBranch(branchName, parent)
+			if err != nil {
+				return nil, false, err
+			}
+			commitMessage = strings.Join(commitMessages, "\n\n")
+		}
+		branchesToCompress = append(branchesToCompress, compressBranchConfig{
+			branch:            *branch,
+			commitMessage:     commitMessage,
+			editCommitMessage: message == "",
+			parent:            parent,
+		})
+	}
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	pushHook, err := repo.Runner.Config.PushHook()
+	if err != nil {
+		return nil, false, err
+	}
+	return &compressConfig{
+		branches:           branches,
+		branchesToCompress: branchesToCompress,
+		isOffline:          repo.IsOffline,
+		lineage:            lineage,
+		mainBranch:         mainBranch,
+		noPushHook:         !pushHook,
+		previousBranch:     previousBranch,
+		shareLineage:       repo.ShareLineage,
+	}, false, nil
+}
+
+// stackToCompress provides all feature branches in the stack of the given branch,
+// starting with its oldest feature ancestor and listing parent branches before their children.
+func stackToCompress(branch domain.LocalBranchName, branches domain.Branches, lineage config.Lineage) domain.LocalBranchNames {
+	root := branch
+	for _, ancestor := range lineage.Ancestors(branch) {
+		if branches.Types.IsFeatureBranch(ancestor) {
+			root = ancestor
+			break
+		}
+	}
+	return appendBranchAndDescendants(domain.LocalBranchNames{}, root, branches, lineage)
+}
+
+// appendBranchAndDescendants appends the given branch and its local descendant feature branches to the given list.
+func appendBranchAndDescendants(list domain.LocalBranchNames, branch domain.LocalBranchName, branches domain.Branches, lineage config.Lineage) domain.LocalBranchNames {
+	list = append(list, branch)
+	for _, child := range lineage.Children(branch) {
+		if branches.All.HasLocalBranch(child) && branches.Types.IsFeatureBranch(child) {
+			list = appendBranchAndDescendants(list, child, branches, lineage)
+		}
+	}
+	return list
+}
+
+func compressStepList(config *compressConfig) (runstate.StepList, error) {
+	result := runstate.StepList{}
+	for _, branchToCompress := range config.branchesToCompress {
+		branch := branchToCompress.branch
+		result.Append(&steps.CheckoutStep{Branch: branch.LocalName})
+		result.Append(&steps.CompressBranchStep{
+			CommitMessage:     branchToCompress.commitMessage,
+			EditCommitMessage: branchToCompress.editCommitMessage,
+			Parent:            branchToCompress.parent,
+		})
+		if branch.HasTrackingBranch() && !config.isOffline && !config.branches.Types.IsPrototypeBranch(branch.LocalName) {
+			result.Append(&steps.ForcePushMovedBranchStep{Branch: branch.LocalName, NoPushHook: config.noPushHook, RemoteSHA: branch.RemoteSHA})
+		}
+		for _, child := range config.lineage.Children(branch.LocalName) {
+			if config.branches.All.HasLocalBranch(child) && !config.compresses(child) {
+				// the child branch is still based on the commits that got compressed,
+				// syncing it moves only its own commits onto the compressed branch
+				result.Append(&steps.SetParentSHAStep{Branch: child, SHA: branch.LocalSHA})
+			}
+		}
+	}
+	result.Append(&steps.CheckoutStep{Branch: config.branches.Initial})
+	err := result.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     false,
+		StashOpenChanges: false,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
+	})
+	return result, err
+}
@@ -28,6 +28,8 @@ func configCmd() *cobra.Command {
 		},
 	}
 	addDebugFlag(&configCmd)
+	configCmd.AddCommand(downstreamConfigCmd())
+	configCmd.AddCommand(exportConfigCommand())
 	configCmd.AddCommand(mainbranchConfigCmd())
 	configCmd.AddCommand(offlineCmd())
 	configCmd.AddCommand(perennialBranchesCmd())
@@ -36,6 +38,8 @@ func configCmd() *cobra.Command {
 	configCmd.AddCommand(pushHookCommand())
 	configCmd.AddCommand(resetConfigCommand())
 	configCmd.AddCommand(setupConfigCommand())
+	configCmd.AddCommand(shipDeleteRemoteBranchCommand())
+	configCmd.AddCommand(shipStrategyCommand())
 	configCmd.AddCommand(syncStrategyCommand())
 	return &configCmd
 }
@@ -61,7 +65,9 @@ func runConfig(debug bool) error {
 
 func determineConfigConfig(run *git.ProdRunner) (ConfigConfig, error) {
 	fc := gohacks.FailureCollector{}
+	branchSyncStrategies := fc.BranchSyncStrategies(run.Config.BranchSyncStrategies())
 	branchTypes := run.Config.BranchTypes()
+	changelogTrailers := fc.Bool(run.Config.ShouldShipChangelogTrailers())
 	deleteOrigin := fc.Bool(run.Config.ShouldShipDeleteOriginBranch())
 	giteaToken := run.Config.GiteaToken()
 	githubToken := run.Config.GitHubToken()
@@ -72,10 +78,26 @@ func determineConfigConfig(run *git.ProdRunner) (ConfigConfig, error) {
 	pullBranchStrategy := fc.PullBranchStrategy(run.Config.PullBranchStrategy())
 	pushHook := fc.Bool(run.Config.PushHook())
 	pushNewBranches := fc.Bool(run.Config.ShouldNewBranchPush())
+	shipTargets := []shipTargetConfig{}
+	for _, target := range run.Config.ShipTargets() {
+		shipTarget := shipTargetConfig{branch: target, deleteOrigin: "", shipStrategy: ""}
+		if run.Config.LocalConfigValue(config.NewShipDeleteRemoteBranchKey(target)) != "" {
+			shipTarget.deleteOrigin = cli.BoolSetting(fc.Bool(run.Config.ShouldShipDeleteOriginBranchInto(target)))
+		}
+		if run.Config.LocalConfigValue(config.NewShipStrategyKey(target)) != "" {
+			shipTarget.shipStrategy = fc.ShipStrategy(run.Config.ShipStrategy(target)).String()
+		}
+		shipTargets = append(shipTargets, shipTarget)
+	}
 	shouldSyncUpstream := fc.Bool(run.Config.ShouldSyncUpstream())
 	syncStrategy := fc.SyncStrategy(run.Config.SyncStrategy())
+	sources := map[config.Key]config.ConfigSource{}
+	for _, key := range configSourceKeys() {
+		_, sources[key] = run.Config.LocalOrGlobalConfigValueAndSource(key)
+	}
 	return ConfigConfig{
 		branchTypes:        branchTypes,
+		changelogTrailers:  changelogTrailers,
 		deleteOrigin:       deleteOrigin,
 		hosting:            hosting,
 		giteaToken:         giteaToken,
@@ -86,13 +108,17 @@ func determineConfigConfig(run *git.ProdRunner) (ConfigConfig, error) {
 		pullBranchStrategy: pullBranchStrategy,
 		pushHook:           pushHook,
 		pushNewBranches:    pushNewBranches,
+		shipTargets:        shipTargets,
 		shouldSyncUpstream: shouldSyncUpstream,
+		sources:            sources,
+		syncStrategies:     branchSyncStrategies,
 		syncStrategy:       syncStrategy,
 	}, fc.Err
 }
 
 type ConfigConfig struct {
 	branchTypes        domain.BranchTypes
+	changelogTrailers  bool
 	deleteOrigin       bool
 	giteaToken         string
 	githubToken        string
@@ -103,32 +129,88 @@ type ConfigConfig struct {
 	pullBranchStrategy config.PullBranchStrategy
 	pushHook           bool
 	pushNewBranches    bool
+	shipTargets        []shipTargetConfig
 	shouldSyncUpstream bool
+	sources            map[config.Key]config.ConfigSource // where the displayed settings come from
+	syncStrategies     config.BranchSyncStrategies
 	syncStrategy       config.SyncStrategy
 }
 
-func printConfig(config ConfigConfig) {
+// shipTargetConfig contains the settings of a branch that has its own settings for shipping feature branches into it.
+type shipTargetConfig struct {
+	branch       domain.LocalBranchName
+	deleteOrigin string // empty if shipping into this branch uses the general setting
+	shipStrategy string // empty if shipping into this branch uses the default ship strategy
+}
+
+// configSourceKeys provides the keys of the settings whose origin "git town config" displays.
+func configSourceKeys() []config.Key {
+	return []config.Key{
+		config.KeyCodeHostingDriver,
+		config.KeyGiteaToken,
+		config.KeyGithubToken,
+		config.KeyGitlabToken,
+		config.KeyMainBranch,
+		config.KeyObservedBranches,
+		config.KeyOffline,
+		config.KeyPerennialBranches,
+		config.KeyPerennialRegex,
+		config.KeyPullBranchStrategy,
+		config.KeyPushHook,
+		config.KeyPushNewBranches,
+		config.KeyShipChangelogTrailers,
+		config.KeyShipDeleteRemoteBranch,
+		config.KeySyncStrategy,
+		config.KeySyncUpstream,
+	}
+}
+
+func printConfig(settings ConfigConfig) {
 	fmt.Println()
 	cli.PrintHeader("Branches")
-	cli.PrintEntry("main branch", cli.StringSetting(config.branchTypes.MainBranch.String()))
-	cli.PrintEntry("perennial branches", cli.StringSetting((config.branchTypes.PerennialBranches.Join(", "))))
+	printConfigEntry("main branch", cli.StringSetting(settings.branchTypes.MainBranch.String()), settings.sources[config.KeyMainBranch])
+	printConfigEntry("perennial branches", cli.StringSetting((settings.branchTypes.PerennialBranches.Join(", "))), settings.sources[config.KeyPerennialBranches])
+	printConfigEntry("perennial regex", cli.StringSetting(settings.branchTypes.PerennialRegex.String()), settings.sources[config.KeyPerennialRegex])
+	printConfigEntry("observed branches", cli.StringSetting(settings.branchTypes.ObservedBranches.Join(", ")), settings.sources[config.KeyObservedBranches])
+	printConfigEntry("prototype branches", cli.StringSetting(settings.branchTypes.PrototypeBranches.Join(", ")), config.ConfigSourceLocal)
 	fmt.Println()
 	cli.PrintHeader("Configuration")
-	cli.PrintEntry("offline", cli.BoolSetting(config.isOffline))
-	cli.PrintEntry("pull branch strategy", config.pullBranchStrategy.String())
-	cli.PrintEntry("run pre-push hook", cli.BoolSetting(config.pushHook))
-	cli.PrintEntry("push new branches", cli.BoolSetting(config.pushNewBranches))
-	cli.PrintEntry("ship removes the remote branch", cli.BoolSetting(config.deleteOrigin))
-	cli.PrintEntry("sync strategy", config.syncStrategy.String())
-	cli.PrintEntry("sync with upstream", cli.BoolSetting(config.shouldSyncUpstream))
+	printConfigEntry("offline", cli.BoolSetting(settings.isOffline), settings.sources[config.KeyOffline])
+	printConfigEntry("pull branch strategy", settings.pullBranchStrategy.String(), settings.sources[config.KeyPullBranchStrategy])
+	printConfigEntry("run pre-push hook", cli.BoolSetting(settings.pushHook), settings.sources[config.KeyPushHook])
+	printConfigEntry("push new branches", cli.BoolSetting(settings.pushNewBranches), settings.sources[config.KeyPushNewBranches])
+	printConfigEntry("ship records changelog trailers", cli.BoolSetting(settings.changelogTrailers), settings.sources[config.KeyShipChangelogTrailers])
+	printConfigEntry("ship removes the remote branch", cli.BoolSetting(settings.deleteOrigin), settings.sources[config.KeyShipDeleteRemoteBranch])
+	for _, shipTarget := range settings.shipTargets {
+		if shipTarget.deleteOrigin != "" {
+			printConfigEntry(fmt.Sprintf("ship into %s removes the remote branch", shipTarget.branch), shipTarget.deleteOrigin, config.ConfigSourceLocal)
+		}
+		if shipTarget.shipStrategy != "" {
+			printConfigEntry(fmt.Sprintf("ship strategy into %s", shipTarget.branch), shipTarget.shipStrategy, config.ConfigSourceLocal)
+		}
+	}
+	printConfigEntry("sync strategy", settings.syncStrategy.String(), settings.sources[config.KeySyncStrategy])
+	for _, branch := range settings.syncStrategies.Branches() {
+		printConfigEntry(fmt.Sprintf("sync strategy of %s", branch), settings.syncStrategies[branch].String(), config.ConfigSourceLocal)
+	}
+	printConfigEntry("sync with upstream", cli.BoolSetting(settings.shouldSyncUpstream), settings.sources[config.KeySyncUpstream])
 	fmt.Println()
 	cli.PrintHeader("Hosting")
-	cli.PrintEntry("hosting service override", cli.StringSetting(config.hosting.String()))
-	cli.PrintEntry("GitHub token", cli.StringSetting(config.githubToken))
-	cli.PrintEntry("GitLab token", cli.StringSetting(config.gitlabToken))
-	cli.PrintEntry("Gitea token", cli.StringSetting(config.giteaToken))
+	printConfigEntry("hosting service override", cli.StringSetting(settings.hosting.String()), settings.sources[config.KeyCodeHostingDriver])
+	printConfigEntry("GitHub token", cli.StringSetting(settings.githubToken), settings.sources[config.KeyGithubToken])
+	printConfigEntry("GitLab token", cli.StringSetting(settings.gitlabToken), settings.sources[config.KeyGitlabToken])
+	printConfigEntry("Gitea token", cli.StringSetting(settings.giteaToken), settings.sources[config.KeyGiteaToken])
 	fmt.Println()
-	if !config.branchTypes.MainBranch.IsEmpty() {
-		cli.PrintLabelAndValue("Branch Lineage", cli.PrintableBranchLineage(config.lineage))
+	if !settings.branchTypes.MainBranch.IsEmpty() {
+		cli.PrintLabelAndValue("Branch Lineage", cli.PrintableBranchLineage(settings.lineage))
+	}
+}
+
+// printConfigEntry prints the given setting together with where its value comes from.
+func printConfigEntry(label, value string, source config.ConfigSource) {
+	if value == cli.StringSetting("") {
+		cli.PrintEntry(label, value)
+		return
 	}
+	cli.PrintEntry(label, fmt.Sprintf("%s (%s)", value, source))
 }
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const downstreamDesc = "Displays or sets the branches that a perennial branch merges into"
+
+const downstreamHelp = `
+Perennial branches don't have parent branches.
+Some workflows merge the changes of a perennial branch into other perennial branches,
+for example hotfixes on a release branch into the development branch.
+
+"git town sync" on a perennial branch merges its new commits into its downstream branches
+and from there into their downstream branches.
+"git town ship" does the same after shipping a branch into a perennial branch.
+
+Provide "" as the only downstream branch to remove the downstream branches.`
+
+func downstreamConfigCmd() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	cmd := cobra.Command{
+		Use:   "downstream <perennial branch> [<downstream branch>...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: downstreamDesc,
+		Long:  long(downstreamDesc, downstreamHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigDownstream(args, readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	return &cmd
+}
+
+func runConfigDownstream(args []string, debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	branch := domain.NewLocalBranchName(args[0])
+	if len(args) > 1 {
+		return setDownstream(branch, args[1:], &repo.Runner)
+	}
+	printDownstream(branch, &repo.Runner)
+	return nil
+}
+
+func printDownstream(branch domain.LocalBranchName, run *git.ProdRunner) {
+	cli.Println(cli.StringSetting(run.Config.Downstream()[branch].Join("\n")))
+}
+
+func setDownstream(branch domain.LocalBranchName, downstreamArgs []string, run *git.ProdRunner) error {
+	branchTypes := run.Config.BranchTypes()
+	if !branchTypes.IsMainBranch(branch) && !branchTypes.IsPerennialBranch(branch) {
+		return fmt.Errorf(messages.DownstreamNoPerennialBranch, branch)
+	}
+	downstream := domain.LocalBranchNames{}
+	for _, arg := range downstreamArgs {
+		if arg == "" {
+			continue
+		}
+		downstreamBranch := domain.NewLocalBranchName(arg)
+		if downstreamBranch == branch {
+			return fmt.Errorf(messages.DownstreamSelf, branch)
+		}
+		if !run.Backend.HasLocalBranch(downstreamBranch) {
+			return fmt.Errorf(messages.BranchDoesntExist, downstreamBranch)
+		}
+		if !branchTypes.IsMainBranch(downstreamBranch) && !branchTypes.IsPerennialBranch(downstreamBranch) {
+			return fmt.Errorf(messages.DownstreamNoPerennialBranch, downstreamBranch)
+		}
+		downstream = append(downstream, downstreamBranch)
+	}
+	return run.Config.SetDownstream(branch, downstream)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const exportConfigDesc = "Stores your Git Town configuration in a file that you can commit"
+
+const exportConfigHelp = `
+Writes the current Git Town settings of this repository
+into the file %s in the root directory of the repository.
+Commit this file to share these settings with your team.
+
+Settings in the local or global Git configuration override the values in this file.
+API tokens and the offline mode are machine-specific and not exported.`
+
+func exportConfigCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	cmd := cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: exportConfigDesc,
+		Long:  long(exportConfigDesc, fmt.Sprintf(exportConfigHelp, config.ConfigFileName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigExport(readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	return &cmd
+}
+
+func runConfigExport(debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	values := config.GitConfigCache{}
+	for _, key := range config.ConfigFileKeys() {
+		values[key] = repo.Runner.Config.LocalOrGlobalConfigValue(key)
+	}
+	content, err := config.RenderConfigFile(values)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(repo.RootDir.String(), config.ConfigFileName), content, 0o644) //nolint:gosec // other users of this repo need to read this file
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileCannotWrite, config.ConfigFileName, err)
+	}
+	cli.Printf(messages.ConfigExportWritten, config.ConfigFileName)
+	return nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/git-town/git-town/v9/src/cli"
 	"github.com/git-town/git-town/v9/src/dialog"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
@@ -25,16 +26,22 @@ func setupConfigCommand() *cobra.Command {
 }
 
 func runConfigSetup(debug bool) error {
+	// this command is how users fix invalid configuration, so it must not fail on it
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		Debug:            debug,
-		DryRun:           false,
-		OmitBranchNames:  true,
-		ValidateIsOnline: false,
-		ValidateGitRepo:  true,
+		Debug:                 debug,
+		DryRun:                false,
+		OmitBranchNames:       true,
+		TolerateInvalidConfig: true,
+		ValidateIsOnline:      false,
+		ValidateGitRepo:       true,
 	})
 	if err != nil {
 		return err
 	}
+	_, err = repo.Runner.Config.PerennialRegex()
+	if err != nil {
+		cli.PrintWarning(err.Error())
+	}
 	lineage := repo.Runner.Config.Lineage()
 	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
 		Repo:                  &repo,
@@ -53,5 +60,9 @@ func runConfigSetup(debug bool) error {
 	}
 	branches.Types.MainBranch = newMainBranch
 	_, err = dialog.EnterPerennialBranches(&repo.Runner.Backend, branches)
+	if err != nil {
+		return err
+	}
+	_, err = dialog.EnterPerennialRegex(&repo.Runner.Backend, branches.Types.PerennialRegex)
 	return err
 }
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const shipDeleteRemoteBranchDesc = "Displays or sets whether shipping deletes the remote branch of the shipped branch"
+
+const shipDeleteRemoteBranchHelp = `
+Enabled by default. Disable it if your origin server deletes shipped branches,
+for example GitHub's feature to automatically delete head branches.
+
+With --branch, displays or sets this for shipping into the given branch,
+which overrides the general setting for that branch.
+Providing an empty value makes shipping into the branch use the general setting again.`
+
+func shipDeleteRemoteBranchCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addBranchFlag, readBranchFlag := flags.String("branch", "b", "", "Displays or sets this for shipping into the given branch")
+	cmd := cobra.Command{
+		Use:   "ship-delete-remote-branch [--branch <branch>] [(yes | no)]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: shipDeleteRemoteBranchDesc,
+		Long:  long(shipDeleteRemoteBranchDesc, shipDeleteRemoteBranchHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigShipDeleteRemoteBranch(args, readBranchFlag(cmd), readDebugFlag(cmd))
+		},
+	}
+	addBranchFlag(&cmd)
+	addDebugFlag(&cmd)
+	return &cmd
+}
+
+func runConfigShipDeleteRemoteBranch(args []string, branchName string, debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	if branchName == "" {
+		if len(args) > 0 {
+			return setShipDeleteRemoteBranch(args[0], &repo.Runner)
+		}
+		return printShipDeleteRemoteBranch(&repo.Runner)
+	}
+	target, err := shipTarget(branchName, &repo.Runner)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return setShipDeleteRemoteBranchInto(target, args[0], &repo.Runner)
+	}
+	return printShipDeleteRemoteBranchInto(target, &repo.Runner)
+}
+
+func printShipDeleteRemoteBranch(run *git.ProdRunner) error {
+	setting, err := run.Config.ShouldShipDeleteOriginBranch()
+	if err != nil {
+		return err
+	}
+	cli.Println(cli.FormatBool(setting))
+	return nil
+}
+
+func printShipDeleteRemoteBranchInto(target domain.LocalBranchName, run *git.ProdRunner) error {
+	setting, err := run.Config.ShouldShipDeleteOriginBranchInto(target)
+	if err != nil {
+		return err
+	}
+	cli.Println(cli.FormatBool(setting))
+	return nil
+}
+
+func setShipDeleteRemoteBranch(text string, run *git.ProdRunner) error {
+	value, err := config.ParseBool(text)
+	if err != nil {
+		return fmt.Errorf(messages.InputYesOrNo, text)
+	}
+	return run.Config.SetShouldShipDeleteRemoteBranch(value)
+}
+
+func setShipDeleteRemoteBranchInto(target domain.LocalBranchName, text string, run *git.ProdRunner) error {
+	if text == "" {
+		return run.Config.RemoveShipDeleteRemoteBranch(target)
+	}
+	value, err := config.ParseBool(text)
+	if err != nil {
+		return fmt.Errorf(messages.InputYesOrNo, text)
+	}
+	return run.Config.SetShipDeleteRemoteBranch(target, value)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const shipStrategyDesc = "Displays or sets how to ship feature branches into a branch"
+
+const shipStrategyHelp = `
+The ship strategy of a branch specifies how "git town ship"
+merges feature branches into it.
+"squash-merge" (the default) squash-merges the shipped branch into a single commit.
+"merge" merges the shipped branch with a merge commit.
+
+Applies to the main branch, or to the given branch with --branch.
+Providing an empty ship strategy makes shipping into the branch squash-merge again.`
+
+func shipStrategyCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addBranchFlag, readBranchFlag := flags.String("branch", "b", "", "Displays or sets the ship strategy of the given branch")
+	cmd := cobra.Command{
+		Use:   "ship-strategy [--branch <branch>] [(squash-merge | merge)]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: shipStrategyDesc,
+		Long:  long(shipStrategyDesc, shipStrategyHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigShipStrategy(args, readBranchFlag(cmd), readDebugFlag(cmd))
+		},
+	}
+	addBranchFlag(&cmd)
+	addDebugFlag(&cmd)
+	return &cmd
+}
+
+func runConfigShipStrategy(args []string, branchName string, debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	target, err := shipTarget(branchName, &repo.Runner)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return setShipStrategy(target, &repo.Runner, args[0])
+	}
+	return printShipStrategy(target, &repo.Runner)
+}
+
+func printShipStrategy(target domain.LocalBranchName, run *git.ProdRunner) error {
+	strategy, err := run.Config.ShipStrategy(target)
+	if err != nil {
+		return err
+	}
+	cli.Println(strategy)
+	return nil
+}
+
+func setShipStrategy(target domain.LocalBranchName, run *git.ProdRunner, value string) error {
+	if value == "" {
+		return run.Config.RemoveShipStrategy(target)
+	}
+	shipStrategy, err := config.ToShipStrategy(value)
+	if err != nil {
+		return err
+	}
+	return run.Config.SetShipStrategy(target, shipStrategy)
+}
+
+// shipTarget provides the branch that the ship settings given with the --branch flag apply to,
+// the main branch if the flag isn't given.
+func shipTarget(branchName string, run *git.ProdRunner) (domain.LocalBranchName, error) {
+	if branchName == "" {
+		return run.Config.MainBranch(), nil
+	}
+	branch := domain.NewLocalBranchName(branchName)
+	if !run.Backend.HasLocalBranch(branch) {
+		return branch, fmt.Errorf(messages.BranchDoesntExist, branch)
+	}
+	return branch, nil
+}
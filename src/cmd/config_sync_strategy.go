@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v9/src/cli"
 	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
 	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/steps"
 	"github.com/spf13/cobra"
 )
 
@@ -13,26 +18,34 @@ const syncStrategyDesc = "Displays or sets your sync strategy"
 
 const syncStrategyHelp = `
 The sync strategy specifies what strategy to use
-when merging remote tracking branches into local feature branches.`
+when merging remote tracking branches into local feature branches.
+
+With --branch, displays or sets the sync strategy of the given branch,
+which overrides the general sync strategy for that branch.
+Providing an empty sync strategy makes the branch use the general sync strategy again.
+Use this for example to rebase your private branches
+but merge into branches that other people have pulled.`
 
 func syncStrategyCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
 	addGlobalFlag, readGlobalFlag := flags.Bool("global", "g", "When set, displays or sets the sync strategy for all repos on this machine")
+	addBranchFlag, readBranchFlag := flags.String("branch", "b", "", "Displays or sets the sync strategy of the given branch")
 	cmd := cobra.Command{
 		Use:   "sync-strategy [(merge | rebase)]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: syncStrategyDesc,
 		Long:  long(syncStrategyDesc, syncStrategyHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runConfigSyncStrategy(args, readGlobalFlag(cmd), readDebugFlag(cmd))
+			return runConfigSyncStrategy(args, readGlobalFlag(cmd), readBranchFlag(cmd), readDebugFlag(cmd))
 		},
 	}
+	addBranchFlag(&cmd)
 	addDebugFlag(&cmd)
 	addGlobalFlag(&cmd)
 	return &cmd
 }
 
-func runConfigSyncStrategy(args []string, global, debug bool) error {
+func runConfigSyncStrategy(args []string, global bool, branchName string, debug bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
@@ -43,12 +56,34 @@ func runConfigSyncStrategy(args []string, global, debug bool) error {
 	if err != nil {
 		return err
 	}
+	if branchName != "" {
+		if global {
+			return fmt.Errorf(messages.ConfigSyncStrategyBranchGlobal)
+		}
+		branch := domain.NewLocalBranchName(branchName)
+		if !repo.Runner.Backend.HasLocalBranch(branch) {
+			return fmt.Errorf(messages.BranchDoesntExist, branch)
+		}
+		if len(args) > 0 {
+			return setBranchSyncStrategy(branch, &repo.Runner, args[0])
+		}
+		return printBranchSyncStrategy(branch, &repo.Runner)
+	}
 	if len(args) > 0 {
 		return setSyncStrategy(global, &repo.Runner, args[0])
 	}
 	return printSyncStrategy(global, &repo.Runner)
 }
 
+func printBranchSyncStrategy(branch domain.LocalBranchName, run *git.ProdRunner) error {
+	strategy, err := run.Config.BranchSyncStrategy(branch)
+	if err != nil {
+		return err
+	}
+	cli.Println(strategy)
+	return nil
+}
+
 func printSyncStrategy(globalFlag bool, run *git.ProdRunner) error {
 	var strategy config.SyncStrategy
 	var err error
@@ -74,3 +109,20 @@ func setSyncStrategy(globalFlag bool, run *git.ProdRunner, value string) error {
 	}
 	return run.Config.SetSyncStrategy(syncStrategy)
 }
+
+func setBranchSyncStrategy(branch domain.LocalBranchName, run *git.ProdRunner, value string) error {
+	if value == "" {
+		return run.Config.RemoveBranchSyncStrategy(branch)
+	}
+	syncStrategy, err := config.ToSyncStrategy(value)
+	if err != nil {
+		return err
+	}
+	return run.Config.SetBranchSyncStrategy(branch, syncStrategy)
+}
+
+// removeBranchSyncStrategyStep provides the step that removes the sync strategy of the given branch,
+// for example when Git Town deletes the branch.
+func removeBranchSyncStrategyStep(branch domain.LocalBranchName) steps.Step {
+	return &steps.RemoveConfigValueStep{Key: config.NewSyncStrategyKey(branch), Global: false}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/git-town/git-town/v9/src/cli"
 	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
 	"github.com/git-town/git-town/v9/src/hosting"
@@ -17,27 +18,37 @@ import (
 
 const continueDesc = "Restarts the last run git-town command after having resolved conflicts"
 
+const continueHelp = `
+After "git town sync --all --keep-going", syncs the branches that need manual syncing.
+Without a branch name, syncs these branches one at a time in the order in which "sync" encountered them.
+With a branch name, syncs the given one of these branches.`
+
 func continueCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
-		Use:     "continue",
+		Use:     "continue [<branch>]",
 		GroupID: "errors",
-		Args:    cobra.NoArgs,
+		Args:    cobra.MaximumNArgs(1),
 		Short:   continueDesc,
-		Long:    long(continueDesc),
+		Long:    long(continueDesc, continueHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runContinue(readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runContinue(args, readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runContinue(debug bool) error {
+func runContinue(args []string, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -48,7 +59,7 @@ func runContinue(debug bool) error {
 	if err != nil {
 		return err
 	}
-	runState, err := determineContinueRunstate(&repo)
+	runState, err := determineContinueRunstate(args, &repo)
 	if err != nil {
 		return err
 	}
@@ -108,13 +119,38 @@ type continueConfig struct {
 	lineage   config.Lineage
 }
 
-func determineContinueRunstate(repo *execute.OpenRepoResult) (*runstate.RunState, error) {
+func determineContinueRunstate(args []string, repo *execute.OpenRepoResult) (*runstate.RunState, error) {
 	runState, err := persistence.Load(repo.RootDir)
 	if err != nil {
 		return nil, fmt.Errorf(messages.RunstateLoadProblem, err)
 	}
-	if runState == nil || !runState.IsUnfinished() {
+	if runState == nil {
+		return nil, fmt.Errorf(messages.ContinueNothingToDo)
+	}
+	if runState.IsUnfinished() {
+		if len(args) > 0 {
+			return nil, fmt.Errorf(messages.ContinueBranchUnfinished)
+		}
+		return runState, nil
+	}
+	if !runState.HasFailedBranches() {
 		return nil, fmt.Errorf(messages.ContinueNothingToDo)
 	}
-	return runState, nil
+	branch := runState.FailedBranches[0].Branch
+	if len(args) > 0 {
+		branch = domain.NewLocalBranchName(args[0])
+		if !runState.HasFailedBranch(branch) {
+			return nil, fmt.Errorf(messages.ContinueBranchNotFailed, branch)
+		}
+	}
+	currentBranch, err := repo.Runner.Backend.CurrentBranch()
+	if err != nil {
+		return nil, err
+	}
+	hasOpenChanges, err := repo.Runner.Backend.HasOpenChanges()
+	if err != nil {
+		return nil, err
+	}
+	failedBranchRunState := runState.CreateFailedBranchRunState(branch, currentBranch, hasOpenChanges)
+	return &failedBranchRunState, nil
 }
@@ -7,14 +7,24 @@ func Execute() error {
 	rootCmd.AddCommand(abortCmd())
 	rootCmd.AddCommand(aliasesCommand())
 	rootCmd.AddCommand(appendCmd())
+	rootCmd.AddCommand(bottomCmd())
+	rootCmd.AddCommand(branchCommand())
+	rootCmd.AddCommand(changelogCommand())
 	rootCmd.AddCommand(completionsCmd(&rootCmd))
+	rootCmd.AddCommand(compressCommand())
 	rootCmd.AddCommand(configCmd())
 	rootCmd.AddCommand(continueCmd())
 	rootCmd.AddCommand(diffParentCommand())
+	rootCmd.AddCommand(doctorCommand())
+	rootCmd.AddCommand(downCmd())
 	rootCmd.AddCommand(hackCmd())
 	rootCmd.AddCommand(killCommand())
+	rootCmd.AddCommand(logShowCommand())
+	rootCmd.AddCommand(moveBranchCommand())
 	rootCmd.AddCommand(newPullRequestCommand())
+	rootCmd.AddCommand(observeCommand())
 	rootCmd.AddCommand(prependCommand())
+	rootCmd.AddCommand(prototypeCommand())
 	rootCmd.AddCommand(pruneBranchesCommand())
 	rootCmd.AddCommand(renameBranchCommand())
 	rootCmd.AddCommand(repoCommand())
@@ -24,7 +34,10 @@ func Execute() error {
 	rootCmd.AddCommand(skipCmd())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(syncCmd())
+	rootCmd.AddCommand(topCmd())
 	rootCmd.AddCommand(undoCmd())
+	rootCmd.AddCommand(unobserveCommand())
+	rootCmd.AddCommand(upCmd())
 	rootCmd.AddCommand(versionCmd())
 	return rootCmd.Execute()
 }
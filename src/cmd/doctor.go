@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/dialog"
+	"github.com/git-town/git-town/v9/src/doctor"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/spf13/cobra"
+)
+
+const doctorDesc = "Finds and repairs problems in the Git Town setup of this repository"
+
+const doctorHelp = `
+Verifies the branch lineage, the values of the Git Town configuration entries,
+and the connection to your code hosting platform.
+
+The branch lineage contains problems if it has cycles,
+entries for branches that don't exist or aren't feature branches,
+parent branches that don't exist,
+or parent branches that the commit history contradicts.
+
+Offers to repair the problems it finds. You can undo the repairs with "git town undo".`
+
+func doctorCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	cmd := cobra.Command{
+		Use:     "doctor",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   doctorDesc,
+		Long:    long(doctorDesc, doctorHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	return &cmd
+}
+
+func runDoctor(debug bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:                 debug,
+		DryRun:                false,
+		OmitBranchNames:       false,
+		TolerateInvalidConfig: true,
+		ValidateIsOnline:      false,
+		ValidateGitRepo:       true,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineDoctorConfig(&repo)
+	if err != nil || exit {
+		return err
+	}
+	repo.Runner.Stats.PrintAnalysis()
+	if len(config.problems) == 0 {
+		fmt.Println(messages.DoctorNoProblems)
+		return nil
+	}
+	printProblems(config.problems)
+	fixes := config.problems.Fixes()
+	if len(fixes) == 0 {
+		return nil
+	}
+	shouldFix, err := dialog.Select(dialog.SelectArgs{
+		Options: []string{messages.DoctorPromptFixYes, messages.DoctorPromptFixNo},
+		Default: messages.DoctorPromptFixYes,
+		Message: messages.DoctorPromptFix,
+	})
+	if err != nil || shouldFix != messages.DoctorPromptFixYes {
+		return err
+	}
+	stepList := runstate.StepList{}
+	stepList.Append(fixes...)
+	err = stepList.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     false,
+		StashOpenChanges: false,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
+	})
+	if err != nil {
+		return err
+	}
+	runState := runstate.RunState{
+		Command:     "doctor",
+		RunStepList: stepList,
+	}
+	return runvm.Execute(runvm.ExecuteArgs{
+		RunState:  &runState,
+		Run:       &repo.Runner,
+		Connector: nil,
+		Lineage:   config.lineage,
+		RootDir:   repo.RootDir,
+	})
+}
+
+type doctorConfig struct {
+	branches       domain.Branches
+	lineage        config.Lineage
+	mainBranch     domain.LocalBranchName
+	previousBranch domain.LocalBranchName
+	problems       doctor.Problems
+	shareLineage   bool
+}
+
+func determineDoctorConfig(repo *execute.OpenRepoResult) (*doctorConfig, bool, error) {
+	lineage := repo.Runner.Config.Lineage()
+	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
+		Repo:                  repo,
+		Fetch:                 false,
+		HandleUnfinishedState: true,
+		Lineage:               lineage,
+		ValidateIsConfigured:  false,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	mainBranch := repo.Runner.Config.MainBranch()
+	problems := doctor.CheckConfigFile(repo.RootDir)
+	problems = append(problems, doctor.CheckConfig(repo.Runner.Config.GitTown, branches.All)...)
+	if branches.All.HasLocalBranch(mainBranch) {
+		// the lineage checks repair the lineage using the main branch
+		lineageProblems, err := doctor.CheckLineage(doctor.CheckLineageArgs{
+			AllBranches: branches.All,
+			Backend:     &repo.Runner.Backend,
+			BranchTypes: branches.Types,
+			Lineage:     lineage,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		problems = append(problems, lineageProblems...)
+	}
+	remotes, err := repo.Runner.Backend.Remotes()
+	if err != nil {
+		return nil, false, err
+	}
+	hostingService, err := repo.Runner.Config.HostingService()
+	if remotes.HasOrigin() && err == nil {
+		connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+			HostingService:  hostingService,
+			GetSHAForBranch: repo.Runner.Backend.SHAForBranch,
+			OriginURL:       repo.Runner.Config.OriginURL(),
+			GiteaAPIToken:   repo.Runner.Config.GiteaToken(),
+			GithubAPIToken:  repo.Runner.Config.GitHubToken(),
+			GitlabAPIToken:  repo.Runner.Config.GitLabToken(),
+			MainBranch:      mainBranch,
+			Log:             cli.PrintingLog{},
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		problems = append(problems, doctor.CheckHosting(repo.Runner.Config.GitTown, connector)...)
+	}
+	return &doctorConfig{
+		branches:       branches,
+		lineage:        lineage,
+		mainBranch:     mainBranch,
+		previousBranch: repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+		problems:       problems,
+		shareLineage:   repo.ShareLineage,
+	}, false, nil
+}
+
+func printProblems(problems doctor.Problems) {
+	for _, problem := range problems {
+		fmt.Printf("- %s\n", problem.Description)
+		if problem.CanFix() {
+			fmt.Printf("  fix: %s\n", problem.Remedy)
+		} else {
+			fmt.Printf("  hint: %s\n", problem.Remedy)
+		}
+	}
+	fmt.Println()
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const downDesc = "Switches to the parent branch of the current branch"
+
+const downHelp = `
+Stashes and restores uncommitted changes.`
+
+func downCmd() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:     "down",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   downDesc,
+		Long:    long(downDesc, downHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runNavigate("down", determineDownTarget, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func determineDownTarget(config *navigateConfig) (domain.LocalBranchName, error) {
+	parent := config.lineage.Parent(config.branches.Initial)
+	if parent.IsEmpty() || !config.branches.All.HasLocalBranch(parent) {
+		return domain.LocalBranchName{}, fmt.Errorf(messages.NavigateNoParentBranch, config.branches.Initial)
+	}
+	return parent, nil
+}
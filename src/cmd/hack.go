@@ -13,6 +13,7 @@ import (
 	"github.com/git-town/git-town/v9/src/messages"
 	"github.com/git-town/git-town/v9/src/runstate"
 	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/steps"
 	"github.com/git-town/git-town/v9/src/validate"
 	"github.com/spf13/cobra"
 )
@@ -26,10 +27,14 @@ pushes the new feature branch to origin
 (if and only if "push-new-branches" is true),
 and brings over all uncommitted changes to the new feature branch.
 
+If a branch with the given name exists only at the "origin" remote,
+offers to check it out as an observed branch.
+
 See "sync" for information regarding upstream remotes.`
 
 func hackCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	addPromptFlag, readPromptFlag := flags.Bool("prompt", "p", "Prompt for the parent branch")
 	cmd := cobra.Command{
 		Use:     "hack <branch>",
@@ -38,19 +43,23 @@ func hackCmd() *cobra.Command {
 		Short:   hackDesc,
 		Long:    long(hackDesc, hackHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runHack(args, readPromptFlag(cmd), readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runHack(args, readPromptFlag(cmd), readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	addPromptFlag(&cmd)
 	return &cmd
 }
 
-func runHack(args []string, promptForParent, debug bool) error {
+func runHack(args []string, promptForParent, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -61,7 +70,12 @@ func runHack(args []string, promptForParent, debug bool) error {
 	if err != nil || exit {
 		return err
 	}
-	stepList, err := appendStepList(config)
+	var stepList runstate.StepList
+	if config.observeRemoteBranch {
+		stepList, err = observeRemoteBranchStepList(config)
+	} else {
+		stepList, err = appendStepList(&config.appendConfig)
+	}
 	if err != nil {
 		return err
 	}
@@ -78,7 +92,12 @@ func runHack(args []string, promptForParent, debug bool) error {
 	})
 }
 
-func determineHackConfig(args []string, promptForParent bool, repo *execute.OpenRepoResult) (*appendConfig, bool, error) {
+type hackConfig struct {
+	appendConfig
+	observeRemoteBranch bool // whether to check out the existing remote branch as an observed branch instead of creating a new feature branch
+}
+
+func determineHackConfig(args []string, promptForParent bool, repo *execute.OpenRepoResult) (*hackConfig, bool, error) {
 	lineage := repo.Runner.Config.Lineage()
 	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
 		Repo:                  repo,
@@ -117,33 +136,68 @@ func determineHackConfig(args []string, promptForParent bool, repo *execute.Open
 	if branches.All.HasLocalBranch(targetBranch) {
 		return nil, false, fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
 	}
+	observeRemoteBranch := false
 	if branches.All.HasMatchingRemoteBranchFor(targetBranch) {
-		return nil, false, fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
+		answer, err := dialog.Select(dialog.SelectArgs{
+			Options: []string{messages.HackObserveYes, messages.HackObserveNo},
+			Default: messages.HackObserveYes,
+			Message: fmt.Sprintf(messages.HackObservePrompt, targetBranch),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if answer != messages.HackObserveYes {
+			return nil, false, fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
+		}
+		observeRemoteBranch = true
 	}
 	branchNamesToSync := lineage.BranchesAndAncestors(domain.LocalBranchNames{parentBranch})
 	branchesToSync := fc.BranchesSyncStatus(branches.All.Select(branchNamesToSync))
 	shouldSyncUpstream := fc.Bool(repo.Runner.Config.ShouldSyncUpstream())
 	pullBranchStrategy := fc.PullBranchStrategy(repo.Runner.Config.PullBranchStrategy())
 	syncStrategy := fc.SyncStrategy(repo.Runner.Config.SyncStrategy())
-	return &appendConfig{
-		branches:            branches,
-		branchesToSync:      branchesToSync,
-		targetBranch:        targetBranch,
-		parentBranch:        parentBranch,
-		hasOpenChanges:      hasOpenChanges,
-		remotes:             remotes,
-		lineage:             lineage,
-		mainBranch:          mainBranch,
-		shouldNewBranchPush: shouldNewBranchPush,
-		previousBranch:      previousBranch,
-		pullBranchStrategy:  pullBranchStrategy,
-		pushHook:            pushHook,
-		isOffline:           isOffline,
-		shouldSyncUpstream:  shouldSyncUpstream,
-		syncStrategy:        syncStrategy,
+	branchSyncStrategies := fc.BranchSyncStrategies(repo.Runner.Config.BranchSyncStrategies())
+	return &hackConfig{
+		appendConfig: appendConfig{
+			branches:            branches,
+			branchesToSync:      branchesToSync,
+			targetBranch:        targetBranch,
+			parentBranch:        parentBranch,
+			hasOpenChanges:      hasOpenChanges,
+			remotes:             remotes,
+			lineage:             lineage,
+			mainBranch:          mainBranch,
+			shouldNewBranchPush: shouldNewBranchPush,
+			previousBranch:      previousBranch,
+			pullBranchStrategy:  pullBranchStrategy,
+			pushHook:            pushHook,
+			isOffline:           isOffline,
+			shareLineage:        repo.ShareLineage,
+			shouldSyncUpstream:  shouldSyncUpstream,
+			syncStrategies:      branchSyncStrategies,
+			syncStrategy:        syncStrategy,
+		},
+		observeRemoteBranch: observeRemoteBranch,
 	}, false, fc.Err
 }
 
+// observeRemoteBranchStepList provides the steps to check out the existing remote branch as an observed branch.
+func observeRemoteBranchStepList(config *hackConfig) (runstate.StepList, error) {
+	list := runstate.StepListBuilder{}
+	list.Add(&steps.CreateBranchStep{Branch: config.targetBranch, StartingPoint: domain.NewLocation(config.targetBranch.RemoteBranch().String())})
+	list.Add(&steps.AddToObservedBranchesStep{Branch: config.targetBranch})
+	list.Add(&steps.CheckoutStep{Branch: config.targetBranch})
+	list.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     true,
+		StashOpenChanges: config.hasOpenChanges,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
+	})
+	return list.Result()
+}
+
 func determineParentBranch(args determineParentBranchArgs) (parentBranch domain.LocalBranchName, updated bool, err error) {
 	if !args.promptForParent {
 		return args.mainBranch, false, nil
@@ -20,28 +20,35 @@ const killDesc = "Removes an obsolete feature branch"
 
 const killHelp = `
 Deletes the current or provided branch from the local and origin repositories.
+Deletes observed branches only from the local repository
+because their tracking branches belong to other people.
 Does not delete perennial branches nor the main branch.`
 
 func killCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:   "kill [<branch>]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: killDesc,
 		Long:  long(killDesc, killHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runKill(args, readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runKill(args, readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runKill(args []string, debug bool) error {
+func runKill(args []string, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -72,11 +79,15 @@ func runKill(args []string, debug bool) error {
 type killConfig struct {
 	hasOpenChanges bool
 	initialBranch  domain.LocalBranchName
+	isObserved     bool
 	isOffline      bool
+	isPrototype    bool
 	lineage        config.Lineage
 	mainBranch     domain.LocalBranchName
 	noPushHook     bool
 	previousBranch domain.LocalBranchName
+	shareLineage   bool
+	syncStrategies config.BranchSyncStrategies
 	targetBranch   domain.BranchInfo
 }
 
@@ -95,14 +106,15 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult) (*killConf
 	}
 	mainBranch := repo.Runner.Config.MainBranch()
 	targetBranchName := domain.NewLocalBranchName(slice.FirstElementOr(args, branches.Initial.String()))
-	if !branches.Types.IsFeatureBranch(targetBranchName) {
+	isObserved := branches.Types.IsObservedBranch(targetBranchName)
+	if !branches.Types.IsFeatureBranch(targetBranchName) && !isObserved {
 		return nil, false, fmt.Errorf(messages.KillOnlyFeatureBranches)
 	}
 	targetBranch := branches.All.FindLocalBranch(targetBranchName)
 	if targetBranch == nil {
 		return nil, false, fmt.Errorf(messages.BranchDoesntExist, targetBranchName)
 	}
-	if targetBranch.IsLocal() {
+	if targetBranch.IsLocal() && !isObserved {
 		updated, err := validate.KnowsBranchAncestors(targetBranchName, validate.KnowsBranchAncestorsArgs{
 			DefaultBranch: mainBranch,
 			Backend:       &repo.Runner.Backend,
@@ -128,14 +140,22 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult) (*killConf
 	if err != nil {
 		return nil, false, err
 	}
+	syncStrategies, err := repo.Runner.Config.BranchSyncStrategies()
+	if err != nil {
+		return nil, false, err
+	}
 	return &killConfig{
 		hasOpenChanges: hasOpenChanges,
 		initialBranch:  branches.Initial,
+		isObserved:     isObserved,
 		isOffline:      repo.IsOffline,
+		isPrototype:    branches.Types.IsPrototypeBranch(targetBranchName),
 		lineage:        lineage,
 		mainBranch:     mainBranch,
 		noPushHook:     !pushHook,
 		previousBranch: previousBranch,
+		shareLineage:   repo.ShareLineage,
+		syncStrategies: syncStrategies,
 		targetBranch:   *targetBranch,
 	}, false, nil
 }
@@ -144,7 +164,12 @@ func (kc killConfig) isOnline() bool {
 	return !kc.isOffline
 }
 
+// targetBranchParent provides the parent of the branch to kill.
+// Observed branches have no parent, for them this provides the main branch.
 func (kc killConfig) targetBranchParent() domain.LocalBranchName {
+	if kc.isObserved {
+		return kc.mainBranch
+	}
 	return kc.lineage.Parent(kc.targetBranch.LocalName)
 }
 
@@ -157,13 +182,15 @@ func killStepList(config *killConfig) (runstate.StepList, error) {
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.initialBranch,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return result, err
 }
 
 // killFeatureBranch kills the given feature branch everywhere it exists (locally and remotely).
+// It kills observed branches only locally.
 func killFeatureBranch(list *runstate.StepList, config killConfig) {
-	if config.targetBranch.HasTrackingBranch() && config.isOnline() {
+	if config.targetBranch.HasTrackingBranch() && config.isOnline() && !config.isObserved {
 		list.Append(&steps.DeleteTrackingBranchStep{Branch: config.targetBranch.LocalName, NoPushHook: config.noPushHook})
 	}
 	if config.initialBranch == config.targetBranch.LocalName {
@@ -173,9 +200,19 @@ func killFeatureBranch(list *runstate.StepList, config killConfig) {
 		list.Append(&steps.CheckoutStep{Branch: config.targetBranchParent()})
 	}
 	list.Append(&steps.DeleteLocalBranchStep{Branch: config.targetBranch.LocalName, Parent: config.mainBranch.Location(), Force: true})
+	if _, hasSyncStrategy := config.syncStrategies[config.targetBranch.LocalName]; hasSyncStrategy {
+		list.Append(removeBranchSyncStrategyStep(config.targetBranch.LocalName))
+	}
+	if config.isObserved {
+		list.Append(&steps.RemoveFromObservedBranchesStep{Branch: config.targetBranch.LocalName})
+		return
+	}
 	childBranches := config.lineage.Children(config.targetBranch.LocalName)
 	for _, child := range childBranches {
 		list.Append(&steps.SetParentStep{Branch: child, ParentBranch: config.targetBranchParent()})
 	}
 	list.Append(&steps.DeleteParentBranchStep{Branch: config.targetBranch.LocalName, Parent: config.targetBranchParent()})
+	if config.isPrototype {
+		list.Append(removePrototypeStep(config.targetBranch.LocalName))
+	}
 }
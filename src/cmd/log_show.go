@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/commandlog"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/spf13/cobra"
+)
+
+const logShowDesc = "Displays the persistent log of the shell commands that Git Town ran"
+
+const logShowHelp = `
+When the "git-town.command-log" setting is enabled,
+Git Town records every shell command it runs in this repository,
+together with its output, its duration, the step that ran it,
+and the Git Town command that caused it.
+
+You can limit the displayed entries to those recorded at or after the date given via --since
+and to those caused by the Git Town command given via --command.`
+
+func logShowCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addSinceFlag, readSinceFlag := flags.String("since", "", "", "Only show commands run at or after the given date (YYYY-MM-DD)")
+	addCommandFlag, readCommandFlag := flags.String("command", "", "", "Only show commands caused by the given Git Town command")
+	cmd := cobra.Command{
+		Use:     "log-show",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   logShowDesc,
+		Long:    long(logShowDesc, logShowHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogShow(readSinceFlag(cmd), readCommandFlag(cmd), readDebugFlag(cmd))
+		},
+	}
+	addDebugFlag(&cmd)
+	addSinceFlag(&cmd)
+	addCommandFlag(&cmd)
+	return &cmd
+}
+
+func runLogShow(sinceText, command string, debug bool) error {
+	since, err := commandlog.ParseSince(sinceText)
+	if err != nil {
+		return err
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	entries, err := commandlog.Load(repo.RootDir)
+	if err != nil {
+		return err
+	}
+	displayCommandLog(commandlog.Filter(entries, since, command), repo.CommandLogEnabled)
+	repo.Runner.Stats.PrintAnalysis()
+	return nil
+}
+
+func displayCommandLog(entries []commandlog.Entry, enabled bool) {
+	if len(entries) == 0 {
+		fmt.Println("The command log is empty.")
+		if !enabled {
+			fmt.Println(`To enable it, run "git config git-town.command-log true".`)
+		}
+		return
+	}
+	for _, entry := range entries {
+		fmt.Printf("%s  git town %s  %s  %s  exit %d  %s\n",
+			entry.Time.Local().Format("2006-01-02 15:04:05"),
+			entry.GitTownCommand,
+			stepNameOrNone(entry.Step),
+			commandType(entry.Frontend),
+			entry.ExitCode,
+			entry.Duration().Round(time.Microsecond),
+		)
+		fmt.Println("  " + entry.Command)
+		output := strings.TrimRight(entry.Output, "\n")
+		if output != "" {
+			for _, line := range strings.Split(output, "\n") {
+				fmt.Println("    " + line)
+			}
+		}
+	}
+}
+
+func commandType(frontend bool) string {
+	if frontend {
+		return "frontend"
+	}
+	return "backend"
+}
+
+func stepNameOrNone(step string) string {
+	if step == "" {
+		return "(no step)"
+	}
+	return step
+}
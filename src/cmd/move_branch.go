@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/git-town/git-town/v9/src/validate"
+	"github.com/spf13/cobra"
+)
+
+const moveBranchDesc = "Moves the current branch and its descendants onto a new parent branch"
+
+const moveBranchHelp = `
+Unlike "git town set-parent", this command also moves the commits.
+It replays only the commits that the current branch adds to its old parent
+onto the new parent, and the commits of all descendant branches onto their moved parents.
+
+When there is a tracking branch
+- force-pushes the moved branches to the origin repository
+
+When the code hosting platform supports it
+- changes the target of the proposal of the current branch to the new parent`
+
+func moveBranchCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:     "move-branch <new_parent>",
+		GroupID: "lineage",
+		Args:    cobra.ExactArgs(1),
+		Short:   moveBranchDesc,
+		Long:    long(moveBranchDesc, moveBranchHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runMoveBranch(args[0], readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func runMoveBranch(newParent string, debug, profile bool, traceFile string) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineMoveBranchConfig(domain.NewLocalBranchName(newParent), &repo)
+	if err != nil || exit {
+		return err
+	}
+	stepList, err := moveBranchStepList(config)
+	if err != nil {
+		return err
+	}
+	runState := runstate.RunState{
+		Command:     "move-branch",
+		RunStepList: stepList,
+	}
+	return runvm.Execute(runvm.ExecuteArgs{
+		RunState:  &runState,
+		Run:       &repo.Runner,
+		Connector: config.connector,
+		Lineage:   config.lineage,
+		RootDir:   repo.RootDir,
+	})
+}
+
+type moveBranchConfig struct {
+	branches       domain.Branches
+	connector      hosting.Connector
+	hasOpenChanges bool
+	isOffline      bool
+	lineage        config.Lineage
+	mainBranch     domain.LocalBranchName
+	newParent      domain.LocalBranchName
+	noPushHook     bool
+	oldParent      domain.LocalBranchName
+	previousBranch domain.LocalBranchName
+	proposal       *hosting.Proposal
+	shareLineage   bool
+}
+
+func determineMoveBranchConfig(newParent domain.LocalBranchName, repo *execute.OpenRepoResult) (*moveBranchConfig, bool, error) {
+	lineage := repo.Runner.Config.Lineage()
+	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
+		Repo:                  repo,
+		Fetch:                 true,
+		HandleUnfinishedState: true,
+		Lineage:               lineage,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	if !branches.Types.IsFeatureBranch(branches.Initial) {
+		return nil, false, errors.New(messages.MoveBranchOnlyFeatureBranches)
+	}
+	if !branches.All.HasLocalBranch(newParent) {
+		return nil, false, fmt.Errorf(messages.BranchDoesntExist, newParent)
+	}
+	if newParent == branches.Initial || lineage.IsAncestor(branches.Initial, newParent) {
+		return nil, false, fmt.Errorf(messages.MoveBranchOntoDescendant, branches.Initial, newParent)
+	}
+	mainBranch := repo.Runner.Config.MainBranch()
+	updated, err := validate.KnowsBranchAncestors(branches.Initial, validate.KnowsBranchAncestorsArgs{
+		DefaultBranch: mainBranch,
+		Backend:       &repo.Runner.Backend,
+		AllBranches:   branches.All,
+		Lineage:       lineage,
+		BranchTypes:   branches.Types,
+		MainBranch:    mainBranch,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if updated {
+		lineage = repo.Runner.Config.Lineage()
+	}
+	oldParent := lineage.Parent(branches.Initial)
+	if oldParent == newParent {
+		return nil, false, fmt.Errorf(messages.MoveBranchParentUnchanged, branches.Initial, newParent)
+	}
+	if !branches.All.HasLocalBranch(oldParent) {
+		return nil, false, fmt.Errorf(messages.BranchDoesntExist, oldParent)
+	}
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	hasOpenChanges, err := repo.Runner.Backend.HasOpenChanges()
+	if err != nil {
+		return nil, false, err
+	}
+	pushHook, err := repo.Runner.Config.PushHook()
+	if err != nil {
+		return nil, false, err
+	}
+	originURL := repo.Runner.Config.OriginURL()
+	hostingService, err := repo.Runner.Config.HostingService()
+	if err != nil {
+		return nil, false, err
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		HostingService:  hostingService,
+		GetSHAForBranch: repo.Runner.Backend.SHAForBranch,
+		OriginURL:       originURL,
+		GiteaAPIToken:   repo.Runner.Config.GiteaToken(),
+		GithubAPIToken:  repo.Runner.Config.GitHubToken(),
+		GitlabAPIToken:  repo.Runner.Config.GitLabToken(),
+		MainBranch:      mainBranch,
+		Log:             cli.PrintingLog{},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	var proposal *hosting.Proposal
+	initialBranch := branches.All.FindLocalBranch(branches.Initial)
+	if !repo.IsOffline && connector != nil && initialBranch != nil && initialBranch.HasTrackingBranch() {
+		proposal, err = connector.FindProposal(branches.Initial, oldParent)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return &moveBranchConfig{
+		branches:       branches,
+		connector:      connector,
+		hasOpenChanges: hasOpenChanges,
+		isOffline:      repo.IsOffline,
+		lineage:        lineage,
+		mainBranch:     mainBranch,
+		newParent:      newParent,
+		noPushHook:     !pushHook,
+		oldParent:      oldParent,
+		previousBranch: previousBranch,
+		proposal:       proposal,
+		shareLineage:   repo.ShareLineage,
+	}, false, nil
+}
+
+func moveBranchStepList(config *moveBranchConfig) (runstate.StepList, error) {
+	result := runstate.StepList{}
+	oldParentBranch := config.branches.All.FindLocalBranch(config.oldParent)
+	moveBranchOnto(&result, config.branches.Initial, config.newParent, oldParentBranch.LocalSHA, config)
+	result.Append(&steps.SetParentStep{Branch: config.branches.Initial, ParentBranch: config.newParent})
+	if config.proposal != nil {
+		result.Append(&steps.UpdateProposalTargetStep{
+			ProposalNumber: config.proposal.Number,
+			NewTarget:      config.newParent,
+			ExistingTarget: config.oldParent,
+		})
+	}
+	result.Append(&steps.CheckoutStep{Branch: config.branches.Initial})
+	err := result.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     true,
+		StashOpenChanges: config.hasOpenChanges,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
+	})
+	return result, err
+}
+
+// moveBranchOnto adds the steps to move the commits that the given branch has on top of the given upstream commit
+// onto the given new parent branch, followed by the steps to move all its descendants along with it.
+func moveBranchOnto(list *runstate.StepList, branch, newParent domain.LocalBranchName, upstream domain.SHA, config *moveBranchConfig) {
+	branchInfo := config.branches.All.FindLocalBranch(branch)
+	list.Append(&steps.CheckoutStep{Branch: branch})
+	list.Append(&steps.RebaseOntoStep{Onto: newParent, Upstream: upstream})
+	if branchInfo.HasTrackingBranch() && !config.isOffline && !config.branches.Types.IsPrototypeBranch(branch) {
+		list.Append(&steps.ForcePushMovedBranchStep{Branch: branch, NoPushHook: config.noPushHook, RemoteSHA: branchInfo.RemoteSHA})
+	}
+	for _, child := range config.lineage.Children(branch) {
+		if !config.branches.All.HasLocalBranch(child) {
+			continue
+		}
+		moveBranchOnto(list, child, branch, branchInfo.LocalSHA, config)
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/dialog"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/git-town/git-town/v9/src/validate"
+)
+
+// navigateTargetFunc determines the branch that a navigation command switches to.
+type navigateTargetFunc func(config *navigateConfig) (domain.LocalBranchName, error)
+
+// runNavigate implements the commands that move through a stack of branches: up, down, top, and bottom.
+func runNavigate(command string, determineTarget navigateTargetFunc, debug, profile bool, traceFile string) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	config, exit, err := determineNavigateConfig(&repo)
+	if err != nil || exit {
+		return err
+	}
+	targetBranch, err := determineTarget(config)
+	if err != nil {
+		return err
+	}
+	if targetBranch == config.branches.Initial {
+		repo.Runner.Stats.PrintAnalysis()
+		return nil
+	}
+	stepList, err := navigateStepList(targetBranch, config)
+	if err != nil {
+		return err
+	}
+	runState := runstate.RunState{
+		Command:     command,
+		RunStepList: stepList,
+	}
+	return runvm.Execute(runvm.ExecuteArgs{
+		RunState:  &runState,
+		Run:       &repo.Runner,
+		Connector: nil,
+		Lineage:   config.lineage,
+		RootDir:   repo.RootDir,
+	})
+}
+
+type navigateConfig struct {
+	branches       domain.Branches
+	hasOpenChanges bool
+	lineage        config.Lineage
+	mainBranch     domain.LocalBranchName
+}
+
+func determineNavigateConfig(repo *execute.OpenRepoResult) (*navigateConfig, bool, error) {
+	lineage := repo.Runner.Config.Lineage()
+	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
+		Repo:                  repo,
+		Fetch:                 false,
+		HandleUnfinishedState: true,
+		Lineage:               lineage,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	mainBranch := repo.Runner.Config.MainBranch()
+	updated, err := validate.KnowsBranchAncestors(branches.Initial, validate.KnowsBranchAncestorsArgs{
+		DefaultBranch: mainBranch,
+		Backend:       &repo.Runner.Backend,
+		AllBranches:   branches.All,
+		Lineage:       lineage,
+		BranchTypes:   branches.Types,
+		MainBranch:    mainBranch,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if updated {
+		lineage = repo.Runner.Config.Lineage()
+	}
+	hasOpenChanges, err := repo.Runner.Backend.HasOpenChanges()
+	if err != nil {
+		return nil, false, err
+	}
+	return &navigateConfig{
+		branches:       branches,
+		hasOpenChanges: hasOpenChanges,
+		lineage:        lineage,
+		mainBranch:     mainBranch,
+	}, false, nil
+}
+
+// localChildren provides the child branches of the given branch that exist in the local repo.
+func (nc navigateConfig) localChildren(branch domain.LocalBranchName) domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	for _, child := range nc.lineage.Children(branch) {
+		if nc.branches.All.HasLocalBranch(child) {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// selectChild provides the child branch of the given branch to switch to,
+// asking the user if there are several.
+func (nc navigateConfig) selectChild(branch domain.LocalBranchName, children domain.LocalBranchNames) (domain.LocalBranchName, error) {
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return dialog.SelectChildBranch(branch, children)
+}
+
+func navigateStepList(targetBranch domain.LocalBranchName, config *navigateConfig) (runstate.StepList, error) {
+	result := runstate.StepList{}
+	result.Append(&steps.CheckoutStep{Branch: targetBranch})
+	err := result.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     false,
+		StashOpenChanges: config.hasOpenChanges,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		// after navigating, "git checkout -" goes back to the branch the user came from
+		PreviousBranch: config.branches.Initial,
+		ShareLineage:   false,
+	})
+	return result, err
+}
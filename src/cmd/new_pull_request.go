@@ -36,6 +36,7 @@ where hostname matches what is in your ssh config file.`
 
 func newPullRequestCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:     "new-pull-request",
 		GroupID: "basic",
@@ -43,18 +44,22 @@ func newPullRequestCommand() *cobra.Command {
 		Short:   newPullRequestDesc,
 		Long:    long(newPullRequestDesc, fmt.Sprintf(newPullRequestHelp, config.KeyCodeHostingDriver, config.KeyCodeHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runNewPullRequest(readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runNewPullRequest(readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runNewPullRequest(debug bool) error {
+func runNewPullRequest(debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: true,
 		ValidateGitRepo:  true,
 	})
@@ -89,6 +94,7 @@ type newPullRequestConfig struct {
 	branches           domain.Branches
 	branchesToSync     domain.BranchInfos
 	connector          hosting.Connector
+	convertPrototype   bool
 	hasOpenChanges     bool
 	remotes            domain.Remotes
 	isOffline          bool
@@ -97,7 +103,9 @@ type newPullRequestConfig struct {
 	previousBranch     domain.LocalBranchName
 	pullBranchStrategy config.PullBranchStrategy
 	pushHook           bool
+	shareLineage       bool
 	shouldSyncUpstream bool
+	syncStrategies     config.BranchSyncStrategies
 	syncStrategy       config.SyncStrategy
 }
 
@@ -138,10 +146,18 @@ func determineNewPullRequestConfig(repo *execute.OpenRepoResult) (*newPullReques
 	if updated {
 		lineage = repo.Runner.Config.Lineage()
 	}
+	convertPrototype, err := convertPrototype(branches.Initial, &branches.Types)
+	if err != nil {
+		return nil, false, err
+	}
 	syncStrategy, err := repo.Runner.Config.SyncStrategy()
 	if err != nil {
 		return nil, false, err
 	}
+	branchSyncStrategies, err := repo.Runner.Config.BranchSyncStrategies()
+	if err != nil {
+		return nil, false, err
+	}
 	pushHook, err := repo.Runner.Config.PushHook()
 	if err != nil {
 		return nil, false, err
@@ -181,6 +197,7 @@ func determineNewPullRequestConfig(repo *execute.OpenRepoResult) (*newPullReques
 		branches:           branches,
 		branchesToSync:     branchesToSync,
 		connector:          connector,
+		convertPrototype:   convertPrototype,
 		hasOpenChanges:     hasOpenChanges,
 		remotes:            remotes,
 		isOffline:          repo.IsOffline,
@@ -189,13 +206,18 @@ func determineNewPullRequestConfig(repo *execute.OpenRepoResult) (*newPullReques
 		previousBranch:     previousBranch,
 		pullBranchStrategy: pullBranchStrategy,
 		pushHook:           pushHook,
+		shareLineage:       repo.ShareLineage,
 		shouldSyncUpstream: shouldSyncUpstream,
+		syncStrategies:     branchSyncStrategies,
 		syncStrategy:       syncStrategy,
 	}, false, err
 }
 
 func newPullRequestStepList(config *newPullRequestConfig) (runstate.StepList, error) {
 	list := runstate.StepListBuilder{}
+	if config.convertPrototype {
+		list.Add(removePrototypeStep(config.branches.Initial))
+	}
 	for _, branch := range config.branchesToSync {
 		syncBranchSteps(&list, syncBranchStepsArgs{
 			branch:             branch,
@@ -207,7 +229,9 @@ func newPullRequestStepList(config *newPullRequestConfig) (runstate.StepList, er
 			pullBranchStrategy: config.pullBranchStrategy,
 			pushBranch:         true,
 			pushHook:           config.pushHook,
+			restackUpstream:    domain.SHA{},
 			shouldSyncUpstream: config.shouldSyncUpstream,
+			syncStrategies:     config.syncStrategies,
 			syncStrategy:       config.syncStrategy,
 		})
 	}
@@ -217,6 +241,7 @@ func newPullRequestStepList(config *newPullRequestConfig) (runstate.StepList, er
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	list.Add(&steps.CreateProposalStep{Branch: config.branches.Initial})
 	return list.Result()
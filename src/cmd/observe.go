@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/spf13/cobra"
+)
+
+const observeDesc = "Marks a branch of somebody else as observed"
+
+const observeHelp = `
+Observed branches belong to other people, for example branches you check out to review or test them.
+"git town sync" only pulls the new commits from their tracking branch into them.
+It doesn't merge their parent branch into them and never pushes them.
+
+Works on either the current branch or the branch name provided.`
+
+func observeCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:   "observe [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: observeDesc,
+		Long:  long(observeDesc, observeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runObserve(args, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func runObserve(args []string, debug, profile bool, traceFile string) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	branch, err := determineBranchArg(args, &repo)
+	if err != nil {
+		return err
+	}
+	branchTypes := repo.Runner.Config.BranchTypes()
+	if branchTypes.IsObservedBranch(branch) {
+		return fmt.Errorf(messages.ObserveAlreadyObserved, branch)
+	}
+	if !branchTypes.IsFeatureBranch(branch) {
+		return fmt.Errorf(messages.ObserveNoFeatureBranch, branch)
+	}
+	stepList := runstate.StepList{}
+	stepList.Append(&steps.AddToObservedBranchesStep{Branch: branch})
+	// observed branches don't have a parent branch because they don't get synced with it
+	parent := repo.Runner.Config.Lineage().Parent(branch)
+	if !parent.IsEmpty() {
+		stepList.Append(&steps.DeleteParentBranchStep{Branch: branch, Parent: parent})
+	}
+	err = runConfigSteps("observe", stepList, &repo)
+	if err != nil {
+		return err
+	}
+	cli.Printf(messages.ObserveDone, branch)
+	return nil
+}
+
+// determineBranchArg provides the local branch given as the optional argument, or the current branch.
+func determineBranchArg(args []string, repo *execute.OpenRepoResult) (domain.LocalBranchName, error) {
+	if len(args) == 0 {
+		return repo.Runner.Backend.CurrentBranch()
+	}
+	branch := domain.NewLocalBranchName(args[0])
+	if !repo.Runner.Backend.HasLocalBranch(branch) {
+		return branch, fmt.Errorf(messages.BranchDoesntExist, branch)
+	}
+	return branch, nil
+}
+
+// runConfigSteps runs the given steps that change the Git Town configuration
+// so that "git town undo" can revert these changes.
+func runConfigSteps(command string, stepList runstate.StepList, repo *execute.OpenRepoResult) error {
+	runState := runstate.RunState{
+		Command:     command,
+		RunStepList: stepList,
+	}
+	return runvm.Execute(runvm.ExecuteArgs{
+		RunState:  &runState,
+		Run:       &repo.Runner,
+		Connector: nil,
+		Lineage:   repo.Runner.Config.Lineage(),
+		RootDir:   repo.RootDir,
+	})
+}
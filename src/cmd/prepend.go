@@ -31,6 +31,7 @@ See "sync" for upstream remote options.
 
 func prependCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:     "prepend <branch>",
 		GroupID: "lineage",
@@ -38,18 +39,22 @@ func prependCommand() *cobra.Command {
 		Short:   prependDesc,
 		Long:    long(prependDesc, prependHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runPrepend(args, readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runPrepend(args, readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runPrepend(args []string, debug bool) error {
+func runPrepend(args []string, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -89,8 +94,10 @@ type prependConfig struct {
 	pullBranchStrategy  config.PullBranchStrategy
 	pushHook            bool
 	parentBranch        domain.LocalBranchName
+	shareLineage        bool
 	shouldSyncUpstream  bool
 	shouldNewBranchPush bool
+	syncStrategies      config.BranchSyncStrategies
 	syncStrategy        config.SyncStrategy
 	targetBranch        domain.LocalBranchName
 }
@@ -116,6 +123,7 @@ func determinePrependConfig(args []string, repo *execute.OpenRepoResult) (*prepe
 	pushHook := fc.Bool(repo.Runner.Config.PushHook())
 	mainBranch := repo.Runner.Config.MainBranch()
 	syncStrategy := fc.SyncStrategy(repo.Runner.Config.SyncStrategy())
+	branchSyncStrategies := fc.BranchSyncStrategies(repo.Runner.Config.BranchSyncStrategies())
 	pullBranchStrategy := fc.PullBranchStrategy(repo.Runner.Config.PullBranchStrategy())
 	shouldSyncUpstream := fc.Bool(repo.Runner.Config.ShouldSyncUpstream())
 	targetBranch := domain.NewLocalBranchName(args[0])
@@ -153,8 +161,10 @@ func determinePrependConfig(args []string, repo *execute.OpenRepoResult) (*prepe
 		pullBranchStrategy:  pullBranchStrategy,
 		pushHook:            pushHook,
 		parentBranch:        lineage.Parent(branches.Initial),
+		shareLineage:        repo.ShareLineage,
 		shouldNewBranchPush: shouldNewBranchPush,
 		shouldSyncUpstream:  shouldSyncUpstream,
+		syncStrategies:      branchSyncStrategies,
 		syncStrategy:        syncStrategy,
 		targetBranch:        targetBranch,
 	}, false, fc.Err
@@ -173,7 +183,9 @@ func prependStepList(config *prependConfig) (runstate.StepList, error) {
 			pullBranchStrategy: config.pullBranchStrategy,
 			pushBranch:         true,
 			pushHook:           config.pushHook,
+			restackUpstream:    domain.SHA{},
 			shouldSyncUpstream: config.shouldSyncUpstream,
+			syncStrategies:     config.syncStrategies,
 			syncStrategy:       config.syncStrategy,
 		})
 	}
@@ -190,6 +202,7 @@ func prependStepList(config *prependConfig) (runstate.StepList, error) {
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return list.Result()
 }
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/dialog"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/slice"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/spf13/cobra"
+)
+
+const prototypeDesc = "Marks a feature branch as a prototype that never gets pushed"
+
+const prototypeHelp = `
+"git town sync" keeps prototype branches up to date with their parent branch
+but never pushes them, even if they have a tracking branch.
+"git town ship" and "git town new-pull-request" offer to convert a prototype branch
+into a normal feature branch.
+
+Works on either the current branch or the branch name provided.
+The --remove flag converts the prototype branch back into a normal feature branch.`
+
+func prototypeCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	addRemoveFlag, readRemoveFlag := flags.Bool("remove", "r", "Convert the prototype branch into a normal feature branch")
+	cmd := cobra.Command{
+		Use:   "prototype [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: prototypeDesc,
+		Long:  long(prototypeDesc, prototypeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runPrototype(args, readRemoveFlag(cmd), readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	addRemoveFlag(&cmd)
+	return &cmd
+}
+
+func runPrototype(args []string, remove, debug, profile bool, traceFile string) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	branch, err := determineBranchArg(args, &repo)
+	if err != nil {
+		return err
+	}
+	branchTypes := repo.Runner.Config.BranchTypes()
+	if remove {
+		if !branchTypes.IsPrototypeBranch(branch) {
+			return fmt.Errorf(messages.PrototypeNotPrototype, branch)
+		}
+		stepList := runstate.StepList{}
+		stepList.Append(removePrototypeStep(branch))
+		err = runConfigSteps("prototype", stepList, &repo)
+		if err != nil {
+			return err
+		}
+		cli.Printf(messages.PrototypeRemoved, branch)
+		return nil
+	}
+	if branchTypes.IsPrototypeBranch(branch) {
+		return fmt.Errorf(messages.PrototypeAlready, branch)
+	}
+	if !branchTypes.IsFeatureBranch(branch) {
+		return fmt.Errorf(messages.PrototypeNoFeatureBranch, branch)
+	}
+	stepList := runstate.StepList{}
+	stepList.Append(addPrototypeStep(branch))
+	err = runConfigSteps("prototype", stepList, &repo)
+	if err != nil {
+		return err
+	}
+	cli.Printf(messages.PrototypeDone, branch)
+	return nil
+}
+
+// convertPrototype asks the user whether to convert the given branch into a normal feature branch
+// in case it is a prototype branch, and updates the given branch types accordingly.
+// The caller must add a step that removes the prototype setting of the branch when this returns true.
+func convertPrototype(branch domain.LocalBranchName, branchTypes *domain.BranchTypes) (bool, error) {
+	if !branchTypes.IsPrototypeBranch(branch) {
+		return false, nil
+	}
+	answer, err := dialog.Select(dialog.SelectArgs{
+		Options: []string{messages.PrototypeConvertYes, messages.PrototypeConvertNo},
+		Default: messages.PrototypeConvertYes,
+		Message: fmt.Sprintf(messages.PrototypeConvertPrompt, branch),
+	})
+	if err != nil {
+		return false, err
+	}
+	if answer != messages.PrototypeConvertYes {
+		return false, fmt.Errorf(messages.PrototypeNeedsConversion, branch)
+	}
+	branchTypes.PrototypeBranches = slice.Remove(branchTypes.PrototypeBranches, branch)
+	return true, nil
+}
+
+// addPrototypeStep provides the step that marks the given branch as a prototype branch.
+func addPrototypeStep(branch domain.LocalBranchName) steps.Step {
+	return &steps.SetConfigValueStep{Key: config.NewPrototypeKey(branch), Global: false, Value: "true"}
+}
+
+// removePrototypeStep provides the step that converts the given prototype branch into a normal feature branch.
+func removePrototypeStep(branch domain.LocalBranchName) steps.Step {
+	return &steps.RemoveConfigValueStep{Key: config.NewPrototypeKey(branch), Global: false}
+}
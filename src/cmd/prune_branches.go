@@ -19,24 +19,29 @@ This usually means the branch was shipped or killed on another machine.`
 
 func pruneBranchesCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	cmd := cobra.Command{
 		Use:   "prune-branches",
 		Args:  cobra.NoArgs,
 		Short: pruneBranchesDesc,
 		Long:  long(pruneBranchesDesc, pruneBranchesHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runPruneBranches(readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runPruneBranches(readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runPruneBranches(debug bool) error {
+func runPruneBranches(debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: true,
 		ValidateGitRepo:  true,
 	})
@@ -70,6 +75,8 @@ type pruneBranchesConfig struct {
 	branchesToDelete domain.LocalBranchNames
 	mainBranch       domain.LocalBranchName
 	previousBranch   domain.LocalBranchName
+	shareLineage     bool
+	syncStrategies   config.BranchSyncStrategies
 }
 
 func determinePruneBranchesConfig(repo *execute.OpenRepoResult) (*pruneBranchesConfig, bool, error) {
@@ -82,13 +89,19 @@ func determinePruneBranchesConfig(repo *execute.OpenRepoResult) (*pruneBranchesC
 		ValidateIsConfigured:  true,
 		ValidateNoOpenChanges: false,
 	})
+	if err != nil || exit {
+		return nil, exit, err
+	}
+	syncStrategies, err := repo.Runner.Config.BranchSyncStrategies()
 	return &pruneBranchesConfig{
 		branches:         branches,
 		lineage:          lineage,
 		branchesToDelete: branches.All.LocalBranchesWithDeletedTrackingBranches().Names(),
 		mainBranch:       repo.Runner.Config.MainBranch(),
 		previousBranch:   repo.Runner.Backend.PreviouslyCheckedOutBranch(),
-	}, exit, err
+		shareLineage:     repo.ShareLineage,
+		syncStrategies:   syncStrategies,
+	}, false, err
 }
 
 func pruneBranchesStepList(config *pruneBranchesConfig) (runstate.StepList, error) {
@@ -101,12 +114,22 @@ func pruneBranchesStepList(config *pruneBranchesConfig) (runstate.StepList, erro
 		if !parent.IsEmpty() {
 			for _, child := range config.lineage.Children(branchWithDeletedRemote) {
 				result.Append(&steps.SetParentStep{Branch: child, ParentBranch: parent})
+				result.Append(&steps.SetParentSHAStep{Branch: child, SHA: config.branches.All.FindLocalBranch(branchWithDeletedRemote).LocalSHA})
 			}
 			result.Append(&steps.DeleteParentBranchStep{Branch: branchWithDeletedRemote, Parent: config.lineage.Parent(branchWithDeletedRemote)})
 		}
 		if config.branches.Types.IsPerennialBranch(branchWithDeletedRemote) {
 			result.Append(&steps.RemoveFromPerennialBranchesStep{Branch: branchWithDeletedRemote})
 		}
+		if config.branches.Types.IsObservedBranch(branchWithDeletedRemote) {
+			result.Append(&steps.RemoveFromObservedBranchesStep{Branch: branchWithDeletedRemote})
+		}
+		if config.branches.Types.IsPrototypeBranch(branchWithDeletedRemote) {
+			result.Append(removePrototypeStep(branchWithDeletedRemote))
+		}
+		if _, hasSyncStrategy := config.syncStrategies[branchWithDeletedRemote]; hasSyncStrategy {
+			result.Append(removeBranchSyncStrategyStep(branchWithDeletedRemote))
+		}
 		result.Append(&steps.DeleteLocalBranchStep{Branch: branchWithDeletedRemote, Parent: config.mainBranch.Location(), Force: false})
 	}
 	err := result.Wrap(runstate.WrapOptions{
@@ -115,6 +138,7 @@ func pruneBranchesStepList(config *pruneBranchesConfig) (runstate.StepList, erro
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return result, err
 }
@@ -36,6 +36,7 @@ When run on a perennial branch
 
 func renameBranchCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	addForceFlag, readForceFlag := flags.Bool("force", "f", "Force rename of perennial branch")
 	cmd := cobra.Command{
 		Use:   "rename-branch [<old_branch_name>] <new_branch_name>",
@@ -43,19 +44,23 @@ func renameBranchCommand() *cobra.Command {
 		Short: renameBranchDesc,
 		Long:  long(renameBranchDesc, renameBranchHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runRenameBranch(args, readForceFlag(cmd), readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runRenameBranch(args, readForceFlag(cmd), readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
 	addForceFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runRenameBranch(args []string, force, debug bool) error {
+func runRenameBranch(args []string, force, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -92,6 +97,8 @@ type renameBranchConfig struct {
 	noPushHook     bool
 	oldBranch      domain.BranchInfo
 	previousBranch domain.LocalBranchName
+	shareLineage   bool
+	syncStrategy   string // the sync strategy that the old branch uses instead of the general sync strategy
 }
 
 func determineRenameBranchConfig(args []string, forceFlag bool, repo *execute.OpenRepoResult) (*renameBranchConfig, bool, error) {
@@ -155,6 +162,8 @@ func determineRenameBranchConfig(args []string, forceFlag bool, repo *execute.Op
 		noPushHook:     !pushHook,
 		oldBranch:      *oldBranch,
 		previousBranch: previousBranch,
+		shareLineage:   repo.ShareLineage,
+		syncStrategy:   repo.Runner.Config.LocalConfigValue(config.NewSyncStrategyKey(oldBranchName)),
 	}, false, err
 }
 
@@ -172,10 +181,18 @@ func renameBranchStepList(config *renameBranchConfig) (runstate.StepList, error)
 		result.Append(&steps.DeleteParentBranchStep{Branch: config.oldBranch.LocalName, Parent: lineage.Parent(config.oldBranch.LocalName)})
 		result.Append(&steps.SetParentStep{Branch: config.newBranch, ParentBranch: lineage.Parent(config.oldBranch.LocalName)})
 	}
+	isPrototype := config.branches.Types.IsPrototypeBranch(config.oldBranch.LocalName)
+	if isPrototype {
+		result.Append(removePrototypeStep(config.oldBranch.LocalName))
+		result.Append(addPrototypeStep(config.newBranch))
+	}
+	if config.syncStrategy != "" {
+		result.Append(moveSyncStrategySteps(config.oldBranch.LocalName, config.newBranch, config.syncStrategy)...)
+	}
 	for _, child := range config.lineage.Children(config.oldBranch.LocalName) {
 		result.Append(&steps.SetParentStep{Branch: child, ParentBranch: config.newBranch})
 	}
-	if config.oldBranch.HasTrackingBranch() && !config.isOffline {
+	if config.oldBranch.HasTrackingBranch() && !config.isOffline && !isPrototype {
 		result.Append(&steps.CreateTrackingBranchStep{Branch: config.newBranch, NoPushHook: config.noPushHook})
 		result.Append(&steps.DeleteTrackingBranchStep{Branch: config.oldBranch.LocalName, NoPushHook: false})
 	}
@@ -186,6 +203,15 @@ func renameBranchStepList(config *renameBranchConfig) (runstate.StepList, error)
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return result, err
 }
+
+// moveSyncStrategySteps provides the steps that make the given new branch use the given sync strategy of the given old branch.
+func moveSyncStrategySteps(oldBranch, newBranch domain.LocalBranchName, syncStrategy string) []steps.Step {
+	return []steps.Step{
+		&steps.RemoveConfigValueStep{Key: config.NewSyncStrategyKey(oldBranch), Global: false},
+		&steps.SetConfigValueStep{Key: config.NewSyncStrategyKey(newBranch), Global: false, Value: syncStrategy},
+	}
+}
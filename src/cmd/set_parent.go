@@ -3,8 +3,13 @@ package cmd
 import (
 	"errors"
 
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/dialog"
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/hosting"
 	"github.com/git-town/git-town/v9/src/messages"
 	"github.com/git-town/git-town/v9/src/validate"
 	"github.com/spf13/cobra"
@@ -12,23 +17,36 @@ import (
 
 const setParentDesc = "Prompts to set the parent branch for the current branch"
 
+const setParentHelp = `
+With the --infer flag, Git Town suggests a parent branch
+for the current branch and all local feature branches without a parent.
+The suggested parent is the local or perennial branch
+whose tip is the nearest ancestor of the branch.
+Proposals at your code hosting platform confirm or override this suggestion.
+
+With the --yes flag, Git Town applies the inferred parents without asking.`
+
 func setParentCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addInferFlag, readInferFlag := flags.Bool("infer", "", "Infer the parent branch from the commit history and proposals")
+	addYesFlag, readYesFlag := flags.Bool("yes", "y", "Apply the inferred parent branches without prompting, implies --infer")
 	cmd := cobra.Command{
 		Use:     "set-parent",
 		GroupID: "lineage",
 		Args:    cobra.NoArgs,
 		Short:   setParentDesc,
-		Long:    long(setParentDesc),
+		Long:    long(setParentDesc, setParentHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSetParent(readDebugFlag(cmd))
+			return runSetParent(readInferFlag(cmd), readYesFlag(cmd), readDebugFlag(cmd))
 		},
 	}
 	addDebugFlag(&cmd)
+	addInferFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func runSetParent(debug bool) error {
+func runSetParent(infer, yes, debug bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
@@ -51,6 +69,14 @@ func runSetParent(debug bool) error {
 	if err != nil || exit {
 		return err
 	}
+	if infer || yes {
+		err = inferParents(&repo, branches, lineage, yes)
+		if err != nil {
+			return err
+		}
+		repo.Runner.Stats.PrintAnalysis()
+		return nil
+	}
 	if !branches.Types.IsFeatureBranch(branches.Initial) {
 		return errors.New(messages.SetParentNoFeatureBranch)
 	}
@@ -79,3 +105,94 @@ func runSetParent(debug bool) error {
 	repo.Runner.Stats.PrintAnalysis()
 	return nil
 }
+
+// inferParents infers the parent branches of the current branch
+// and of all local feature branches without a parent.
+// Applies the inferred parents directly or lets the user confirm them.
+func inferParents(repo *execute.OpenRepoResult, branches domain.Branches, lineage config.Lineage, apply bool) error {
+	var connector hosting.Connector
+	if !repo.IsOffline {
+		hostingService, err := repo.Runner.Config.HostingService()
+		if err != nil {
+			return err
+		}
+		connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+			HostingService:  hostingService,
+			GetSHAForBranch: repo.Runner.Backend.SHAForBranch,
+			OriginURL:       repo.Runner.Config.OriginURL(),
+			GiteaAPIToken:   repo.Runner.Config.GiteaToken(),
+			GithubAPIToken:  repo.Runner.Config.GitHubToken(),
+			GitlabAPIToken:  repo.Runner.Config.GitLabToken(),
+			MainBranch:      repo.Runner.Config.MainBranch(),
+			Log:             cli.PrintingLog{},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, branch := range branchesToInfer(branches, lineage) {
+		inference, err := validate.InferParent(branch, validate.InferParentArgs{
+			AllBranches: branches.All,
+			Backend:     &repo.Runner.Backend,
+			BranchTypes: branches.Types,
+			Connector:   connector,
+			Lineage:     lineage,
+		})
+		if err != nil {
+			return err
+		}
+		printParentInference(branch, inference)
+		parent := inference.Candidate.Name
+		if !apply {
+			parent, err = dialog.EnterParent(branch, parent, lineage, branches.All)
+			if err != nil {
+				return err
+			}
+			if parent.String() == dialog.PerennialBranchOption {
+				err = repo.Runner.Config.AddToPerennialBranches(branch)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		err = repo.Runner.Config.SetParent(branch, parent)
+		if err != nil {
+			return err
+		}
+		lineage[branch] = parent
+	}
+	return nil
+}
+
+// branchesToInfer provides the branches whose parent "set-parent --infer" determines:
+// the current feature branch followed by all local feature branches without a parent.
+func branchesToInfer(branches domain.Branches, lineage config.Lineage) domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	if branches.Types.IsFeatureBranch(branches.Initial) {
+		result = append(result, branches.Initial)
+	}
+	for _, branch := range branches.All.LocalBranches().Names() {
+		if branch == branches.Initial || !branches.Types.IsFeatureBranch(branch) || !lineage.Parent(branch).IsEmpty() {
+			continue
+		}
+		result = append(result, branch)
+	}
+	return result
+}
+
+func printParentInference(branch domain.LocalBranchName, inference validate.ParentInference) {
+	parent := inference.Candidate.Name
+	switch {
+	case inference.Proposal != nil && inference.Confidence == validate.ParentConfidenceHigh:
+		cli.Printf(messages.InferParentProposalConfirms, branch, parent, inference.Proposal.Number, inference.Confidence)
+	case inference.Proposal != nil:
+		cli.Printf(messages.InferParentProposalDisagrees, branch, parent, inference.Proposal.Number, inference.Nearest, inference.Confidence)
+	case inference.Confidence == validate.ParentConfidenceLow:
+		cli.Printf(messages.InferParentNoCandidates, branch, parent, inference.Confidence)
+	case len(inference.Tied) > 0:
+		cli.Printf(messages.InferParentTied, branch, parent, inference.Tied.Join(", "), inference.Confidence)
+	default:
+		cli.Printf(messages.InferParentNearest, branch, parent, inference.Candidate.Ahead, inference.Confidence)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
-	"github.com/git-town/git-town/v9/src/git"
 	"github.com/git-town/git-town/v9/src/hosting"
 	"github.com/git-town/git-town/v9/src/messages"
 	"github.com/git-town/git-town/v9/src/runstate"
@@ -34,8 +33,15 @@ into the main branch, resulting in linear history on the main branch.
 - pushes the main branch to the origin repository
 - deletes <branch_name> from the local and origin repositories
 
+Ships into the parent branch of <branch_name>, which can be the main branch or a perennial branch.
+To merge shipped branches into a branch using merge commits instead of squash-merges,
+run "git town config ship-strategy --branch <branch> merge".
+
 Ships direct children of the main branch.
-To ship a nested child branch, ship or kill all ancestor branches first.
+To ship a nested child branch, ship or kill all ancestor branches first,
+or ship it together with all its ancestor branches using the --stack flag.
+This ships the branches one after the other, starting with the oldest ancestor.
+If shipping one of them fails, the branches shipped before it remain shipped.
 
 If you use GitHub, this command can squash merge pull requests via the GitHub API. Setup:
 1. Get a GitHub personal access token with the "repo" scope
@@ -45,43 +51,54 @@ It will also update the base branch for any pull requests against that branch.
 
 If your origin server deletes shipped branches, for example
 GitHub's feature to automatically delete head branches,
-run "git config %s false"
-and Git Town will leave it up to your origin server to delete the remote branch.`
+run "git town config ship-delete-remote-branch no"
+and Git Town will leave it up to your origin server to delete the remote branch.
+Add "--branch <branch>" to configure this only for shipping into the given branch.`
 
 func shipCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	addMessageFlag, readMessageFlag := flags.String("message", "m", "", "Specify the commit message for the squash commit")
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Ship the branch together with all its ancestor branches")
 	cmd := cobra.Command{
 		Use:     "ship",
 		GroupID: "basic",
 		Args:    cobra.MaximumNArgs(1),
 		Short:   shipDesc,
-		Long:    long(shipDesc, fmt.Sprintf(shipHelp, config.KeyGithubToken, config.KeyShipDeleteRemoteBranch)),
+		Long:    long(shipDesc, fmt.Sprintf(shipHelp, config.KeyGithubToken)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runShip(args, readMessageFlag(cmd), readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runShip(args, readMessageFlag(cmd), readStackFlag(cmd), readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addDebugFlag(&cmd)
 	addMessageFlag(&cmd)
+	addProfileFlag(&cmd)
+	addStackFlag(&cmd)
 	return &cmd
 }
 
-func runShip(args []string, message string, debug bool) error {
+func runShip(args []string, message string, stack, debug, profile bool, traceFile string) error {
+	if stack && message != "" {
+		return fmt.Errorf(messages.ShipStackMessage)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
 	if err != nil {
 		return err
 	}
-	config, exit, err := determineShipConfig(args, &repo)
+	config, exit, err := determineShipConfig(args, stack, &repo)
 	if err != nil || exit {
 		return err
 	}
-	if config.branchToShip.LocalName == config.branches.Initial {
+	if config.isShippingInitialBranch {
 		hasOpenChanges, err := repo.Runner.Backend.HasOpenChanges()
 		if err != nil {
 			return err
@@ -91,7 +108,7 @@ func runShip(args []string, message string, debug bool) error {
 			return err
 		}
 	}
-	stepList, err := shipStepList(config, message, &repo.Runner)
+	stepList, err := shipStepList(config, message)
 	if err != nil {
 		return err
 	}
@@ -109,30 +126,40 @@ func runShip(args []string, message string, debug bool) error {
 }
 
 type shipConfig struct {
-	branches                 domain.Branches
-	branchToShip             domain.BranchInfo
-	connector                hosting.Connector
-	targetBranch             domain.BranchInfo
+	branches                domain.Branches
+	branchesToShip          []shipBranchConfig // ordered from the oldest ancestor to the branch that the user wants to ship
+	connector               hosting.Connector
+	targetBranch            domain.BranchInfo
+	deleteOriginBranch      bool
+	downstream              config.Downstream
+	hasOpenChanges          bool
+	remotes                 domain.Remotes
+	shipStrategy            config.ShipStrategy
+	isShippingInitialBranch bool
+	isOffline               bool
+	lineage                 config.Lineage
+	mainBranch              domain.LocalBranchName
+	previousBranch          domain.LocalBranchName
+	pullBranchStrategy      config.PullBranchStrategy
+	pushHook                bool
+	shareLineage            bool
+	shouldSyncUpstream      bool
+	syncStrategies          config.BranchSyncStrategies
+	syncStrategy            config.SyncStrategy
+}
+
+// shipBranchConfig contains the information needed to ship one particular branch.
+type shipBranchConfig struct {
+	branch                   domain.BranchInfo
 	canShipViaAPI            bool
 	childBranches            domain.LocalBranchNames
-	proposalMessage          string
-	deleteOriginBranch       bool
-	hasOpenChanges           bool
-	remotes                  domain.Remotes
-	isShippingInitialBranch  bool
-	isOffline                bool
-	lineage                  config.Lineage
-	mainBranch               domain.LocalBranchName
-	previousBranch           domain.LocalBranchName
+	convertPrototype         bool
 	proposal                 *hosting.Proposal
+	proposalMessage          string
 	proposalsOfChildBranches []hosting.Proposal
-	pullBranchStrategy       config.PullBranchStrategy
-	pushHook                 bool
-	shouldSyncUpstream       bool
-	syncStrategy             config.SyncStrategy
 }
 
-func determineShipConfig(args []string, repo *execute.OpenRepoResult) (*shipConfig, bool, error) {
+func determineShipConfig(args []string, stack bool, repo *execute.OpenRepoResult) (*shipConfig, bool, error) {
 	lineage := repo.Runner.Config.Lineage()
 	branches, exit, err := execute.LoadBranches(execute.LoadBranchesArgs{
 		Repo:                  repo,
@@ -154,18 +181,17 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult) (*shipConf
 	if err != nil {
 		return nil, false, err
 	}
-	deleteOrigin, err := repo.Runner.Config.ShouldShipDeleteOriginBranch()
-	if err != nil {
-		return nil, false, err
-	}
 	mainBranch := repo.Runner.Config.MainBranch()
 	branchNameToShip := domain.NewLocalBranchName(slice.FirstElementOr(args, branches.Initial.String()))
 	branchToShip := branches.All.FindLocalBranch(branchNameToShip)
-	isShippingInitialBranch := branchNameToShip == branches.Initial
 	syncStrategy, err := repo.Runner.Config.SyncStrategy()
 	if err != nil {
 		return nil, false, err
 	}
+	branchSyncStrategies, err := repo.Runner.Config.BranchSyncStrategies()
+	if err != nil {
+		return nil, false, err
+	}
 	pullBranchStrategy, err := repo.Runner.Config.PullBranchStrategy()
 	if err != nil {
 		return nil, false, err
@@ -174,10 +200,8 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult) (*shipConf
 	if err != nil {
 		return nil, false, err
 	}
-	if !isShippingInitialBranch {
-		if branchToShip == nil {
-			return nil, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToShip)
-		}
+	if branchToShip == nil {
+		return nil, false, fmt.Errorf(messages.BranchDoesntExist, branchNameToShip)
 	}
 	if !branches.Types.IsFeatureBranch(branchNameToShip) {
 		return nil, false, fmt.Errorf(messages.ShipNoFeatureBranch, branchNameToShip)
@@ -196,20 +220,54 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult) (*shipConf
 	if updated {
 		lineage = repo.Runner.Config.Lineage()
 	}
-	err = ensureParentBranchIsMainOrPerennialBranch(branchNameToShip, branches.Types, lineage)
-	if err != nil {
-		return nil, false, err
+	branchNamesToShip := domain.LocalBranchNames{branchNameToShip}
+	if stack {
+		branchNamesToShip = stackToShip(branchNameToShip, branches.Types, lineage)
+	} else {
+		err = ensureParentBranchIsMainOrPerennialBranch(branchNameToShip, branches.Types, lineage)
+		if err != nil {
+			return nil, false, err
+		}
 	}
-	targetBranchName := lineage.Parent(branchNameToShip)
+	targetBranchName := lineage.Parent(branchNamesToShip[0])
 	targetBranch := branches.All.FindLocalBranch(targetBranchName)
 	if targetBranch == nil {
 		return nil, false, fmt.Errorf(messages.BranchDoesntExist, targetBranchName)
 	}
-	canShipViaAPI := false
-	proposalMessage := ""
-	var proposal *hosting.Proposal
-	childBranches := lineage.Children(branchNameToShip)
-	proposalsOfChildBranches := []hosting.Proposal{}
+	shipStrategy, err := repo.Runner.Config.ShipStrategy(targetBranchName)
+	if err != nil {
+		return nil, false, err
+	}
+	deleteOrigin, err := repo.Runner.Config.ShouldShipDeleteOriginBranchInto(targetBranchName)
+	if err != nil {
+		return nil, false, err
+	}
+	if messageTemplate := repo.Runner.Config.ShipMessageTemplate(); messageTemplate != "" {
+		_, err = config.ParseShipMessageTemplate(messageTemplate)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	branchesToShip := make([]shipBranchConfig, len(branchNamesToShip))
+	for b, branchName := range branchNamesToShip {
+		branch := branches.All.FindLocalBranch(branchName)
+		if branch == nil {
+			return nil, false, fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		convertPrototype, err := convertPrototype(branchName, &branches.Types)
+		if err != nil {
+			return nil, false, err
+		}
+		branchesToShip[b] = shipBranchConfig{
+			branch:                   *branch,
+			canShipViaAPI:            false,
+			childBranches:            lineage.Children(branchName),
+			convertPrototype:         convertPrototype,
+			proposal:                 nil,
+			proposalMessage:          "",
+			proposalsOfChildBranches: []hosting.Proposal{},
+		}
+	}
 	pushHook, err := repo.Runner.Config.PushHook()
 	if err != nil {
 		return nil, false, err
@@ -232,49 +290,53 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult) (*shipConf
 	if err != nil {
 		return nil, false, err
 	}
-	if !repo.IsOffline && connector != nil {
-		if branchToShip.HasTrackingBranch() {
-			proposal, err = connector.FindProposal(branchNameToShip, targetBranchName)
-			if err != nil {
-				return nil, false, err
-			}
-			if proposal != nil {
-				canShipViaAPI = true
-				proposalMessage = connector.DefaultProposalMessage(*proposal)
-			}
-		}
-		for _, childBranch := range childBranches {
-			childProposal, err := connector.FindProposal(childBranch, branchNameToShip)
-			if err != nil {
-				return nil, false, fmt.Errorf(messages.ProposalNotFoundForBranch, branchNameToShip, err)
+	// the APIs of the hosting services squash-merge proposals
+	if !repo.IsOffline && connector != nil && shipStrategy == config.ShipStrategySquashMerge {
+		for b := range branchesToShip {
+			branchToShip := &branchesToShip[b]
+			branchName := branchToShip.branch.LocalName
+			if branchToShip.branch.HasTrackingBranch() {
+				branchToShip.proposal, err = connector.FindProposal(branchName, lineage.Parent(branchName))
+				if err != nil {
+					return nil, false, err
+				}
+				if branchToShip.proposal != nil {
+					branchToShip.canShipViaAPI = true
+					branchToShip.proposalMessage = connector.DefaultProposalMessage(*branchToShip.proposal)
+				}
 			}
-			if childProposal != nil {
-				proposalsOfChildBranches = append(proposalsOfChildBranches, *childProposal)
+			for _, childBranch := range branchToShip.childBranches {
+				childProposal, err := connector.FindProposal(childBranch, branchName)
+				if err != nil {
+					return nil, false, fmt.Errorf(messages.ProposalNotFoundForBranch, branchName, err)
+				}
+				if childProposal != nil {
+					branchToShip.proposalsOfChildBranches = append(branchToShip.proposalsOfChildBranches, *childProposal)
+				}
 			}
 		}
 	}
 	return &shipConfig{
-		branches:                 branches,
-		connector:                connector,
-		targetBranch:             *targetBranch,
-		branchToShip:             *branchToShip,
-		canShipViaAPI:            canShipViaAPI,
-		childBranches:            childBranches,
-		proposalMessage:          proposalMessage,
-		deleteOriginBranch:       deleteOrigin,
-		hasOpenChanges:           hasOpenChanges,
-		remotes:                  remotes,
-		isOffline:                repo.IsOffline,
-		isShippingInitialBranch:  isShippingInitialBranch,
-		lineage:                  lineage,
-		mainBranch:               mainBranch,
-		previousBranch:           previousBranch,
-		proposal:                 proposal,
-		proposalsOfChildBranches: proposalsOfChildBranches,
-		pullBranchStrategy:       pullBranchStrategy,
-		pushHook:                 pushHook,
-		shouldSyncUpstream:       shouldSyncUpstream,
-		syncStrategy:             syncStrategy,
+		branches:                branches,
+		branchesToShip:          branchesToShip,
+		connector:               connector,
+		targetBranch:            *targetBranch,
+		deleteOriginBranch:      deleteOrigin,
+		downstream:              repo.Runner.Config.Downstream(),
+		hasOpenChanges:          hasOpenChanges,
+		remotes:                 remotes,
+		shipStrategy:            shipStrategy,
+		isOffline:               repo.IsOffline,
+		isShippingInitialBranch: slice.Contains(branchNamesToShip, branches.Initial),
+		lineage:                 lineage,
+		mainBranch:              mainBranch,
+		previousBranch:          previousBranch,
+		pullBranchStrategy:      pullBranchStrategy,
+		pushHook:                pushHook,
+		shareLineage:            repo.ShareLineage,
+		shouldSyncUpstream:      shouldSyncUpstream,
+		syncStrategies:          branchSyncStrategies,
+		syncStrategy:            syncStrategy,
 	}, false, nil
 }
 
@@ -290,8 +352,34 @@ please ship %q first`, stringslice.Connect(ancestorsWithoutMainOrPerennial.Strin
 	return nil
 }
 
-func shipStepList(config *shipConfig, commitMessage string, run *git.ProdRunner) (runstate.StepList, error) {
+// stackToShip provides the given branch and all its ancestor feature branches, starting with the oldest ancestor.
+func stackToShip(branch domain.LocalBranchName, branchTypes domain.BranchTypes, lineage config.Lineage) domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	for _, ancestor := range lineage.Ancestors(branch) {
+		if branchTypes.IsFeatureBranch(ancestor) {
+			result = append(result, ancestor)
+		}
+	}
+	return append(result, branch)
+}
+
+// lineageAfterShipping provides a copy of the given lineage in which the given branch has the given parent.
+func lineageAfterShipping(lineage config.Lineage, branch, parent domain.LocalBranchName) config.Lineage {
+	result := make(config.Lineage, len(lineage))
+	for child, childParent := range lineage {
+		result[child] = childParent
+	}
+	result[branch] = parent
+	return result
+}
+
+func shipStepList(config *shipConfig, commitMessage string) (runstate.StepList, error) {
 	list := runstate.StepListBuilder{}
+	for _, branchToShip := range config.branchesToShip {
+		if branchToShip.convertPrototype {
+			list.Add(removePrototypeStep(branchToShip.branch.LocalName))
+		}
+	}
 	// sync the parent branch
 	syncBranchSteps(&list, syncBranchStepsArgs{
 		branch:             config.targetBranch,
@@ -303,45 +391,106 @@ func shipStepList(config *shipConfig, commitMessage string, run *git.ProdRunner)
 		pullBranchStrategy: config.pullBranchStrategy,
 		pushBranch:         true,
 		pushHook:           config.pushHook,
+		restackUpstream:    domain.SHA{},
 		shouldSyncUpstream: config.shouldSyncUpstream,
+		syncStrategies:     config.syncStrategies,
 		syncStrategy:       config.syncStrategy,
 	})
+	lineage := config.lineage
+	for b, branchToShip := range config.branchesToShip {
+		restackUpstream := domain.SHA{}
+		if b > 0 {
+			// the previous branch in the stack is shipped now, so this branch ships into the target branch.
+			// With the rebase sync strategy, only its own commits move onto the squash-merged commit.
+			// With the merge sync strategy, this branch has received the shipped branch before it got deleted
+			// and merging the squash-merged commit adds no new changes.
+			lineage = lineageAfterShipping(lineage, branchToShip.branch.LocalName, config.targetBranch.LocalName)
+			restackUpstream = config.branchesToShip[b-1].branch.LocalSHA
+			// if shipping this branch fails, keep the branches shipped so far
+			list.Add(&steps.SetSavepoint{})
+		}
+		nextInStack := domain.LocalBranchName{}
+		if b < len(config.branchesToShip)-1 {
+			nextInStack = config.branchesToShip[b+1].branch.LocalName
+		}
+		shipBranchSteps(&list, config, branchToShip, lineage, restackUpstream, nextInStack, commitMessage)
+	}
+	if len(config.branchesToShip) > 1 {
+		list.Add(&steps.ReleaseSavepoint{})
+	}
+	// merge the shipped changes into the perennial branches downstream of the target branch
+	if len(config.downstream.Chain(config.targetBranch.LocalName)) > 0 {
+		propagateDownstreamSteps(&list, propagateDownstreamStepsArgs{
+			allBranches:        config.branches.All,
+			branch:             config.targetBranch.LocalName,
+			downstream:         config.downstream,
+			isOffline:          config.isOffline,
+			pullBranchStrategy: config.pullBranchStrategy,
+			pushHook:           config.pushHook,
+			remotes:            config.remotes,
+		})
+		if config.isShippingInitialBranch {
+			list.Add(&steps.CheckoutStep{Branch: config.targetBranch.LocalName})
+		}
+	}
+	if !config.isShippingInitialBranch {
+		list.Add(&steps.CheckoutStep{Branch: config.branches.Initial})
+	}
+	list.Wrap(runstate.WrapOptions{
+		RunInGitRoot:     true,
+		StashOpenChanges: !config.isShippingInitialBranch && config.hasOpenChanges,
+		MainBranch:       config.mainBranch,
+		InitialBranch:    config.branches.Initial,
+		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
+	})
+	return list.Result()
+}
+
+// shipBranchSteps adds the steps to ship the given branch into the target branch.
+// nextInStack is the branch that this ship command ships after the given branch, if any.
+func shipBranchSteps(list *runstate.StepListBuilder, config *shipConfig, branchToShip shipBranchConfig, lineage config.Lineage, restackUpstream domain.SHA, nextInStack domain.LocalBranchName, commitMessage string) {
 	// sync the branch to ship (local sync only)
-	syncBranchSteps(&list, syncBranchStepsArgs{
-		branch:             config.branchToShip,
+	syncBranchSteps(list, syncBranchStepsArgs{
+		branch:             branchToShip.branch,
 		branchTypes:        config.branches.Types,
 		remotes:            config.remotes,
 		isOffline:          config.isOffline,
-		lineage:            config.lineage,
+		lineage:            lineage,
 		mainBranch:         config.mainBranch,
 		pullBranchStrategy: config.pullBranchStrategy,
 		pushBranch:         false,
 		pushHook:           config.pushHook,
+		restackUpstream:    restackUpstream,
 		shouldSyncUpstream: config.shouldSyncUpstream,
+		syncStrategies:     config.syncStrategies,
 		syncStrategy:       config.syncStrategy,
 	})
-	list.Add(&steps.EnsureHasShippableChangesStep{Branch: config.branchToShip.LocalName, Parent: config.mainBranch})
+	list.Add(&steps.EnsureHasShippableChangesStep{Branch: branchToShip.branch.LocalName, Parent: config.targetBranch.LocalName})
 	list.Add(&steps.CheckoutStep{Branch: config.targetBranch.LocalName})
-	if config.canShipViaAPI {
-		// update the proposals of child branches
-		for _, childProposal := range config.proposalsOfChildBranches {
-			list.Add(&steps.UpdateProposalTargetStep{
-				ProposalNumber: childProposal.Number,
-				NewTarget:      config.targetBranch.LocalName,
-				ExistingTarget: childProposal.Target,
-			})
-		}
+	// update the proposals of child branches,
+	// including those of stacked branches that ship next, before the tracking branch of this branch disappears
+	for _, childProposal := range branchToShip.proposalsOfChildBranches {
+		list.Add(&steps.UpdateProposalTargetStep{
+			ProposalNumber: childProposal.Number,
+			NewTarget:      config.targetBranch.LocalName,
+			ExistingTarget: childProposal.Target,
+		})
+	}
+	if branchToShip.canShipViaAPI {
 		// push
-		list.Add(&steps.PushCurrentBranchStep{CurrentBranch: config.branchToShip.LocalName, NoPushHook: false, Undoable: false})
+		list.Add(&steps.PushCurrentBranchStep{CurrentBranch: branchToShip.branch.LocalName, NoPushHook: false, Undoable: false})
 		list.Add(&steps.ConnectorMergeProposalStep{
-			Branch:          config.branchToShip.LocalName,
-			ProposalNumber:  config.proposal.Number,
+			Branch:          branchToShip.branch.LocalName,
+			Parent:          config.targetBranch.LocalName,
+			ProposalNumber:  branchToShip.proposal.Number,
+			ProposalTitle:   branchToShip.proposal.Title,
 			CommitMessage:   commitMessage,
-			ProposalMessage: config.proposalMessage,
+			ProposalMessage: branchToShip.proposalMessage,
 		})
 		list.Add(&steps.PullCurrentBranchStep{})
 	} else {
-		list.Add(&steps.SquashMergeStep{Branch: config.branchToShip.LocalName, CommitMessage: commitMessage, Parent: config.targetBranch.LocalName})
+		mergeShippedBranchStep(list, branchToShip.branch.LocalName, config.targetBranch.LocalName, config.shipStrategy, commitMessage)
 	}
 	if config.remotes.HasOrigin() && !config.isOffline {
 		list.Add(&steps.PushCurrentBranchStep{CurrentBranch: config.targetBranch.LocalName, Undoable: true, NoPushHook: false})
@@ -350,25 +499,60 @@ func shipStepList(config *shipConfig, commitMessage string, run *git.ProdRunner)
 	// - we know we have a tracking branch (otherwise there would be no PR to ship via API)
 	// - we have updated the PRs of all child branches (because we have API access)
 	// - we know we are online
-	if config.canShipViaAPI || (config.branchToShip.HasTrackingBranch() && len(config.childBranches) == 0 && !config.isOffline) {
+	if branchToShip.canShipViaAPI || (branchToShip.branch.HasTrackingBranch() && len(unshippedChildren(branchToShip.childBranches, config.branchesToShip)) == 0 && !config.isOffline) {
 		if config.deleteOriginBranch {
-			list.Add(&steps.DeleteTrackingBranchStep{Branch: config.branchToShip.LocalName, NoPushHook: false})
+			list.Add(&steps.DeleteTrackingBranchStep{Branch: branchToShip.branch.LocalName, NoPushHook: false})
 		}
 	}
-	list.Add(&steps.DeleteLocalBranchStep{Branch: config.branchToShip.LocalName, Parent: config.mainBranch.Location(), Force: false})
-	list.Add(&steps.DeleteParentBranchStep{Branch: config.branchToShip.LocalName, Parent: run.Config.Lineage().Parent(config.branchToShip.LocalName)})
-	for _, child := range config.childBranches {
+	if !nextInStack.IsEmpty() {
+		mergeIntoNextInStackSteps(list, branchToShip.branch.LocalName, nextInStack, config.syncStrategies.For(nextInStack, config.syncStrategy))
+	}
+	list.Add(&steps.DeleteLocalBranchStep{Branch: branchToShip.branch.LocalName, Parent: config.mainBranch.Location(), Force: false})
+	list.Add(&steps.DeleteParentBranchStep{Branch: branchToShip.branch.LocalName, Parent: lineage.Parent(branchToShip.branch.LocalName)})
+	for _, child := range branchToShip.childBranches {
 		list.Add(&steps.SetParentStep{Branch: child, ParentBranch: config.targetBranch.LocalName})
+		// allows syncing the child branch to move only its own commits onto the squash-merged commit
+		list.Add(&steps.SetParentSHAStep{Branch: child, SHA: branchToShip.branch.LocalSHA})
 	}
-	if !config.isShippingInitialBranch {
-		list.Add(&steps.CheckoutStep{Branch: config.branches.Initial})
+}
+
+// mergeShippedBranchStep adds the step to merge the given shipped branch into the given target branch.
+func mergeShippedBranchStep(list *runstate.StepListBuilder, branch, target domain.LocalBranchName, strategy config.ShipStrategy, commitMessage string) {
+	switch strategy {
+	case config.ShipStrategySquashMerge:
+		list.Add(&steps.SquashMergeStep{Branch: branch, CommitMessage: commitMessage, Parent: target})
+	case config.ShipStrategyMerge:
+		list.Add(&steps.MergeNoFastForwardStep{Branch: branch, CommitMessage: commitMessage, Parent: target})
+	default:
+		list.Fail("unknown ship strategy: %q", strategy)
 	}
-	list.Wrap(runstate.WrapOptions{
-		RunInGitRoot:     true,
-		StashOpenChanges: !config.isShippingInitialBranch && config.hasOpenChanges,
-		MainBranch:       config.mainBranch,
-		InitialBranch:    config.branches.Initial,
-		PreviousBranch:   config.previousBranch,
-	})
-	return list.Result()
+}
+
+// mergeIntoNextInStackSteps adds the steps to merge the given shipped branch into the given branch that ships after it
+// if that branch uses the merge sync strategy.
+// Merging doesn't restack, so the next branch receives the changes of the shipped branch before the shipped branch gets deleted.
+func mergeIntoNextInStackSteps(list *runstate.StepListBuilder, shipped, next domain.LocalBranchName, syncStrategy config.SyncStrategy) {
+	if syncStrategy != config.SyncStrategyMerge {
+		return
+	}
+	list.Add(&steps.CheckoutStep{Branch: next})
+	list.Add(&steps.MergeStep{Branch: shipped.BranchName()})
+}
+
+// unshippedChildren provides the given child branches that this ship command doesn't ship.
+func unshippedChildren(children domain.LocalBranchNames, branchesToShip []shipBranchConfig) domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	for _, child := range children {
+		shipped := false
+		for _, branchToShip := range branchesToShip {
+			if branchToShip.branch.LocalName == child {
+				shipped = true
+				break
+			}
+		}
+		if !shipped {
+			result = append(result, child)
+		}
+	}
+	return result
 }
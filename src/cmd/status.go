@@ -1,37 +1,51 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/execute"
 	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/gohacks"
 	"github.com/git-town/git-town/v9/src/persistence"
 	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/steps"
 	"github.com/spf13/cobra"
 )
 
 const statusDesc = "Displays or resets the current suspended Git Town command"
 
+const statusHelp = `
+When the last Git Town command hit a problem,
+this command lists the step that failed, the files with merge conflicts,
+the steps that "git town continue" will run on each branch,
+and the steps that "git town undo" would run.
+
+With the --json flag, this command prints this information as JSON.`
+
 func statusCommand() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addJSONFlag, readJSONFlag := flags.Bool("json", "", "Print the status as JSON")
 	cmd := cobra.Command{
 		Use:     "status",
 		GroupID: "errors",
 		Args:    cobra.NoArgs,
 		Short:   statusDesc,
-		Long:    long(statusDesc),
+		Long:    long(statusDesc, statusHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runStatus(readDebugFlag(cmd))
+			return runStatus(readJSONFlag(cmd), readDebugFlag(cmd))
 		},
 	}
 	addDebugFlag(&cmd)
+	addJSONFlag(&cmd)
 	cmd.AddCommand(resetRunstateCommand())
 	return &cmd
 }
 
-func runStatus(debug bool) error {
+func runStatus(asJSON, debug bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           false,
@@ -42,21 +56,29 @@ func runStatus(debug bool) error {
 	if err != nil {
 		return err
 	}
-	config, err := loadDisplayStatusConfig(repo.RootDir)
+	config, err := loadDisplayStatusConfig(repo.RootDir, &repo.Runner.Backend)
 	if err != nil {
 		return err
 	}
-	displayStatus(*config)
+	if asJSON {
+		err = displayStatusJSON(*config)
+		if err != nil {
+			return err
+		}
+	} else {
+		displayStatus(*config)
+	}
 	repo.Runner.Stats.PrintAnalysis()
 	return nil
 }
 
 type displayStatusConfig struct {
-	filepath string             // filepath of the runstate file
-	state    *runstate.RunState // content of the runstate file
+	conflictingFiles []string           // files with unresolved merge conflicts
+	filepath         string             // filepath of the runstate file
+	state            *runstate.RunState // content of the runstate file
 }
 
-func loadDisplayStatusConfig(rootDir domain.RepoRootDir) (*displayStatusConfig, error) {
+func loadDisplayStatusConfig(rootDir domain.RepoRootDir, backend *git.BackendCommands) (*displayStatusConfig, error) {
 	filepath, err := persistence.FilePath(rootDir)
 	if err != nil {
 		return nil, err
@@ -65,9 +87,17 @@ func loadDisplayStatusConfig(rootDir domain.RepoRootDir) (*displayStatusConfig,
 	if err != nil {
 		return nil, err
 	}
+	conflictingFiles := []string{}
+	if state != nil && state.IsUnfinished() {
+		conflictingFiles, err = backend.ConflictingFiles()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &displayStatusConfig{
-		filepath: filepath,
-		state:    state,
+		conflictingFiles: conflictingFiles,
+		filepath:         filepath,
+		state:            state,
 	}, nil
 }
 
@@ -83,6 +113,63 @@ func displayStatus(config displayStatusConfig) {
 	}
 }
 
+// statusJSON is the JSON representation of the status of the last Git Town command.
+type statusJSON struct {
+	CanAbort         bool                    `json:"CanAbort"`
+	CanContinue      bool                    `json:"CanContinue"`
+	CanSkip          bool                    `json:"CanSkip"`
+	CanUndo          bool                    `json:"CanUndo"`
+	Command          string                  `json:"Command"`
+	ConflictingFiles []string                `json:"ConflictingFiles"`
+	EndBranch        domain.LocalBranchName  `json:"EndBranch"`
+	FailedBranches   []runstate.FailedBranch `json:"FailedBranches"`
+	FailedStep       *runstate.JSONStep      `json:"FailedStep"`
+	RemainingSteps   []runstate.BranchSteps  `json:"RemainingSteps"`
+	Status           string                  `json:"Status"`
+	UndoSteps        runstate.StepList       `json:"UndoSteps"`
+}
+
+func displayStatusJSON(config displayStatusConfig) error {
+	status := statusJSON{
+		CanAbort:         false,
+		CanContinue:      false,
+		CanSkip:          false,
+		CanUndo:          false,
+		Command:          "",
+		ConflictingFiles: config.conflictingFiles,
+		EndBranch:        domain.LocalBranchName{},
+		FailedBranches:   []runstate.FailedBranch{},
+		FailedStep:       nil,
+		RemainingSteps:   []runstate.BranchSteps{},
+		Status:           "none",
+		UndoSteps:        runstate.StepList{},
+	}
+	if config.state != nil {
+		status.Command = config.state.Command
+		status.FailedBranches = append(status.FailedBranches, config.state.FailedBranches...)
+		status.UndoSteps = config.state.UndoStepList
+		if config.state.IsUnfinished() {
+			status.Status = "unfinished"
+			status.CanAbort = config.state.HasAbortSteps()
+			status.CanContinue = config.state.HasRunSteps()
+			status.CanSkip = config.state.UnfinishedDetails.CanSkip
+			status.EndBranch = config.state.UnfinishedDetails.EndBranch
+			status.FailedStep = config.state.UnfinishedDetails.FailedStep
+			status.RemainingSteps = config.state.RunStepList.GroupByBranch(status.EndBranch)
+		} else {
+			status.Status = "finished"
+			status.CanContinue = config.state.HasFailedBranches()
+			status.CanUndo = config.state.HasUndoSteps()
+		}
+	}
+	content, err := json.MarshalIndent(&status, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
+
 func displayUnfinishedStatus(config displayStatusConfig) {
 	timeDiff := time.Since(config.state.UnfinishedDetails.EndTime)
 	fmt.Printf("The last Git Town command (%s) hit a problem %v ago.\n", config.state.Command, timeDiff)
@@ -95,6 +182,41 @@ func displayUnfinishedStatus(config displayStatusConfig) {
 	if config.state.UnfinishedDetails.CanSkip {
 		fmt.Println("You can run \"git town skip\" to skip the currently failing step.")
 	}
+	if config.state.UnfinishedDetails.FailedStep != nil {
+		fmt.Printf("\nFailed step on branch %q:\n", config.state.UnfinishedDetails.EndBranch)
+		fmt.Printf("  %s\n", describeStep(config.state.UnfinishedDetails.FailedStep.Step))
+	}
+	if len(config.conflictingFiles) > 0 {
+		fmt.Println("\nFiles with merge conflicts:")
+		for _, file := range config.conflictingFiles {
+			fmt.Printf("  %s\n", file)
+		}
+	}
+	if config.state.HasRunSteps() {
+		fmt.Println("\nSteps that \"git town continue\" will run:")
+		for _, group := range config.state.RunStepList.GroupByBranch(config.state.UnfinishedDetails.EndBranch) {
+			fmt.Printf("  %s:\n", group.Branch)
+			for _, step := range group.Steps.List {
+				fmt.Printf("    %s\n", describeStep(step))
+			}
+		}
+	}
+	if config.state.HasUndoSteps() {
+		fmt.Println("\nSteps that undo the changes made so far:")
+		for _, step := range config.state.UndoStepList.List {
+			fmt.Printf("  %s\n", describeStep(step))
+		}
+	}
+}
+
+// describeStep provides a human-readable description of the given step.
+func describeStep(step steps.Step) string {
+	name := gohacks.TypeName(step)
+	data, err := json.Marshal(step)
+	if err != nil || string(data) == "{}" {
+		return name
+	}
+	return name + " " + string(data)
 }
 
 func displayFinishedStatus(config displayStatusConfig) {
@@ -102,4 +224,7 @@ func displayFinishedStatus(config displayStatusConfig) {
 	if config.state.HasUndoSteps() {
 		fmt.Println("You can run \"git town undo\" to undo it.")
 	}
+	if config.state.HasFailedBranches() {
+		fmt.Printf("It could not sync %d branches. You can run \"git town continue\" to sync them.\n", len(config.state.FailedBranches))
+	}
 }
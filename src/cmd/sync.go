@@ -9,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v9/src/flags"
 	"github.com/git-town/git-town/v9/src/runstate"
 	"github.com/git-town/git-town/v9/src/runvm"
+	"github.com/git-town/git-town/v9/src/slice"
 	"github.com/git-town/git-town/v9/src/steps"
 	"github.com/git-town/git-town/v9/src/validate"
 	"github.com/spf13/cobra"
@@ -31,12 +32,18 @@ When run on the main branch or a perennial branch
 
 If the repository contains an "upstream" remote,
 syncs the main branch with its upstream counterpart.
-You can disable this by running "git config %s false".`
+You can disable this by running "git config %s false".
+
+With the "--keep-going" option, branches that cannot be synced
+because of conflicts get skipped and listed at the end.
+Run "git town continue" afterwards to sync them one at a time.`
 
 func syncCmd() *cobra.Command {
 	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
 	addAllFlag, readAllFlag := flags.Bool("all", "a", "Sync all local branches")
+	addKeepGoingFlag, readKeepGoingFlag := flags.Bool("keep-going", "k", "Skip branches with conflicts and sync the remaining branches")
 	cmd := cobra.Command{
 		Use:     "sync",
 		GroupID: "basic",
@@ -44,20 +51,25 @@ func syncCmd() *cobra.Command {
 		Short:   syncDesc,
 		Long:    long(syncDesc, fmt.Sprintf(syncHelp, config.KeySyncUpstream)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSync(readAllFlag(cmd), readDryRunFlag(cmd), readDebugFlag(cmd))
+			profile, traceFile := readProfileFlag(cmd)
+			return runSync(readAllFlag(cmd), readKeepGoingFlag(cmd), readDryRunFlag(cmd), readDebugFlag(cmd), profile, traceFile)
 		},
 	}
 	addAllFlag(&cmd)
 	addDebugFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addKeepGoingFlag(&cmd)
+	addProfileFlag(&cmd)
 	return &cmd
 }
 
-func runSync(all, dryRun, debug bool) error {
+func runSync(all, keepGoing, dryRun, debug, profile bool, traceFile string) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		Debug:            debug,
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
+		Profile:          profile,
+		TraceFile:        traceFile,
 		ValidateIsOnline: false,
 		ValidateGitRepo:  true,
 	})
@@ -74,6 +86,7 @@ func runSync(all, dryRun, debug bool) error {
 	}
 	runState := runstate.RunState{
 		Command:     "sync",
+		KeepGoing:   keepGoing,
 		RunStepList: stepList,
 	}
 	return runvm.Execute(runvm.ExecuteArgs{
@@ -88,17 +101,23 @@ func runSync(all, dryRun, debug bool) error {
 type syncConfig struct {
 	branches           domain.Branches
 	branchesToSync     domain.BranchInfos
+	downstream         config.Downstream
 	hasOpenChanges     bool
 	remotes            domain.Remotes
 	isOffline          bool
 	lineage            config.Lineage
 	mainBranch         domain.LocalBranchName
 	previousBranch     domain.LocalBranchName
+	propagateBranches  domain.LocalBranchNames // the perennial branches whose changes to merge into their downstream branches
 	pullBranchStrategy config.PullBranchStrategy
 	pushHook           bool
+	restackUpstreams   map[domain.LocalBranchName]domain.SHA
+	shareLineage       bool
 	shouldPushTags     bool
 	shouldSyncUpstream bool
+	syncStrategies     config.BranchSyncStrategies
 	syncStrategy       config.SyncStrategy
+	updateRefsStacks   map[domain.LocalBranchName]domain.LocalBranchNames
 }
 
 func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult) (*syncConfig, bool, error) {
@@ -108,12 +127,14 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult) (*syncConfi
 		Fetch:                 true,
 		HandleUnfinishedState: true,
 		Lineage:               lineage,
+		ReparentOrphans:       true,
 		ValidateIsConfigured:  true,
 		ValidateNoOpenChanges: false,
 	})
 	if err != nil || exit {
 		return nil, exit, err
 	}
+	lineage = repo.Runner.Config.Lineage() // reload because removing outdated configuration might have changed the lineage
 	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
 	hasOpenChanges, err := repo.Runner.Backend.HasOpenChanges()
 	if err != nil {
@@ -164,13 +185,24 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult) (*syncConfi
 			repo.Runner.Config.Reload()
 			branches.Types = repo.Runner.Config.BranchTypes()
 		}
-		shouldPushTags = !branches.Types.IsFeatureBranch(branches.Initial)
+		shouldPushTags = branches.Types.IsMainBranch(branches.Initial) || branches.Types.IsPerennialBranch(branches.Initial)
 	}
 	allBranchNamesToSync := lineage.BranchesAndAncestors(branchNamesToSync)
+	downstream := repo.Runner.Config.Downstream()
+	propagateBranches := domain.LocalBranchNames{}
+	for _, branch := range branchNamesToSync {
+		if (branches.Types.IsMainBranch(branch) || branches.Types.IsPerennialBranch(branch)) && len(downstream[branch]) > 0 {
+			propagateBranches = append(propagateBranches, branch)
+		}
+	}
 	syncStrategy, err := repo.Runner.Config.SyncStrategy()
 	if err != nil {
 		return nil, false, err
 	}
+	branchSyncStrategies, err := repo.Runner.Config.BranchSyncStrategies()
+	if err != nil {
+		return nil, false, err
+	}
 	pushHook, err := repo.Runner.Config.PushHook()
 	if err != nil {
 		return nil, false, err
@@ -184,40 +216,226 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult) (*syncConfi
 		return nil, false, err
 	}
 	branchesToSync, err := branches.All.Select(allBranchNamesToSync)
+	if err != nil {
+		return nil, false, err
+	}
+	restackUpstreams, err := determineRestackUpstreams(branchesToSync, branches, lineage, branchSyncStrategies, syncStrategy, repo)
+	if err != nil {
+		return nil, false, err
+	}
+	updateRefsStacks := map[domain.LocalBranchName]domain.LocalBranchNames{}
+	if usesRebase(branchSyncStrategies, syncStrategy) && repo.SupportsRebaseUpdateRefs {
+		syncUpdateRefs, err := repo.Runner.Config.ShouldSyncUpdateRefs()
+		if err != nil {
+			return nil, false, err
+		}
+		if syncUpdateRefs {
+			updateRefsStacks, err = determineUpdateRefsStacks(branchesToSync, branches, lineage, branchSyncStrategies, syncStrategy, restackUpstreams, repo)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+	}
 	return &syncConfig{
 		branches:           branches,
 		branchesToSync:     branchesToSync,
+		downstream:         downstream,
 		hasOpenChanges:     hasOpenChanges,
 		remotes:            remotes,
 		isOffline:          repo.IsOffline,
 		lineage:            lineage,
 		mainBranch:         mainBranch,
 		previousBranch:     previousBranch,
+		propagateBranches:  propagateBranches,
 		pullBranchStrategy: pullBranchStrategy,
 		pushHook:           pushHook,
+		restackUpstreams:   restackUpstreams,
+		shareLineage:       repo.ShareLineage,
 		shouldPushTags:     shouldPushTags,
 		shouldSyncUpstream: shouldSyncUpstream,
+		syncStrategies:     branchSyncStrategies,
 		syncStrategy:       syncStrategy,
-	}, false, err
+		updateRefsStacks:   updateRefsStacks,
+	}, false, nil
+}
+
+// determineRestackUpstreams provides the commits that the given feature branches were based on
+// when they were last synced, for branches whose parent branch no longer contains that commit,
+// for example because the former parent branch was shipped with a squash merge.
+// Syncing these branches moves only their own commits onto their current parent branch.
+func determineRestackUpstreams(branchesToSync domain.BranchInfos, branches domain.Branches, lineage config.Lineage, branchSyncStrategies config.BranchSyncStrategies, syncStrategy config.SyncStrategy, repo *execute.OpenRepoResult) (map[domain.LocalBranchName]domain.SHA, error) {
+	result := map[domain.LocalBranchName]domain.SHA{}
+	for _, branch := range branchesToSync {
+		if !branches.Types.IsFeatureBranch(branch.LocalName) || branchSyncStrategies.For(branch.LocalName, syncStrategy) != config.SyncStrategyRebase {
+			continue
+		}
+		parentSHA := repo.Runner.Config.ParentSHA(branch.LocalName)
+		if parentSHA.IsEmpty() {
+			continue
+		}
+		if !repo.Runner.Backend.HasCommit(parentSHA) {
+			// the recorded commit was garbage-collected, it can't be the base of this branch anymore
+			err := repo.Runner.Config.RemoveParentSHA(branch.LocalName)
+			if err != nil {
+				return result, err
+			}
+			continue
+		}
+		isBase, err := repo.Runner.Backend.IsAncestor(parentSHA, branch.LocalName.BranchName())
+		if err != nil {
+			return result, err
+		}
+		if !isBase {
+			// the branch was moved elsewhere since it was last synced
+			continue
+		}
+		parent := branches.All.FindLocalBranch(lineage.Parent(branch.LocalName))
+		if parent == nil {
+			continue
+		}
+		parentContainsSHA, err := repo.Runner.Backend.IsAncestor(parentSHA, parent.LocalName.BranchName())
+		if err != nil {
+			return result, err
+		}
+		if !parentContainsSHA && parent.HasTrackingBranch() {
+			parentContainsSHA, err = repo.Runner.Backend.IsAncestor(parentSHA, parent.RemoteName.BranchName())
+			if err != nil {
+				return result, err
+			}
+		}
+		if !parentContainsSHA {
+			result[branch.LocalName] = parentSHA
+		}
+	}
+	return result, nil
+}
+
+// determineUpdateRefsStacks provides the linear stacks of feature branches
+// that can be synced by rebasing only their leaf branch with "git rebase --update-refs".
+// The result maps each leaf branch to the branches below it that Git moves along, ordered from the bottom of the stack.
+func determineUpdateRefsStacks(branchesToSync domain.BranchInfos, branches domain.Branches, lineage config.Lineage, branchSyncStrategies config.BranchSyncStrategies, syncStrategy config.SyncStrategy, restackUpstreams map[domain.LocalBranchName]domain.SHA, repo *execute.OpenRepoResult) (map[domain.LocalBranchName]domain.LocalBranchNames, error) {
+	result := map[domain.LocalBranchName]domain.LocalBranchNames{}
+	canStack := func(branch domain.BranchInfo) bool {
+		// branches that need commits from their tracking branch or get moved onto a new base cannot be rebased along with their children
+		switch branch.SyncStatus {
+		case domain.SyncStatusUpToDate, domain.SyncStatusAhead, domain.SyncStatusLocalOnly:
+		default:
+			return false
+		}
+		_, restacks := restackUpstreams[branch.LocalName]
+		isRebased := branchSyncStrategies.For(branch.LocalName, syncStrategy) == config.SyncStrategyRebase
+		return branches.Types.IsFeatureBranch(branch.LocalName) && isRebased && !restacks
+	}
+	intermediates := map[domain.LocalBranchName]bool{}
+	for _, branch := range branchesToSync {
+		if !canStack(branch) {
+			continue
+		}
+		children := domain.LocalBranchNames{}
+		for _, child := range lineage.Children(branch.LocalName) {
+			if branches.All.HasLocalBranch(child) {
+				children = append(children, child)
+			}
+		}
+		if len(children) != 1 {
+			continue
+		}
+		child := branchesToSync.FindLocalBranch(children[0])
+		if child == nil || !canStack(*child) {
+			continue
+		}
+		ahead, _, err := repo.Runner.Backend.CommitsAheadAndBehind(branch.LocalName, lineage.Parent(branch.LocalName))
+		if err != nil {
+			return result, err
+		}
+		if ahead == 0 {
+			// Git moves only branches that point to one of the rebased commits
+			continue
+		}
+		isInChild, err := repo.Runner.Backend.IsAncestor(branch.LocalSHA, child.LocalName.BranchName())
+		if err != nil {
+			return result, err
+		}
+		if isInChild {
+			intermediates[branch.LocalName] = true
+		}
+	}
+	for _, branch := range branchesToSync {
+		if intermediates[branch.LocalName] {
+			continue
+		}
+		stack := domain.LocalBranchNames{}
+		for parent := lineage.Parent(branch.LocalName); intermediates[parent]; parent = lineage.Parent(parent) {
+			stack = append(domain.LocalBranchNames{parent}, stack...)
+		}
+		if len(stack) > 0 {
+			result[branch.LocalName] = stack
+		}
+	}
+	return result, nil
+}
+
+// usesRebase indicates whether any branch uses the rebase sync strategy.
+func usesRebase(branchSyncStrategies config.BranchSyncStrategies, syncStrategy config.SyncStrategy) bool {
+	if syncStrategy == config.SyncStrategyRebase {
+		return true
+	}
+	for _, strategy := range branchSyncStrategies {
+		if strategy == config.SyncStrategyRebase {
+			return true
+		}
+	}
+	return false
 }
 
 // syncBranchesSteps provides the step list for the "git sync" command.
 func syncBranchesSteps(config *syncConfig) (runstate.StepList, error) {
 	list := runstate.StepListBuilder{}
+	stackedBranches := map[domain.LocalBranchName]bool{}
+	for _, stack := range config.updateRefsStacks {
+		for _, branch := range stack {
+			stackedBranches[branch] = true
+		}
+	}
 	for _, branch := range config.branchesToSync {
-		syncBranchSteps(&list, syncBranchStepsArgs{
-			branch:             branch,
-			branchTypes:        config.branches.Types,
-			remotes:            config.remotes,
-			isOffline:          config.isOffline,
-			lineage:            config.lineage,
-			mainBranch:         config.mainBranch,
-			pullBranchStrategy: config.pullBranchStrategy,
-			pushBranch:         true,
-			pushHook:           config.pushHook,
-			shouldSyncUpstream: config.shouldSyncUpstream,
-			syncStrategy:       config.syncStrategy,
-		})
+		if stackedBranches[branch.LocalName] {
+			// synced together with the leaf of its stack
+			continue
+		}
+		if stack, isLeaf := config.updateRefsStacks[branch.LocalName]; isLeaf {
+			syncStackSteps(&list, branch, stack, config)
+		} else {
+			syncBranchSteps(&list, syncBranchStepsArgs{
+				branch:             branch,
+				branchTypes:        config.branches.Types,
+				remotes:            config.remotes,
+				isOffline:          config.isOffline,
+				lineage:            config.lineage,
+				mainBranch:         config.mainBranch,
+				pullBranchStrategy: config.pullBranchStrategy,
+				pushBranch:         true,
+				pushHook:           config.pushHook,
+				restackUpstream:    config.restackUpstreams[branch.LocalName],
+				shouldSyncUpstream: config.shouldSyncUpstream,
+				syncStrategies:     config.syncStrategies,
+				syncStrategy:       config.syncStrategy,
+			})
+			if slice.Contains(config.propagateBranches, branch.LocalName) {
+				propagateDownstreamSteps(&list, propagateDownstreamStepsArgs{
+					allBranches:        config.branches.All,
+					branch:             branch.LocalName,
+					downstream:         config.downstream,
+					isOffline:          config.isOffline,
+					pullBranchStrategy: config.pullBranchStrategy,
+					pushHook:           config.pushHook,
+					remotes:            config.remotes,
+				})
+			}
+		}
+		if config.shareLineage {
+			// share the lineage of the pushed branches right away in case this command doesn't finish
+			list.Add(&steps.PushSharedLineageStep{})
+		}
 	}
 	list.Add(&steps.CheckoutStep{Branch: config.branches.Initial})
 	if config.remotes.HasOrigin() && config.shouldPushTags && !config.isOffline {
@@ -229,6 +447,7 @@ func syncBranchesSteps(config *syncConfig) (runstate.StepList, error) {
 		MainBranch:       config.mainBranch,
 		InitialBranch:    config.branches.Initial,
 		PreviousBranch:   config.previousBranch,
+		ShareLineage:     config.shareLineage,
 	})
 	return list.Result()
 }
@@ -237,13 +456,19 @@ func syncBranchesSteps(config *syncConfig) (runstate.StepList, error) {
 func syncBranchSteps(list *runstate.StepListBuilder, args syncBranchStepsArgs) {
 	isFeatureBranch := args.branchTypes.IsFeatureBranch(args.branch.LocalName)
 	if !isFeatureBranch && !args.remotes.HasOrigin() {
-		// perennial branch but no remote --> this branch cannot be synced
+		// perennial or observed branch but no remote --> this branch cannot be synced
 		return
 	}
 	list.Add(&steps.CheckoutStep{Branch: args.branch.LocalName})
-	if isFeatureBranch {
-		syncFeatureBranchSteps(list, args.branch, args.lineage, args.syncStrategy)
-	} else {
+	syncStrategy := args.syncStrategies.For(args.branch.LocalName, args.syncStrategy)
+	switch {
+	case args.branchTypes.IsObservedBranch(args.branch.LocalName):
+		syncObservedBranchSteps(list, args.branch, args.pullBranchStrategy)
+		// observed branches belong to somebody else and never get pushed
+		return
+	case isFeatureBranch:
+		syncFeatureBranchSteps(list, args.branch, args.lineage, syncStrategy, args.restackUpstream)
+	default:
 		syncPerennialBranchSteps(list, syncPerennialBranchStepsArgs{
 			branch:             args.branch,
 			mainBranch:         args.mainBranch,
@@ -252,16 +477,52 @@ func syncBranchSteps(list *runstate.StepListBuilder, args syncBranchStepsArgs) {
 			hasUpstream:        args.remotes.HasUpstream(),
 		})
 	}
-	if args.pushBranch && args.remotes.HasOrigin() && !args.isOffline {
+	if args.pushBranch && args.remotes.HasOrigin() && !args.isOffline && !args.branchTypes.IsPrototypeBranch(args.branch.LocalName) {
 		switch {
 		case !args.branch.HasTrackingBranch():
 			list.Add(&steps.CreateTrackingBranchStep{Branch: args.branch.LocalName, NoPushHook: false})
 		case !isFeatureBranch:
 			list.Add(&steps.PushCurrentBranchStep{CurrentBranch: args.branch.LocalName, NoPushHook: false, Undoable: false})
 		default:
-			pushFeatureBranchSteps(list, args.branch.LocalName, args.syncStrategy, args.pushHook)
+			pushFeatureBranchSteps(list, args.branch, args.lineage.Parent(args.branch.LocalName), syncStrategy, args.pushHook)
+		}
+	}
+}
+
+// syncStackSteps provides the steps to sync the given leaf branch together with the given branches below it
+// by rebasing only the leaf branch and letting Git move the other branches.
+func syncStackSteps(list *runstate.StepListBuilder, leaf domain.BranchInfo, stack domain.LocalBranchNames, config *syncConfig) {
+	list.Add(&steps.CheckoutStep{Branch: leaf.LocalName})
+	syncStrategy := config.syncStrategies.For(leaf.LocalName, config.syncStrategy)
+	if leaf.HasTrackingBranch() {
+		pullTrackingBranchOfCurrentFeatureBranchStep(list, leaf.RemoteName, syncStrategy)
+	}
+	list.Add(&steps.RebaseUpdateRefsStep{Branch: config.lineage.Parent(stack[0]).BranchName(), MovedBranches: stack})
+	for _, branch := range stack {
+		list.Add(&steps.RecordParentSHAStep{Branch: branch, Parent: config.lineage.Parent(branch)})
+	}
+	list.Add(&steps.RecordParentSHAStep{Branch: leaf.LocalName, Parent: config.lineage.Parent(leaf.LocalName)})
+	if !config.remotes.HasOrigin() || config.isOffline {
+		return
+	}
+	for _, branch := range stack {
+		switch {
+		case config.branches.Types.IsPrototypeBranch(branch):
+			// prototype branches never get pushed
+		case config.branchesToSync.FindLocalBranch(branch).HasTrackingBranch():
+			list.Add(&steps.ForcePushLocalBranchStep{Branch: branch, NoPushHook: !config.pushHook, RemoteSHA: config.branchesToSync.FindLocalBranch(branch).RemoteSHA})
+		default:
+			list.Add(&steps.CreateTrackingBranchStep{Branch: branch, NoPushHook: !config.pushHook})
 		}
 	}
+	switch {
+	case config.branches.Types.IsPrototypeBranch(leaf.LocalName):
+		// prototype branches never get pushed
+	case leaf.HasTrackingBranch():
+		pushFeatureBranchSteps(list, leaf, config.lineage.Parent(leaf.LocalName), syncStrategy, config.pushHook)
+	default:
+		list.Add(&steps.CreateTrackingBranchStep{Branch: leaf.LocalName, NoPushHook: !config.pushHook})
+	}
 }
 
 type syncBranchStepsArgs struct {
@@ -274,16 +535,33 @@ type syncBranchStepsArgs struct {
 	pullBranchStrategy config.PullBranchStrategy
 	pushBranch         bool
 	pushHook           bool
+	restackUpstream    domain.SHA // when set, moves the commits after this commit onto the parent branch
 	shouldSyncUpstream bool
+	syncStrategies     config.BranchSyncStrategies // sync strategies of individual branches that replace syncStrategy
 	syncStrategy       config.SyncStrategy
 }
 
 // syncFeatureBranchSteps adds all the steps to sync the feature branch with the given name.
-func syncFeatureBranchSteps(list *runstate.StepListBuilder, branch domain.BranchInfo, lineage config.Lineage, syncStrategy config.SyncStrategy) {
+func syncFeatureBranchSteps(list *runstate.StepListBuilder, branch domain.BranchInfo, lineage config.Lineage, syncStrategy config.SyncStrategy, restackUpstream domain.SHA) {
 	if branch.HasTrackingBranch() {
 		pullTrackingBranchOfCurrentFeatureBranchStep(list, branch.RemoteName, syncStrategy)
 	}
-	pullParentBranchOfCurrentFeatureBranchStep(list, lineage.Parent(branch.LocalName), syncStrategy)
+	parent := lineage.Parent(branch.LocalName)
+	if syncStrategy == config.SyncStrategyRebase && !restackUpstream.IsEmpty() {
+		list.Add(&steps.RebaseOntoStep{Onto: parent, Upstream: restackUpstream})
+	} else {
+		pullParentBranchOfCurrentFeatureBranchStep(list, parent, syncStrategy)
+	}
+	if syncStrategy == config.SyncStrategyRebase {
+		list.Add(&steps.RecordParentSHAStep{Branch: branch.LocalName, Parent: parent})
+	}
+}
+
+// syncObservedBranchSteps adds all the steps to sync the observed branch with the given name.
+func syncObservedBranchSteps(list *runstate.StepListBuilder, branch domain.BranchInfo, pullBranchStrategy config.PullBranchStrategy) {
+	if branch.HasTrackingBranch() {
+		updateCurrentPerennialBranchStep(list, branch.RemoteName, pullBranchStrategy)
+	}
 }
 
 // syncPerennialBranchSteps adds all the steps to sync the perennial branch with the given name.
@@ -297,6 +575,42 @@ func syncPerennialBranchSteps(list *runstate.StepListBuilder, args syncPerennial
 	}
 }
 
+// propagateDownstreamSteps adds the steps to merge the changes of the given perennial branch
+// into the perennial branches downstream of it.
+func propagateDownstreamSteps(list *runstate.StepListBuilder, args propagateDownstreamStepsArgs) {
+	skipped := domain.LocalBranchNames{}
+	for _, merge := range args.downstream.Chain(args.branch) {
+		target := args.allBranches.FindLocalBranch(merge.Branch)
+		if target == nil || slice.Contains(skipped, merge.Source) {
+			// branches that don't exist locally can't receive changes and don't provide changes to their downstream branches
+			skipped = append(skipped, merge.Branch)
+			continue
+		}
+		list.Add(&steps.CheckoutStep{Branch: target.LocalName})
+		if target.HasTrackingBranch() {
+			updateCurrentPerennialBranchStep(list, target.RemoteName, args.pullBranchStrategy)
+		}
+		list.Add(&steps.MergeStep{Branch: merge.Source.BranchName()})
+		if args.remotes.HasOrigin() && !args.isOffline {
+			if target.HasTrackingBranch() {
+				list.Add(&steps.PushCurrentBranchStep{CurrentBranch: target.LocalName, NoPushHook: !args.pushHook, Undoable: false})
+			} else {
+				list.Add(&steps.CreateTrackingBranchStep{Branch: target.LocalName, NoPushHook: !args.pushHook})
+			}
+		}
+	}
+}
+
+type propagateDownstreamStepsArgs struct {
+	allBranches        domain.BranchInfos
+	branch             domain.LocalBranchName
+	downstream         config.Downstream
+	isOffline          bool
+	pullBranchStrategy config.PullBranchStrategy
+	pushHook           bool
+	remotes            domain.Remotes
+}
+
 type syncPerennialBranchStepsArgs struct {
 	branch             domain.BranchInfo
 	mainBranch         domain.LocalBranchName
@@ -341,12 +655,12 @@ func updateCurrentPerennialBranchStep(list *runstate.StepListBuilder, otherBranc
 	}
 }
 
-func pushFeatureBranchSteps(list *runstate.StepListBuilder, branch domain.LocalBranchName, syncStrategy config.SyncStrategy, pushHook bool) {
+func pushFeatureBranchSteps(list *runstate.StepListBuilder, branch domain.BranchInfo, parent domain.LocalBranchName, syncStrategy config.SyncStrategy, pushHook bool) {
 	switch syncStrategy {
 	case config.SyncStrategyMerge:
-		list.Add(&steps.PushCurrentBranchStep{CurrentBranch: branch, NoPushHook: !pushHook, Undoable: false})
+		list.Add(&steps.PushCurrentBranchStep{CurrentBranch: branch.LocalName, NoPushHook: !pushHook, Undoable: false})
 	case config.SyncStrategyRebase:
-		list.Add(&steps.ForcePushBranchStep{Branch: branch, NoPushHook: false})
+		list.Add(&steps.ForcePushBranchStep{Branch: branch.LocalName, NoPushHook: false, Parent: parent, RemoteSHA: branch.RemoteSHA})
 	default:
 		list.Fail("unknown syncStrategy value: %q", syncStrategy)
 	}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/slice"
+	"github.com/spf13/cobra"
+)
+
+const topDesc = "Switches to the last branch in the stack of the current branch"
+
+const topHelp = `
+Follows the child branches of the current branch until it reaches a branch without children.
+Where a branch has several child branches, asks which one to follow.
+Stashes and restores uncommitted changes.`
+
+func topCmd() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:     "top",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   topDesc,
+		Long:    long(topDesc, topHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runNavigate("top", determineTopTarget, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func determineTopTarget(config *navigateConfig) (domain.LocalBranchName, error) {
+	result := config.branches.Initial
+	visited := domain.LocalBranchNames{}
+	for {
+		visited = append(visited, result)
+		children := config.localChildren(result)
+		if len(children) == 0 {
+			return result, nil
+		}
+		var err error
+		result, err = config.selectChild(result, children)
+		if err != nil {
+			return result, err
+		}
+		if slice.Contains(visited, result) {
+			// "git town doctor" repairs cycles in the lineage
+			return result, fmt.Errorf(messages.NavigateLineageCycle, config.branches.Initial)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/execute"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/spf13/cobra"
+)
+
+const unobserveDesc = "Turns an observed branch back into a feature branch"
+
+const unobserveHelp = `
+"git town sync" syncs the branch with its parent branch and pushes it again.
+Git Town asks for the parent branch the next time it needs it.
+
+Works on either the current branch or the branch name provided.`
+
+func unobserveCommand() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:   "unobserve [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: unobserveDesc,
+		Long:  long(unobserveDesc, unobserveHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runUnobserve(args, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func runUnobserve(args []string, debug, profile bool, traceFile string) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		Debug:            debug,
+		DryRun:           false,
+		OmitBranchNames:  true,
+		Profile:          profile,
+		TraceFile:        traceFile,
+		ValidateIsOnline: false,
+		ValidateGitRepo:  true,
+	})
+	if err != nil {
+		return err
+	}
+	branch, err := determineBranchArg(args, &repo)
+	if err != nil {
+		return err
+	}
+	if !repo.Runner.Config.BranchTypes().IsObservedBranch(branch) {
+		return fmt.Errorf(messages.UnobserveNotObserved, branch)
+	}
+	stepList := runstate.StepList{}
+	stepList.Append(&steps.RemoveFromObservedBranchesStep{Branch: branch})
+	err = runConfigSteps("unobserve", stepList, &repo)
+	if err != nil {
+		return err
+	}
+	cli.Printf(messages.UnobserveDone, branch)
+	return nil
+}
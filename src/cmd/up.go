@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const upDesc = "Switches to the child branch of the current branch"
+
+const upHelp = `
+If the current branch has several child branches,
+asks which one to switch to.
+Stashes and restores uncommitted changes.`
+
+func upCmd() *cobra.Command {
+	addDebugFlag, readDebugFlag := flags.Debug()
+	addProfileFlag, readProfileFlag := flags.Profile()
+	cmd := cobra.Command{
+		Use:     "up",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   upDesc,
+		Long:    long(upDesc, upHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, traceFile := readProfileFlag(cmd)
+			return runNavigate("up", determineUpTarget, readDebugFlag(cmd), profile, traceFile)
+		},
+	}
+	addDebugFlag(&cmd)
+	addProfileFlag(&cmd)
+	return &cmd
+}
+
+func determineUpTarget(config *navigateConfig) (domain.LocalBranchName, error) {
+	children := config.localChildren(config.branches.Initial)
+	if len(children) == 0 {
+		return domain.LocalBranchName{}, fmt.Errorf(messages.NavigateNoChildBranch, config.branches.Initial)
+	}
+	return config.selectChild(config.branches.Initial, children)
+}
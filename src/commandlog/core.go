@@ -0,0 +1,2 @@
+// Package commandlog stores a persistent log of the shell commands that Git Town runs.
+package commandlog
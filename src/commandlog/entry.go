@@ -0,0 +1,32 @@
+package commandlog
+
+import (
+	"strings"
+	"time"
+)
+
+// Entry is a shell command recorded in the command log.
+type Entry struct {
+	Command        string    `json:"command"`        // the executed shell command including its arguments
+	DurationMS     float64   `json:"durationMs"`     // how long the command ran, in milliseconds
+	ExitCode       int       `json:"exitCode"`       // the exit code of the command, -1 if it could not be started
+	Frontend       bool      `json:"frontend"`       // whether this is a frontend command that the user sees
+	GitTownCommand string    `json:"gitTownCommand"` // the Git Town command that ran this shell command, as the user entered it
+	Output         string    `json:"output"`         // the output of the command
+	Step           string    `json:"step"`           // the step that ran this command, empty for commands run while loading the repo
+	Time           time.Time `json:"time"`           // when the command started
+}
+
+// Duration provides how long the command of this entry ran.
+func (e Entry) Duration() time.Duration {
+	return time.Duration(e.DurationMS * float64(time.Millisecond))
+}
+
+// GitTownSubcommand provides the name of the Git Town command that ran this entry, i.e. "sync".
+func (e Entry) GitTownSubcommand() string {
+	fields := strings.Fields(e.GitTownCommand)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
@@ -0,0 +1,26 @@
+package commandlog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/persistence"
+)
+
+// FilePath provides the path of the command log file for the repo in the given directory.
+func FilePath(repoDir domain.RepoRootDir) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(messages.CommandLogPathProblem, err)
+	}
+	logDir := filepath.Join(configDir, "git-town", "logs")
+	return filepath.Join(logDir, persistence.SanitizePath(repoDir)+".jsonl"), nil
+}
+
+// rotatedFilePath provides the path of the given rotated generation of the given log file.
+func rotatedFilePath(path string, generation int) string {
+	return fmt.Sprintf("%s.%d", path, generation)
+}
@@ -0,0 +1,99 @@
+package commandlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// Load provides all entries in the command log of the repo in the given directory,
+// including the rotated log files, oldest first.
+func Load(repoDir domain.RepoRootDir) ([]Entry, error) {
+	path, err := FilePath(repoDir)
+	if err != nil {
+		return []Entry{}, err
+	}
+	return LoadFile(path)
+}
+
+// LoadFile provides all entries in the log file at the given path
+// and its rotated predecessors, oldest first.
+func LoadFile(path string) ([]Entry, error) {
+	result := []Entry{}
+	for generation := RotatedFiles; generation >= 0; generation-- {
+		filePath := path
+		if generation > 0 {
+			filePath = rotatedFilePath(path, generation)
+		}
+		entries, err := loadSingleFile(filePath)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, entries...)
+	}
+	return result, nil
+}
+
+func loadSingleFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Entry{}, nil
+		}
+		return []Entry{}, fmt.Errorf(messages.CommandLogReadProblem, path, err)
+	}
+	defer file.Close()
+	result := []Entry{}
+	decoder := json.NewDecoder(file)
+	for {
+		var entry Entry
+		err = decoder.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf(messages.CommandLogReadProblem, path, err)
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// Filter provides the given entries that were recorded at or after the given time
+// and that were caused by the Git Town command with the given name.
+// A zero time or empty command name matches all entries.
+func Filter(entries []Entry, since time.Time, command string) []Entry {
+	result := []Entry{}
+	for _, entry := range entries {
+		if !since.IsZero() && entry.Time.Before(since) {
+			continue
+		}
+		if command != "" && entry.GitTownSubcommand() != command {
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// ParseSince parses the given user-provided point in time, either as a date or as an RFC 3339 timestamp.
+func ParseSince(text string) (time.Time, error) {
+	if text == "" {
+		return time.Time{}, nil
+	}
+	result, err := time.ParseInLocation("2006-01-02", text, time.Local)
+	if err == nil {
+		return result, nil
+	}
+	result, err = time.Parse(time.RFC3339, text)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(messages.CommandLogDateInvalid, text, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package commandlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/commandlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Filter", func(t *testing.T) {
+		t.Parallel()
+		day1 := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+		day2 := time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+		entries := []commandlog.Entry{
+			{Command: "one", GitTownCommand: "sync --all", Time: day1},   //nolint:exhaustruct
+			{Command: "two", GitTownCommand: "hack feature", Time: day2}, //nolint:exhaustruct
+			{Command: "three", GitTownCommand: "sync", Time: day2},       //nolint:exhaustruct
+		}
+		t.Run("no filter", func(t *testing.T) {
+			t.Parallel()
+			have := commandlog.Filter(entries, time.Time{}, "")
+			assert.Equal(t, entries, have)
+		})
+		t.Run("by date", func(t *testing.T) {
+			t.Parallel()
+			have := commandlog.Filter(entries, day2, "")
+			assert.Equal(t, entries[1:], have)
+		})
+		t.Run("by command", func(t *testing.T) {
+			t.Parallel()
+			have := commandlog.Filter(entries, time.Time{}, "sync")
+			assert.Equal(t, []commandlog.Entry{entries[0], entries[2]}, have)
+		})
+		t.Run("by date and command", func(t *testing.T) {
+			t.Parallel()
+			have := commandlog.Filter(entries, day2, "sync")
+			assert.Equal(t, []commandlog.Entry{entries[2]}, have)
+		})
+	})
+
+	t.Run("ParseSince", func(t *testing.T) {
+		t.Parallel()
+		t.Run("date", func(t *testing.T) {
+			t.Parallel()
+			have, err := commandlog.ParseSince("2023-10-02")
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2023, 10, 2, 0, 0, 0, 0, time.Local), have)
+		})
+		t.Run("timestamp", func(t *testing.T) {
+			t.Parallel()
+			have, err := commandlog.ParseSince("2023-10-02T14:30:00Z")
+			assert.NoError(t, err)
+			assert.True(t, time.Date(2023, 10, 2, 14, 30, 0, 0, time.UTC).Equal(have))
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have, err := commandlog.ParseSince("")
+			assert.NoError(t, err)
+			assert.True(t, have.IsZero())
+		})
+		t.Run("invalid", func(t *testing.T) {
+			t.Parallel()
+			_, err := commandlog.ParseSince("yesterday")
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,127 @@
+package commandlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/statistics"
+)
+
+const (
+	// MaxFileSize is the size in bytes above which the command log of a repo gets rotated.
+	MaxFileSize = 1024 * 1024
+
+	// RotatedFiles is how many rotated log files to keep per repo.
+	RotatedFiles = 3
+)
+
+// Log appends entries to the command log of a repo.
+type Log struct {
+	GitTownCommand string // the Git Town command that the user is running
+	Path           string // path of the log file
+}
+
+// Open provides a Log for the repo in the given directory
+// and rotates the existing log file if it has grown too large.
+func Open(repoDir domain.RepoRootDir, gitTownCommand string) (*Log, error) {
+	path, err := FilePath(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	return OpenFile(path, gitTownCommand)
+}
+
+// OpenFile provides a Log that appends to the log file at the given path
+// and rotates the existing log file if it has grown too large.
+func OpenFile(path string, gitTownCommand string) (*Log, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CommandLogWriteProblem, path, err)
+	}
+	err = rotate(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{
+		GitTownCommand: gitTownCommand,
+		Path:           path,
+	}, nil
+}
+
+// RecordRun appends the given command run to the log file.
+func (l *Log) RecordRun(run statistics.CommandRun) error {
+	return l.Write(Entry{
+		Command:        run.Command,
+		DurationMS:     float64(run.Duration) / float64(time.Millisecond),
+		ExitCode:       run.ExitCode,
+		Frontend:       run.Frontend,
+		GitTownCommand: l.GitTownCommand,
+		Output:         loggedOutput(run),
+		Step:           run.Step,
+		Time:           run.Start,
+	})
+}
+
+// Write appends the given entry to the log file.
+func (l *Log) Write(entry Entry) error {
+	entry.GitTownCommand = l.GitTownCommand
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf(messages.CommandLogWriteProblem, l.Path, err)
+	}
+	file, err := os.OpenFile(l.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.CommandLogWriteProblem, l.Path, err)
+	}
+	_, err = file.Write(append(content, '\n'))
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf(messages.CommandLogWriteProblem, l.Path, err)
+	}
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf(messages.CommandLogWriteProblem, l.Path, err)
+	}
+	return nil
+}
+
+// loggedOutput provides the output of the given command run as the log stores it.
+// The output of "git config" can contain access tokens, so the log doesn't store it.
+func loggedOutput(run statistics.CommandRun) string {
+	if run.Command == "git config" || strings.HasPrefix(run.Command, "git config ") {
+		return ""
+	}
+	return run.Output
+}
+
+// rotate moves the log file at the given path out of the way if it is larger than MaxFileSize.
+func rotate(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf(messages.FileStatProblem, path, err)
+	}
+	if info.Size() < MaxFileSize {
+		return nil
+	}
+	_ = os.Remove(rotatedFilePath(path, RotatedFiles))
+	for generation := RotatedFiles - 1; generation >= 1; generation-- {
+		err = os.Rename(rotatedFilePath(path, generation), rotatedFilePath(path, generation+1))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf(messages.CommandLogRotateProblem, path, err)
+		}
+	}
+	err = os.Rename(path, rotatedFilePath(path, 1))
+	if err != nil {
+		return fmt.Errorf(messages.CommandLogRotateProblem, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package commandlog_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/commandlog"
+	"github.com/git-town/git-town/v9/src/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RecordRun and LoadFile", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "logs", "repo.jsonl")
+		log, err := commandlog.OpenFile(path, "sync --all")
+		assert.NoError(t, err)
+		start := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+		err = log.RecordRun(statistics.CommandRun{
+			Command:  "git fetch --prune --tags",
+			Duration: 1500 * time.Microsecond,
+			ExitCode: 0,
+			Frontend: true,
+			Output:   "fetched\n",
+			Start:    start,
+			Step:     "FetchStep",
+		})
+		assert.NoError(t, err)
+		err = log.RecordRun(statistics.CommandRun{
+			Command:  "git rev-parse --verify --abbrev-ref @{-1}",
+			Duration: time.Millisecond,
+			ExitCode: 128,
+			Frontend: false,
+			Start:    start.Add(time.Second),
+		})
+		assert.NoError(t, err)
+		have, err := commandlog.LoadFile(path)
+		assert.NoError(t, err)
+		want := []commandlog.Entry{
+			{
+				Command:        "git fetch --prune --tags",
+				DurationMS:     1.5,
+				ExitCode:       0,
+				Frontend:       true,
+				GitTownCommand: "sync --all",
+				Output:         "fetched\n",
+				Step:           "FetchStep",
+				Time:           start,
+			},
+			{
+				Command:        "git rev-parse --verify --abbrev-ref @{-1}",
+				DurationMS:     1,
+				ExitCode:       128,
+				Frontend:       false,
+				GitTownCommand: "sync --all",
+				Output:         "",
+				Step:           "",
+				Time:           start.Add(time.Second),
+			},
+		}
+		assert.Equal(t, want, have)
+		assert.Equal(t, 1500*time.Microsecond, have[0].Duration())
+	})
+
+	t.Run("does not record the output of git config", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "repo.jsonl")
+		log, err := commandlog.OpenFile(path, "sync")
+		assert.NoError(t, err)
+		err = log.RecordRun(statistics.CommandRun{
+			Command:  "git config -lz --global",
+			Duration: time.Millisecond,
+			ExitCode: 0,
+			Frontend: false,
+			Output:   "git-town.github-token\nsecret\x00",
+			Start:    time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+		})
+		assert.NoError(t, err)
+		have, err := commandlog.LoadFile(path)
+		assert.NoError(t, err)
+		assert.Len(t, have, 1)
+		assert.Equal(t, "git config -lz --global", have[0].Command)
+		assert.Equal(t, "", have[0].Output)
+	})
+
+	t.Run("rotates large log files", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "repo.jsonl")
+		start := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+		for i := 0; i < commandlog.RotatedFiles+2; i++ {
+			log, err := commandlog.OpenFile(path, "sync")
+			assert.NoError(t, err)
+			err = log.Write(commandlog.Entry{
+				Command:        "git status",
+				DurationMS:     1,
+				ExitCode:       0,
+				Frontend:       false,
+				GitTownCommand: "",
+				Output:         strings.Repeat("x", commandlog.MaxFileSize),
+				Step:           "",
+				Time:           start.Add(time.Duration(i) * time.Second),
+			})
+			assert.NoError(t, err)
+		}
+		have, err := commandlog.LoadFile(path)
+		assert.NoError(t, err)
+		// the current file plus the rotated files, the oldest entry got dropped
+		assert.Len(t, have, commandlog.RotatedFiles+1)
+		assert.Equal(t, start.Add(time.Second), have[0].Time)
+		assert.Equal(t, start.Add(time.Duration(commandlog.RotatedFiles+1)*time.Second), have[len(have)-1].Time)
+	})
+}
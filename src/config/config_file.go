@@ -0,0 +1,164 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/messages"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileName is the name of the file in the root directory of the repository
+// that contains the Git Town configuration shared by all users of the repository.
+const ConfigFileName = ".git-town.yml"
+
+// ConfigFileKeys provides the configuration keys that the configuration file can contain.
+// Machine-specific settings like API tokens or the offline mode don't belong into a file that gets committed.
+func ConfigFileKeys() []Key {
+	return []Key{
+		KeyMainBranch,
+		KeyPerennialBranches,
+		KeyPerennialRegex,
+		KeyCodeHostingDriver,
+		KeyCodeHostingOriginHostname,
+		KeyPullBranchStrategy,
+		KeyPushHook,
+		KeyPushNewBranches,
+		KeyShareLineage,
+		KeyShipChangelogTrailers,
+		KeyShipDeleteRemoteBranch,
+		KeyShipMessageTemplate,
+		KeySyncStrategy,
+		KeySyncUpdateRefs,
+		KeySyncUpstream,
+	}
+}
+
+// ConfigFileKeyName provides the name of the given key in the configuration file.
+func ConfigFileKeyName(key Key) string {
+	return strings.TrimPrefix(key.Name, "git-town.")
+}
+
+// LoadConfigFile provides the configuration values in the configuration file of the repository with the given root directory.
+func LoadConfigFile(rootDir domain.RepoRootDir) (GitConfigCache, error) {
+	path := filepath.Join(rootDir.String(), ConfigFileName)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return GitConfigCache{}, nil
+		}
+		return GitConfigCache{}, fmt.Errorf(messages.ConfigFileCannotRead, ConfigFileName, err)
+	}
+	return ParseConfigFile(content)
+}
+
+// ParseConfigFile provides the configuration values in the given content of a configuration file.
+// It ignores unknown settings, for example the ones of newer Git Town versions.
+// Settings with invalid values don't prevent reading the other settings,
+// the returned error describes the first invalid value.
+func ParseConfigFile(content []byte) (GitConfigCache, error) {
+	result := GitConfigCache{}
+	entries := map[string]interface{}{}
+	err := yaml.Unmarshal(content, &entries)
+	if err != nil {
+		return result, fmt.Errorf(messages.ConfigFileInvalid, ConfigFileName, err)
+	}
+	var firstErr error
+	for _, name := range sortedKeys(entries) {
+		key := parseConfigFileKey(name)
+		if key == nil {
+			continue
+		}
+		text, err := configFileValue(*key, entries[name])
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		result[*key] = text
+	}
+	return result, firstErr
+}
+
+// RenderConfigFile provides the content of a configuration file containing the given configuration values.
+func RenderConfigFile(values GitConfigCache) ([]byte, error) {
+	document := yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range ConfigFileKeys() {
+		value, has := values[key]
+		if !has || value == "" {
+			continue
+		}
+		valueNode := yaml.Node{Kind: yaml.ScalarNode, Value: value}
+		if key == KeyPerennialBranches {
+			valueNode = yaml.Node{Kind: yaml.SequenceNode}
+			for _, branch := range strings.Fields(value) {
+				valueNode.Content = append(valueNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: branch})
+			}
+		} else if _, err := ParseBool(value); err != nil {
+			valueNode.Tag = "!!str"
+		}
+		document.Content = append(document.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: ConfigFileKeyName(key)},
+			&valueNode,
+		)
+	}
+	var result bytes.Buffer
+	encoder := yaml.NewEncoder(&result)
+	encoder.SetIndent(2)
+	err := encoder.Encode(&document)
+	if err != nil {
+		return []byte{}, err
+	}
+	err = encoder.Close()
+	return result.Bytes(), err
+}
+
+// configFileValue converts the given value of the configuration file into its Git configuration representation.
+func configFileValue(key Key, value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case bool:
+		return fmt.Sprint(typed), nil
+	case []interface{}:
+		if key != KeyPerennialBranches {
+			return "", fmt.Errorf(messages.ConfigFileInvalidValue, ConfigFileKeyName(key), ConfigFileName)
+		}
+		branches := make([]string, len(typed))
+		for b, branch := range typed {
+			name, isString := branch.(string)
+			if !isString {
+				return "", fmt.Errorf(messages.ConfigFileInvalidValue, ConfigFileKeyName(key), ConfigFileName)
+			}
+			branches[b] = name
+		}
+		return strings.Join(branches, " "), nil
+	}
+	return "", fmt.Errorf(messages.ConfigFileInvalidValue, ConfigFileKeyName(key), ConfigFileName)
+}
+
+func parseConfigFileKey(name string) *Key {
+	for _, key := range ConfigFileKeys() {
+		if ConfigFileKeyName(key) == name {
+			return &key
+		}
+	}
+	return nil
+}
+
+// sortedKeys provides the keys of the given configuration file entries in alphabetical order.
+func sortedKeys(entries map[string]interface{}) []string {
+	result := make([]string, 0, len(entries))
+	for name := range entries {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
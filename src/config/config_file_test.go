@@ -0,0 +1,100 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseConfigFile", func(t *testing.T) {
+		t.Parallel()
+		t.Run("all settings", func(t *testing.T) {
+			t.Parallel()
+			give := `
+main-branch-name: main
+perennial-branch-names: [production, qa]
+push-hook: false
+sync-strategy: rebase
+`
+			have, err := config.ParseConfigFile([]byte(give))
+			assert.NoError(t, err)
+			want := config.GitConfigCache{
+				config.KeyMainBranch:        "main",
+				config.KeyPerennialBranches: "production qa",
+				config.KeyPushHook:          "false",
+				config.KeySyncStrategy:      "rebase",
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("perennial branches as a string", func(t *testing.T) {
+			t.Parallel()
+			have, err := config.ParseConfigFile([]byte("perennial-branch-names: production qa"))
+			assert.NoError(t, err)
+			assert.Equal(t, config.GitConfigCache{config.KeyPerennialBranches: "production qa"}, have)
+		})
+		t.Run("empty file", func(t *testing.T) {
+			t.Parallel()
+			have, err := config.ParseConfigFile([]byte{})
+			assert.NoError(t, err)
+			assert.Equal(t, config.GitConfigCache{}, have)
+		})
+		t.Run("unknown setting", func(t *testing.T) {
+			t.Parallel()
+			have, err := config.ParseConfigFile([]byte("github-token: 123\nsync-strategy: rebase"))
+			assert.NoError(t, err)
+			assert.Equal(t, config.GitConfigCache{config.KeySyncStrategy: "rebase"}, have)
+		})
+		t.Run("list for a setting that isn't a list", func(t *testing.T) {
+			t.Parallel()
+			have, err := config.ParseConfigFile([]byte("sync-strategy: [merge, rebase]\npush-hook: false"))
+			assert.Error(t, err)
+			assert.Equal(t, config.GitConfigCache{config.KeyPushHook: "false"}, have)
+		})
+		t.Run("invalid YAML", func(t *testing.T) {
+			t.Parallel()
+			_, err := config.ParseConfigFile([]byte("main-branch-name: [main"))
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("RenderConfigFile", func(t *testing.T) {
+		t.Parallel()
+		t.Run("renders the given settings in a stable order", func(t *testing.T) {
+			t.Parallel()
+			give := config.GitConfigCache{
+				config.KeySyncStrategy:      "rebase",
+				config.KeyPushHook:          "true",
+				config.KeyPerennialBranches: "production qa",
+				config.KeyMainBranch:        "main",
+				config.KeyCodeHostingDriver: "",
+			}
+			have, err := config.RenderConfigFile(give)
+			assert.NoError(t, err)
+			want := `main-branch-name: main
+perennial-branch-names:
+  - production
+  - qa
+push-hook: true
+sync-strategy: rebase
+`
+			assert.Equal(t, want, string(have))
+		})
+		t.Run("round trip", func(t *testing.T) {
+			t.Parallel()
+			give := config.GitConfigCache{
+				config.KeyMainBranch:        "true",
+				config.KeyPerennialBranches: "yes",
+				config.KeyShareLineage:      "false",
+			}
+			content, err := config.RenderConfigFile(give)
+			assert.NoError(t, err)
+			have, err := config.ParseConfigFile(content)
+			assert.NoError(t, err)
+			assert.Equal(t, give, have)
+		})
+	})
+}
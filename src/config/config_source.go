@@ -0,0 +1,15 @@
+package config
+
+// ConfigSource describes where the value of a configuration setting comes from.
+type ConfigSource struct {
+	name string
+}
+
+func (s ConfigSource) String() string { return s.name }
+
+var (
+	ConfigSourceLocal   = ConfigSource{"local Git config"}  //nolint:gochecknoglobals
+	ConfigSourceGlobal  = ConfigSource{"global Git config"} //nolint:gochecknoglobals
+	ConfigSourceFile    = ConfigSource{ConfigFileName}      //nolint:gochecknoglobals
+	ConfigSourceDefault = ConfigSource{"default"}           //nolint:gochecknoglobals
+)
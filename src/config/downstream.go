@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/slice"
+)
+
+// Downstream describes into which other perennial branches the perennial branches merge their changes.
+// Example: a release branch whose hotfixes need to go into the development branch as well.
+// perennial branch --> the branches it merges into.
+type Downstream map[domain.LocalBranchName]domain.LocalBranchNames
+
+// DownstreamMerge describes merging the changes of a perennial branch into a branch downstream of it.
+type DownstreamMerge struct {
+	// the branch that receives the changes
+	Branch domain.LocalBranchName
+	// the branch that provides the changes
+	Source domain.LocalBranchName
+}
+
+// Chain provides the merges that move the changes of the given branch into all branches downstream of it,
+// in the order in which they need to happen.
+// Every branch receives changes only once, even if the configuration contains cycles.
+func (d Downstream) Chain(branch domain.LocalBranchName) []DownstreamMerge {
+	result := []DownstreamMerge{}
+	visited := domain.LocalBranchNames{branch}
+	sources := domain.LocalBranchNames{branch}
+	for len(sources) > 0 {
+		source := sources[0]
+		sources = sources[1:]
+		for _, downstream := range d[source] {
+			if slice.Contains(visited, downstream) {
+				continue
+			}
+			visited = append(visited, downstream)
+			sources = append(sources, downstream)
+			result = append(result, DownstreamMerge{Branch: downstream, Source: source})
+		}
+	}
+	return result
+}
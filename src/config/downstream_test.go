@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownstream(t *testing.T) {
+	t.Parallel()
+	main := domain.NewLocalBranchName("main")
+	develop := domain.NewLocalBranchName("develop")
+	release := domain.NewLocalBranchName("release")
+	qa := domain.NewLocalBranchName("qa")
+
+	t.Run("Chain", func(t *testing.T) {
+		t.Parallel()
+		t.Run("chain of branches", func(t *testing.T) {
+			t.Parallel()
+			downstream := config.Downstream{
+				release: domain.LocalBranchNames{develop},
+				develop: domain.LocalBranchNames{main},
+			}
+			have := downstream.Chain(release)
+			want := []config.DownstreamMerge{
+				{Branch: develop, Source: release},
+				{Branch: main, Source: develop},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("several downstream branches", func(t *testing.T) {
+			t.Parallel()
+			downstream := config.Downstream{
+				release: domain.LocalBranchNames{develop, qa},
+				develop: domain.LocalBranchNames{main},
+				qa:      domain.LocalBranchNames{main},
+			}
+			have := downstream.Chain(release)
+			want := []config.DownstreamMerge{
+				{Branch: develop, Source: release},
+				{Branch: qa, Source: release},
+				{Branch: main, Source: develop},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("cycle", func(t *testing.T) {
+			t.Parallel()
+			downstream := config.Downstream{
+				release: domain.LocalBranchNames{develop},
+				develop: domain.LocalBranchNames{release},
+			}
+			have := downstream.Chain(release)
+			want := []config.DownstreamMerge{
+				{Branch: develop, Source: release},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("no downstream branches", func(t *testing.T) {
+			t.Parallel()
+			have := config.Downstream{}.Chain(main)
+			assert.Equal(t, []config.DownstreamMerge{}, have)
+		})
+	})
+}
@@ -1,10 +1,14 @@
 package config
 
+import "github.com/git-town/git-town/v9/src/domain"
+
 // Git manages configuration data stored in Git metadata.
-// Supports configuration in the local repo and the global Git configuration.
+// Supports configuration in the local repo and the global Git configuration,
+// as well as the configuration file committed into the repo.
 type Git struct {
 	runner
 	config GitConfig
+	file   GitConfigCache // the values in the configuration file of the repo
 }
 
 type runner interface {
@@ -16,10 +20,22 @@ type runner interface {
 func NewGit(gitConfig GitConfig, runner runner) Git {
 	return Git{
 		config: gitConfig,
+		file:   GitConfigCache{},
 		runner: runner,
 	}
 }
 
+// AssumeLocalConfigValue makes this instance provide the given value for the given key
+// until the configuration gets reloaded, without storing it in the Git configuration.
+func (g *Git) AssumeLocalConfigValue(key Key, value string) {
+	g.config.Local[key] = value
+}
+
+// ConfigFileValue provides the configuration value with the given key from the configuration file of the repo.
+func (g Git) ConfigFileValue(key Key) string {
+	return g.file[key]
+}
+
 func (g Git) GlobalConfigClone() GitConfigCache {
 	return g.config.Global.Clone()
 }
@@ -40,14 +56,34 @@ func (g Git) LocalConfigValue(key Key) string {
 	return g.config.Local[key]
 }
 
-// LocalOrGlobalConfigValue provides the configuration value with the given key from the local and global Git configuration.
-// Local configuration takes precedence.
+// LoadConfigFile loads the configuration file in the given root directory of the repo.
+func (g *Git) LoadConfigFile(rootDir domain.RepoRootDir) error {
+	file, err := LoadConfigFile(rootDir)
+	g.file = file
+	return err
+}
+
+// LocalOrGlobalConfigValue provides the configuration value with the given key from the local and global Git configuration
+// and the configuration file of the repo.
+// Local configuration takes precedence over global configuration, which takes precedence over the configuration file.
 func (g Git) LocalOrGlobalConfigValue(key Key) string {
-	local := g.LocalConfigValue(key)
-	if local != "" {
-		return local
+	value, _ := g.LocalOrGlobalConfigValueAndSource(key)
+	return value
+}
+
+// LocalOrGlobalConfigValueAndSource provides the configuration value with the given key
+// together with where this value is defined.
+func (g Git) LocalOrGlobalConfigValueAndSource(key Key) (string, ConfigSource) {
+	if local := g.LocalConfigValue(key); local != "" {
+		return local, ConfigSourceLocal
+	}
+	if global := g.GlobalConfigValue(key); global != "" {
+		return global, ConfigSourceGlobal
+	}
+	if file := g.ConfigFileValue(key); file != "" {
+		return file, ConfigSourceFile
 	}
-	return g.GlobalConfigValue(key)
+	return "", ConfigSourceDefault
 }
 
 // Reload refreshes the cached configuration information.
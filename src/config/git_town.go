@@ -19,30 +19,89 @@ import (
 type GitTown struct {
 	Git
 	originURLCache OriginURLCache
+	// the compiled perennial regex and the text it was compiled from,
+	// so that determining branch types doesn't compile it again
+	perennialRegex     domain.PerennialRegex
+	perennialRegexText string
 }
 
 func NewGitTown(gitConfig GitConfig, runner runner) *GitTown {
 	return &GitTown{
-		Git:            NewGit(gitConfig, runner),
-		originURLCache: OriginURLCache{},
+		Git:                NewGit(gitConfig, runner),
+		originURLCache:     OriginURLCache{},
+		perennialRegex:     domain.PerennialRegex{},
+		perennialRegexText: "",
 	}
 }
 
 type OriginURLCache map[string]*giturl.Parts
 
+// AddToObservedBranches registers the given branch names as observed branches.
+func (gt *GitTown) AddToObservedBranches(branches ...domain.LocalBranchName) error {
+	return gt.SetObservedBranches(append(gt.ObservedBranches(), branches...))
+}
+
 // AddToPerennialBranches registers the given branch names as perennial branches.
 // The branches must exist.
 func (gt *GitTown) AddToPerennialBranches(branches ...domain.LocalBranchName) error {
 	return gt.SetPerennialBranches(append(gt.PerennialBranches(), branches...))
 }
 
+// AssumeParent makes this instance provide the given parent for the given branch
+// until the configuration gets reloaded, without storing it in the Git configuration.
+func (gt *GitTown) AssumeParent(branch, parentBranch domain.LocalBranchName) {
+	gt.AssumeLocalConfigValue(NewParentKey(branch), parentBranch.String())
+}
+
+// BranchSyncStrategies provides the sync strategies that individual branches use instead of the general sync strategy.
+func (gt *GitTown) BranchSyncStrategies() (BranchSyncStrategies, error) {
+	result := BranchSyncStrategies{}
+	for _, key := range gt.LocalConfigKeysMatching(`^git-town-branch\..*\.sync-strategy$`) {
+		branch := domain.NewLocalBranchName(strings.TrimSuffix(strings.TrimPrefix(key.Name, "git-town-branch."), ".sync-strategy"))
+		strategy, err := ToSyncStrategy(gt.LocalConfigValue(key))
+		if err != nil {
+			return result, err
+		}
+		result[branch] = strategy
+	}
+	return result, nil
+}
+
+// BranchSyncStrategy provides the sync strategy that applies to the given branch.
+func (gt *GitTown) BranchSyncStrategy(branch domain.LocalBranchName) (SyncStrategy, error) {
+	text := gt.LocalConfigValue(NewSyncStrategyKey(branch))
+	if text == "" {
+		return gt.SyncStrategy()
+	}
+	return ToSyncStrategy(text)
+}
+
+// BranchTypes provides the types of the branches in this repo.
+// This ignores an invalid perennial regex since OpenRepo verifies it.
 func (gt *GitTown) BranchTypes() domain.BranchTypes {
+	perennialRegex, _ := gt.PerennialRegex()
 	return domain.BranchTypes{
 		MainBranch:        gt.MainBranch(),
+		ObservedBranches:  gt.ObservedBranches(),
 		PerennialBranches: gt.PerennialBranches(),
+		PerennialRegex:    perennialRegex,
+		PrototypeBranches: gt.PrototypeBranches(),
 	}
 }
 
+// Downstream provides into which other perennial branches the perennial branches merge their changes.
+func (gt *GitTown) Downstream() Downstream {
+	result := Downstream{}
+	for _, key := range gt.LocalConfigKeysMatching(`^git-town-branch\..*\.downstream$`) {
+		branch := domain.NewLocalBranchName(strings.TrimSuffix(strings.TrimPrefix(key.Name, "git-town-branch."), ".downstream"))
+		downstream := strings.Fields(gt.LocalConfigValue(key))
+		if len(downstream) > 0 {
+			result[branch] = domain.NewLocalBranchNames(downstream...)
+		}
+	}
+	return result
+}
+
 func (gt *GitTown) DeprecatedNewBranchPushFlagGlobal() string {
 	return gt.config.Global[KeyDeprecatedNewBranchPushFlag]
 }
@@ -82,7 +141,7 @@ func (gt *GitTown) GiteaToken() string {
 // HasBranchInformation indicates whether this configuration contains any branch hierarchy entries.
 func (gt *GitTown) HasBranchInformation() bool {
 	for key := range gt.config.Local {
-		if strings.HasPrefix(key.Name, "git-town-branch.") {
+		if strings.HasPrefix(key.Name, "git-town-branch.") && strings.HasSuffix(key.Name, ".parent") {
 			return true
 		}
 	}
@@ -106,6 +165,19 @@ func (gt *GitTown) IsMainBranch(branch domain.LocalBranchName) bool {
 	return branch == gt.MainBranch()
 }
 
+// IsCommandLogEnabled indicates whether Git Town should record the shell commands it runs in the command log.
+func (gt *GitTown) IsCommandLogEnabled() (bool, error) {
+	text := gt.LocalOrGlobalConfigValue(KeyCommandLog)
+	if text == "" {
+		return false, nil
+	}
+	result, err := ParseBool(text)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, KeyCommandLog, text)
+	}
+	return result, nil
+}
+
 // IsOffline indicates whether Git Town is currently in offline mode.
 func (gt *GitTown) IsOffline() (bool, error) {
 	config := gt.GlobalConfigValue(KeyOffline)
@@ -139,9 +211,18 @@ func (gt *GitTown) MainBranch() domain.LocalBranchName {
 	return domain.NewLocalBranchName(mainBranch)
 }
 
+// ObservedBranches provides the branches of other people that this repository only pulls from.
+func (gt *GitTown) ObservedBranches() domain.LocalBranchNames {
+	return domain.NewLocalBranchNames(strings.Fields(gt.LocalConfigValue(KeyObservedBranches))...)
+}
+
 // OriginOverride provides the override for the origin hostname from the Git Town configuration.
 func (gt *GitTown) OriginOverride() string {
-	return gt.LocalConfigValue(KeyCodeHostingOriginHostname)
+	override := gt.LocalConfigValue(KeyCodeHostingOriginHostname)
+	if override != "" {
+		return override
+	}
+	return gt.ConfigFileValue(KeyCodeHostingOriginHostname)
 }
 
 // OriginURLString provides the URL for the "origin" remote.
@@ -179,6 +260,15 @@ func DetermineOriginURL(originURL, originOverride string, originURLCache OriginU
 	return url
 }
 
+// ParentSHA provides the commit of the parent branch that the given branch was last synced with.
+func (gt *GitTown) ParentSHA(branch domain.LocalBranchName) domain.SHA {
+	sha := gt.LocalConfigValue(NewParentSHAKey(branch))
+	if sha == "" {
+		return domain.SHA{}
+	}
+	return domain.NewSHA(sha)
+}
+
 // PerennialBranches returns all branches that are marked as perennial.
 func (gt *GitTown) PerennialBranches() domain.LocalBranchNames {
 	result := gt.LocalOrGlobalConfigValue(KeyPerennialBranches)
@@ -188,6 +278,35 @@ func (gt *GitTown) PerennialBranches() domain.LocalBranchNames {
 	return domain.NewLocalBranchNames(strings.Split(result, " ")...)
 }
 
+// PerennialRegex provides the regular expression that matches the names of additional perennial branches.
+// It compiles the regular expression only when its configured text changes.
+func (gt *GitTown) PerennialRegex() (domain.PerennialRegex, error) {
+	text := gt.LocalOrGlobalConfigValue(KeyPerennialRegex)
+	if text == gt.perennialRegexText {
+		return gt.perennialRegex, nil
+	}
+	regex, err := domain.NewPerennialRegex(text)
+	if err != nil {
+		return regex, fmt.Errorf(messages.PerennialRegexInvalid, text, err)
+	}
+	gt.perennialRegex = regex
+	gt.perennialRegexText = text
+	return regex, nil
+}
+
+// PrototypeBranches provides the branches that Git Town keeps local and never pushes.
+func (gt *GitTown) PrototypeBranches() domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	for _, key := range gt.LocalConfigKeysMatching(`^git-town-branch\..*\.prototype$`) {
+		isPrototype, err := ParseBool(gt.LocalConfigValue(key))
+		if err == nil && isPrototype {
+			result = append(result, domain.NewLocalBranchName(strings.TrimSuffix(strings.TrimPrefix(key.Name, "git-town-branch."), ".prototype")))
+		}
+	}
+	result.Sort()
+	return result
+}
+
 // PullBranchStrategy provides the currently configured pull branch strategy.
 func (gt *GitTown) PullBranchStrategy() (PullBranchStrategy, error) {
 	text := gt.LocalOrGlobalConfigValue(KeyPullBranchStrategy)
@@ -228,6 +347,19 @@ func (gt *GitTown) PushHookGlobal() (bool, error) {
 	return result, nil
 }
 
+// RemoveBranchSyncStrategy makes the given branch use the general sync strategy again.
+func (gt *GitTown) RemoveBranchSyncStrategy(branch domain.LocalBranchName) error {
+	if gt.LocalConfigValue(NewSyncStrategyKey(branch)) == "" {
+		return nil
+	}
+	return gt.RemoveLocalConfigValue(NewSyncStrategyKey(branch))
+}
+
+// RemoveFromObservedBranches removes the given branch as an observed branch.
+func (gt *GitTown) RemoveFromObservedBranches(branch domain.LocalBranchName) error {
+	return gt.SetObservedBranches(slice.Remove(gt.ObservedBranches(), branch))
+}
+
 // RemoveFromPerennialBranches removes the given branch as a perennial branch.
 func (gt *GitTown) RemoveFromPerennialBranches(branch domain.LocalBranchName) error {
 	return gt.SetPerennialBranches(slice.Remove(gt.PerennialBranches(), branch))
@@ -258,14 +390,50 @@ func (gt *GitTown) RemoveMainBranchConfiguration() error {
 // RemoveParent removes the parent branch entry for the given branch
 // from the Git configuration.
 func (gt *GitTown) RemoveParent(branch domain.LocalBranchName) error {
+	err := gt.RemoveParentSHA(branch)
+	if err != nil {
+		return err
+	}
 	return gt.RemoveLocalConfigValue(NewParentKey(branch))
 }
 
+// RemoveParentSHA removes the commit of the parent branch that the given branch was last synced with
+// from the Git configuration.
+func (gt *GitTown) RemoveParentSHA(branch domain.LocalBranchName) error {
+	if gt.ParentSHA(branch).IsEmpty() {
+		return nil
+	}
+	return gt.RemoveLocalConfigValue(NewParentSHAKey(branch))
+}
+
 // RemovePerennialBranchConfiguration removes the configuration entry for the perennial branches.
 func (gt *GitTown) RemovePerennialBranchConfiguration() error {
 	return gt.RemoveLocalConfigValue(KeyPerennialBranches)
 }
 
+// RemoveShipDeleteRemoteBranch removes whether shipping into the given branch deletes the remote branch of the shipped branch,
+// so that shipping into the given branch follows the general "ship-delete-remote-branch" setting again.
+func (gt *GitTown) RemoveShipDeleteRemoteBranch(target domain.LocalBranchName) error {
+	if gt.LocalConfigValue(NewShipDeleteRemoteBranchKey(target)) == "" {
+		return nil
+	}
+	return gt.RemoveLocalConfigValue(NewShipDeleteRemoteBranchKey(target))
+}
+
+// RemoveShipStrategy removes how to ship feature branches into the given branch,
+// so that shipping into the given branch squash-merges again.
+func (gt *GitTown) RemoveShipStrategy(target domain.LocalBranchName) error {
+	if gt.LocalConfigValue(NewShipStrategyKey(target)) == "" {
+		return nil
+	}
+	return gt.RemoveLocalConfigValue(NewShipStrategyKey(target))
+}
+
+// SetBranchSyncStrategy makes the given branch use the given sync strategy instead of the general sync strategy.
+func (gt *GitTown) SetBranchSyncStrategy(branch domain.LocalBranchName, value SyncStrategy) error {
+	return gt.SetLocalConfigValue(NewSyncStrategyKey(branch), value.name)
+}
+
 // SetCodeHostingDriver sets the "github.code-hosting-driver" setting.
 func (gt *GitTown) SetCodeHostingDriver(value string) error {
 	gt.config.Local[KeyCodeHostingDriver] = value
@@ -286,6 +454,17 @@ func (gt *GitTown) SetColorUI(value string) error {
 	return err
 }
 
+// SetDownstream updates the branches that the given perennial branch merges its changes into.
+func (gt *GitTown) SetDownstream(branch domain.LocalBranchName, downstream domain.LocalBranchNames) error {
+	if len(downstream) == 0 {
+		if gt.LocalConfigValue(NewDownstreamKey(branch)) == "" {
+			return nil
+		}
+		return gt.RemoveLocalConfigValue(NewDownstreamKey(branch))
+	}
+	return gt.SetLocalConfigValue(NewDownstreamKey(branch), downstream.Join(" "))
+}
+
 // SetMainBranch marks the given branch as the main branch
 // in the Git Town configuration.
 func (gt *GitTown) SetMainBranch(branch domain.LocalBranchName) error {
@@ -305,6 +484,17 @@ func (gt *GitTown) SetNewBranchPush(value bool, global bool) error {
 	return err
 }
 
+// SetObservedBranches marks the given branches as observed branches.
+func (gt *GitTown) SetObservedBranches(branches domain.LocalBranchNames) error {
+	if len(branches) == 0 {
+		if gt.LocalConfigValue(KeyObservedBranches) == "" {
+			return nil
+		}
+		return gt.RemoveLocalConfigValue(KeyObservedBranches)
+	}
+	return gt.SetLocalConfigValue(KeyObservedBranches, branches.Join(" "))
+}
+
 // SetOffline updates whether Git Town is in offline mode.
 func (gt *GitTown) SetOffline(value bool) error {
 	err := gt.SetGlobalConfigValue(KeyOffline, strconv.FormatBool(value))
@@ -318,12 +508,28 @@ func (gt *GitTown) SetParent(branch, parentBranch domain.LocalBranchName) error
 	return err
 }
 
+// SetParentSHA registers the given commit of the parent branch as the one that the given branch was last synced with.
+func (gt *GitTown) SetParentSHA(branch domain.LocalBranchName, sha domain.SHA) error {
+	return gt.SetLocalConfigValue(NewParentSHAKey(branch), sha.String())
+}
+
 // SetPerennialBranches marks the given branches as perennial branches.
 func (gt *GitTown) SetPerennialBranches(branches domain.LocalBranchNames) error {
 	err := gt.SetLocalConfigValue(KeyPerennialBranches, branches.Join(" "))
 	return err
 }
 
+// SetPerennialRegex updates the regular expression that matches the names of additional perennial branches.
+func (gt *GitTown) SetPerennialRegex(regex domain.PerennialRegex) error {
+	if regex.IsEmpty() {
+		if gt.LocalConfigValue(KeyPerennialRegex) == "" {
+			return nil
+		}
+		return gt.RemoveLocalConfigValue(KeyPerennialRegex)
+	}
+	return gt.SetLocalConfigValue(KeyPerennialRegex, regex.String())
+}
+
 // SetPullBranchStrategy updates the configured pull branch strategy.
 func (gt *GitTown) SetPullBranchStrategy(strategy PullBranchStrategy) error {
 	err := gt.SetLocalConfigValue(KeyPullBranchStrategy, strategy.String())
@@ -348,6 +554,16 @@ func (gt *GitTown) SetShouldShipDeleteRemoteBranch(value bool) error {
 	return err
 }
 
+// SetShipDeleteRemoteBranch defines whether shipping into the given branch deletes the remote branch of the shipped branch.
+func (gt *GitTown) SetShipDeleteRemoteBranch(target domain.LocalBranchName, value bool) error {
+	return gt.SetLocalConfigValue(NewShipDeleteRemoteBranchKey(target), strconv.FormatBool(value))
+}
+
+// SetShipStrategy defines how to ship feature branches into the given branch.
+func (gt *GitTown) SetShipStrategy(target domain.LocalBranchName, value ShipStrategy) error {
+	return gt.SetLocalConfigValue(NewShipStrategyKey(target), value.name)
+}
+
 // SetShouldSyncUpstream updates the configured pull branch strategy.
 func (gt *GitTown) SetShouldSyncUpstream(value bool) error {
 	err := gt.SetLocalConfigValue(KeySyncUpstream, strconv.FormatBool(value))
@@ -402,6 +618,71 @@ func (gt *GitTown) ShouldNewBranchPushGlobal() (bool, error) {
 	return ParseBool(config)
 }
 
+// ShouldShareLineage indicates whether Git Town should share the branch lineage with the origin repository.
+func (gt *GitTown) ShouldShareLineage() (bool, error) {
+	text := gt.LocalOrGlobalConfigValue(KeyShareLineage)
+	if text == "" {
+		return false, nil
+	}
+	result, err := ParseBool(text)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, KeyShareLineage, text)
+	}
+	return result, nil
+}
+
+// ShipMessageTemplate provides the Go template for the commit messages of shipped branches, empty if none is configured.
+func (gt *GitTown) ShipMessageTemplate() string {
+	return gt.LocalOrGlobalConfigValue(KeyShipMessageTemplate)
+}
+
+// ShipStrategy provides how to ship feature branches into the given branch.
+func (gt *GitTown) ShipStrategy(target domain.LocalBranchName) (ShipStrategy, error) {
+	return ToShipStrategy(gt.LocalConfigValue(NewShipStrategyKey(target)))
+}
+
+// ShipTargets provides the branches that have their own settings for shipping feature branches into them,
+// sorted alphabetically.
+func (gt *GitTown) ShipTargets() domain.LocalBranchNames {
+	result := domain.LocalBranchNames{}
+	for _, key := range gt.LocalConfigKeysMatching(`^git-town-branch\..*\.(ship-strategy|ship-delete-remote-branch)$`) {
+		name := strings.TrimPrefix(key.Name, "git-town-branch.")
+		name = strings.TrimSuffix(strings.TrimSuffix(name, ".ship-strategy"), ".ship-delete-remote-branch")
+		result = slice.AppendAllMissing(result, domain.LocalBranchNames{domain.NewLocalBranchName(name)})
+	}
+	result.Sort()
+	return result
+}
+
+// ShouldShipChangelogTrailers indicates whether the commits that ship branches
+// record the shipped branch and its proposal in commit trailers for "git town changelog".
+func (gt *GitTown) ShouldShipChangelogTrailers() (bool, error) {
+	text := gt.LocalOrGlobalConfigValue(KeyShipChangelogTrailers)
+	if text == "" {
+		return true, nil
+	}
+	result, err := ParseBool(text)
+	if err != nil {
+		return true, fmt.Errorf(messages.ValueInvalid, KeyShipChangelogTrailers, text)
+	}
+	return result, nil
+}
+
+// ShouldShipDeleteOriginBranchInto indicates whether to delete the remote branch after shipping it into the given branch.
+// Branches that don't configure this themselves use the general "ship-delete-remote-branch" setting.
+func (gt *GitTown) ShouldShipDeleteOriginBranchInto(target domain.LocalBranchName) (bool, error) {
+	key := NewShipDeleteRemoteBranchKey(target)
+	setting := gt.LocalConfigValue(key)
+	if setting == "" {
+		return gt.ShouldShipDeleteOriginBranch()
+	}
+	result, err := ParseBool(setting)
+	if err != nil {
+		return true, fmt.Errorf(messages.ValueInvalid, key, setting)
+	}
+	return result, nil
+}
+
 // ShouldShipDeleteOriginBranch indicates whether to delete the remote branch after shipping.
 func (gt *GitTown) ShouldShipDeleteOriginBranch() (bool, error) {
 	setting := gt.LocalOrGlobalConfigValue(KeyShipDeleteRemoteBranch)
@@ -415,6 +696,20 @@ func (gt *GitTown) ShouldShipDeleteOriginBranch() (bool, error) {
 	return result, nil
 }
 
+// ShouldSyncUpdateRefs indicates whether syncing a stack of feature branches with the rebase sync strategy
+// should rebase only the last branch of the stack and let "git rebase --update-refs" move the other branches.
+func (gt *GitTown) ShouldSyncUpdateRefs() (bool, error) {
+	text := gt.LocalOrGlobalConfigValue(KeySyncUpdateRefs)
+	if text == "" {
+		return false, nil
+	}
+	result, err := ParseBool(text)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, KeySyncUpdateRefs, text)
+	}
+	return result, nil
+}
+
 // ShouldSyncUpstream indicates whether this repo should sync with its upstream.
 func (gt *GitTown) ShouldSyncUpstream() (bool, error) {
 	text := gt.LocalOrGlobalConfigValue(KeySyncUpstream)
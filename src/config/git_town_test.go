@@ -127,6 +127,37 @@ func TestGitTown(t *testing.T) {
 		}
 	})
 
+	t.Run("PerennialRegex", func(t *testing.T) {
+		t.Parallel()
+		repo := testruntime.CreateGitTown(t)
+		have, err := repo.Config.PerennialRegex()
+		assert.NoError(t, err)
+		assert.True(t, have.IsEmpty())
+		release, err := domain.NewPerennialRegex("^release/")
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Config.SetPerennialRegex(release))
+		have, err = repo.Config.PerennialRegex()
+		assert.NoError(t, err)
+		assert.Equal(t, "^release/", have.String())
+		assert.NoError(t, repo.Config.SetPerennialRegex(domain.PerennialRegex{}))
+		have, err = repo.Config.PerennialRegex()
+		assert.NoError(t, err)
+		assert.True(t, have.IsEmpty())
+	})
+
+	t.Run("ShipTargets", func(t *testing.T) {
+		t.Parallel()
+		repo := testruntime.CreateGitTown(t)
+		assert.Equal(t, domain.LocalBranchNames{}, repo.Config.ShipTargets())
+		release := domain.NewLocalBranchName("release")
+		develop := domain.NewLocalBranchName("develop")
+		assert.NoError(t, repo.Config.SetShipStrategy(release, config.ShipStrategyMerge))
+		assert.NoError(t, repo.Config.SetShipDeleteRemoteBranch(release, false))
+		assert.NoError(t, repo.Config.SetShipDeleteRemoteBranch(develop, false))
+		repo.Config.Reload()
+		assert.Equal(t, domain.LocalBranchNames{develop, release}, repo.Config.ShipTargets())
+	})
+
 	t.Run("SetOffline", func(t *testing.T) {
 		t.Parallel()
 		repo := testruntime.CreateGitTown(t)
@@ -27,6 +27,7 @@ var (
 	KeyAliasShip                   = Key{"alias." + AliasShip.name}               //nolint:gochecknoglobals
 	KeyAliasSync                   = Key{"alias." + AliasSync.name}               //nolint:gochecknoglobals
 	KeyCodeHostingDriver           = Key{"git-town.code-hosting-driver"}          //nolint:gochecknoglobals
+	KeyCommandLog                  = Key{"git-town.command-log"}                  //nolint:gochecknoglobals
 	KeyCodeHostingOriginHostname   = Key{"git-town.code-hosting-origin-hostname"} //nolint:gochecknoglobals
 	KeyDeprecatedNewBranchPushFlag = Key{"git-town.new-branch-push-flag"}         //nolint:gochecknoglobals
 	KeyDeprecatedPushVerify        = Key{"git-town.push-verify"}                  //nolint:gochecknoglobals
@@ -34,12 +35,18 @@ var (
 	KeyGithubToken                 = Key{"git-town.github-token"}                 //nolint:gochecknoglobals
 	KeyGitlabToken                 = Key{"git-town.gitlab-token"}                 //nolint:gochecknoglobals
 	KeyMainBranch                  = Key{"git-town.main-branch-name"}             //nolint:gochecknoglobals
+	KeyObservedBranches            = Key{"git-town.observed-branches"}            //nolint:gochecknoglobals
 	KeyOffline                     = Key{"git-town.offline"}                      //nolint:gochecknoglobals
 	KeyPerennialBranches           = Key{"git-town.perennial-branch-names"}       //nolint:gochecknoglobals
+	KeyPerennialRegex              = Key{"git-town.perennial-regex"}              //nolint:gochecknoglobals
 	KeyPullBranchStrategy          = Key{"git-town.pull-branch-strategy"}         //nolint:gochecknoglobals
 	KeyPushHook                    = Key{"git-town.push-hook"}                    //nolint:gochecknoglobals
 	KeyPushNewBranches             = Key{"git-town.push-new-branches"}            //nolint:gochecknoglobals
+	KeyShareLineage                = Key{"git-town.share-lineage"}                //nolint:gochecknoglobals
+	KeyShipChangelogTrailers       = Key{"git-town.ship-changelog-trailers"}      //nolint:gochecknoglobals
 	KeyShipDeleteRemoteBranch      = Key{"git-town.ship-delete-remote-branch"}    //nolint:gochecknoglobals
+	KeyShipMessageTemplate         = Key{"git-town.ship-message-template"}        //nolint:gochecknoglobals
+	KeySyncUpdateRefs              = Key{"git-town.sync-update-refs"}             //nolint:gochecknoglobals
 	KeySyncUpstream                = Key{"git-town.sync-upstream"}                //nolint:gochecknoglobals
 	KeySyncStrategy                = Key{"git-town.sync-strategy"}                //nolint:gochecknoglobals
 	KeyTestingRemoteURL            = Key{"git-town.testing.remote-url"}           //nolint:gochecknoglobals
@@ -48,18 +55,25 @@ var (
 var keys = []Key{ //nolint:gochecknoglobals
 	KeyCodeHostingDriver,
 	KeyCodeHostingOriginHostname,
+	KeyCommandLog,
 	KeyDeprecatedNewBranchPushFlag,
 	KeyDeprecatedPushVerify,
 	KeyGiteaToken,
 	KeyGithubToken,
 	KeyGitlabToken,
 	KeyMainBranch,
+	KeyObservedBranches,
 	KeyOffline,
 	KeyPerennialBranches,
+	KeyPerennialRegex,
 	KeyPullBranchStrategy,
 	KeyPushHook,
 	KeyPushNewBranches,
+	KeyShareLineage,
+	KeyShipChangelogTrailers,
 	KeyShipDeleteRemoteBranch,
+	KeyShipMessageTemplate,
+	KeySyncUpdateRefs,
 	KeySyncUpstream,
 	KeySyncStrategy,
 	KeyTestingRemoteURL,
@@ -92,7 +106,10 @@ func parseAliasKey(key string) *Key {
 }
 
 func parseLineageKey(key string) *Key {
-	if !strings.HasPrefix(key, "git-town-branch.") || !strings.HasSuffix(key, ".parent") {
+	if !strings.HasPrefix(key, "git-town-branch.") {
+		return nil
+	}
+	if !strings.HasSuffix(key, ".parent") && !strings.HasSuffix(key, ".parent-sha") && !strings.HasSuffix(key, ".downstream") && !strings.HasSuffix(key, ".prototype") && !strings.HasSuffix(key, ".sync-strategy") && !strings.HasSuffix(key, ".ship-strategy") && !strings.HasSuffix(key, ".ship-delete-remote-branch") {
 		return nil
 	}
 	return &Key{
@@ -128,8 +145,50 @@ func NewAliasKey(aliasType Alias) Key {
 	panic(fmt.Sprintf("don't know how to convert alias type %q into a config key", aliasType))
 }
 
+// NewDownstreamKey provides the key that stores the branches that the given perennial branch merges its changes into.
+func NewDownstreamKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.downstream", branch),
+	}
+}
+
 func NewParentKey(branch domain.LocalBranchName) Key {
 	return Key{
 		Name: fmt.Sprintf("git-town-branch.%s.parent", branch),
 	}
 }
+
+// NewParentSHAKey provides the key that stores the commit of the parent branch that the given branch is based on.
+func NewParentSHAKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.parent-sha", branch),
+	}
+}
+
+// NewPrototypeKey provides the key that marks the given branch as a prototype branch.
+func NewPrototypeKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.prototype", branch),
+	}
+}
+
+// NewShipDeleteRemoteBranchKey provides the key that stores whether shipping into the given branch deletes the remote branch of the shipped branch.
+func NewShipDeleteRemoteBranchKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.ship-delete-remote-branch", branch),
+	}
+}
+
+// NewShipStrategyKey provides the key that stores how to ship feature branches into the given branch.
+func NewShipStrategyKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.ship-strategy", branch),
+	}
+}
+
+// NewSyncStrategyKey provides the key that stores the sync strategy that the given branch uses instead of the general sync strategy.
+func NewSyncStrategyKey(branch domain.LocalBranchName) Key {
+	return Key{
+		Name: fmt.Sprintf("git-town-branch.%s.sync-strategy", branch),
+	}
+}
@@ -27,6 +27,48 @@ func TestKey(t *testing.T) {
 				want := &config.Key{give}
 				assert.Equal(t, want, have)
 			})
+			t.Run("parent SHA key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.parent-sha"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
+			t.Run("downstream key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.release.downstream"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
+			t.Run("prototype key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.prototype"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
+			t.Run("ship delete remote branch key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.release.ship-delete-remote-branch"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
+			t.Run("ship strategy key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.release.ship-strategy"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
+			t.Run("sync strategy key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.sync-strategy"
+				have := config.ParseKey(give)
+				want := &config.Key{give}
+				assert.Equal(t, want, have)
+			})
 			t.Run("lineage key without suffix", func(t *testing.T) {
 				t.Parallel()
 				have := config.ParseKey("git-town-branch.branch-1")
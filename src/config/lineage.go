@@ -31,12 +31,13 @@ func (l Lineage) BranchesAndAncestors(branchNames domain.LocalBranchNames) domai
 }
 
 // Ancestors provides the names of all parent branches of the branch with the given name.
+// Stops at the first branch that appears twice if the lineage contains a cycle.
 func (l Lineage) Ancestors(branch domain.LocalBranchName) domain.LocalBranchNames {
 	current := branch
 	result := domain.LocalBranchNames{}
 	for {
 		parent, found := l[current]
-		if !found {
+		if !found || parent == branch || slice.Contains(result, parent) {
 			return result
 		}
 		result = append(domain.LocalBranchNames{parent}, result...)
@@ -63,6 +64,26 @@ func (l Lineage) Children(branch domain.LocalBranchName) domain.LocalBranchNames
 	return result
 }
 
+// Cycles provides all cycles in this lineage.
+// Each cycle contains its branches sorted alphabetically.
+func (l Lineage) Cycles() []domain.LocalBranchNames {
+	result := []domain.LocalBranchNames{}
+	for _, branch := range l.BranchNames() {
+		if !l.IsAncestor(branch, branch) {
+			continue
+		}
+		cycle := domain.LocalBranchNames{branch}
+		for current := l[branch]; current != branch; current = l[current] {
+			cycle = append(cycle, current)
+		}
+		cycle.Sort()
+		if cycle[0] == branch {
+			result = append(result, cycle)
+		}
+	}
+	return result
+}
+
 // HasParents returns whether or not the given branch has at least one parent.
 func (l Lineage) HasParents(branch domain.LocalBranchName) bool {
 	for child := range l {
@@ -76,14 +97,16 @@ func (l Lineage) HasParents(branch domain.LocalBranchName) bool {
 // IsAncestor indicates whether the given branch is an ancestor of the other given branch.
 func (l Lineage) IsAncestor(ancestor, other domain.LocalBranchName) bool {
 	current := other
+	visited := map[domain.LocalBranchName]bool{}
 	for {
 		parent, found := l[current]
-		if !found {
+		if !found || visited[parent] {
 			return false
 		}
 		if parent == ancestor {
 			return true
 		}
+		visited[parent] = true
 		current = parent
 	}
 }
@@ -66,6 +66,16 @@ func TestLineage(t *testing.T) {
 			want := domain.LocalBranchNames{}
 			assert.Equal(t, want, have)
 		})
+		t.Run("cycle", func(t *testing.T) {
+			t.Parallel()
+			lineage := config.Lineage{}
+			lineage[three] = two
+			lineage[two] = one
+			lineage[one] = two
+			have := lineage.Ancestors(three)
+			want := domain.LocalBranchNames{one, two}
+			assert.Equal(t, want, have)
+		})
 	})
 
 	t.Run("BranchNames", func(t *testing.T) {
@@ -79,6 +89,40 @@ func TestLineage(t *testing.T) {
 		assert.Equal(t, want, have)
 	})
 
+	t.Run("Cycles", func(t *testing.T) {
+		t.Parallel()
+		t.Run("provides each cycle once", func(t *testing.T) {
+			t.Parallel()
+			lineage := config.Lineage{}
+			lineage[one] = three
+			lineage[two] = one
+			lineage[three] = two
+			lineage[main] = main
+			have := lineage.Cycles()
+			want := []domain.LocalBranchNames{{main}, {one, three, two}}
+			assert.Equal(t, want, have)
+		})
+		t.Run("ignores branches leading into a cycle", func(t *testing.T) {
+			t.Parallel()
+			lineage := config.Lineage{}
+			lineage[three] = two
+			lineage[two] = one
+			lineage[one] = two
+			have := lineage.Cycles()
+			want := []domain.LocalBranchNames{{one, two}}
+			assert.Equal(t, want, have)
+		})
+		t.Run("no cycles", func(t *testing.T) {
+			t.Parallel()
+			lineage := config.Lineage{}
+			lineage[two] = one
+			lineage[one] = main
+			have := lineage.Cycles()
+			want := []domain.LocalBranchNames{}
+			assert.Equal(t, want, have)
+		})
+	})
+
 	t.Run("Children", func(t *testing.T) {
 		t.Parallel()
 		t.Run("provides all children of the given branch, ordered alphabetically", func(t *testing.T) {
@@ -148,6 +192,13 @@ func TestLineage(t *testing.T) {
 			lineage[three] = one
 			assert.False(t, lineage.IsAncestor(two, three))
 		})
+		t.Run("cycle", func(t *testing.T) {
+			t.Parallel()
+			lineage := config.Lineage{}
+			lineage[two] = one
+			lineage[one] = two
+			assert.False(t, lineage.IsAncestor(three, two))
+		})
 	})
 
 	t.Run("OrderedHierarchically", func(t *testing.T) {
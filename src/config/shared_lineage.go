@@ -0,0 +1,106 @@
+package config
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/slice"
+)
+
+const (
+	// BranchesRefspec fetches the branches from the origin repository, like the default refspec of cloned repositories.
+	BranchesRefspec = "+refs/heads/*:refs/remotes/origin/*"
+	// SharedLineageRef is the Git ref that stores the lineage shared with the origin repository.
+	// It also records the shared lineage that Git Town last merged into the local lineage.
+	SharedLineageRef = "refs/git-town/lineage"
+	// SharedLineageTrackingRef is the Git ref that stores the shared lineage fetched from the origin repository.
+	SharedLineageTrackingRef = "refs/git-town/remotes/origin/lineage"
+	// SharedLineageRefspec fetches the shared lineage from the origin repository.
+	SharedLineageRefspec = "+refs/git-town/*:refs/git-town/remotes/origin/*"
+)
+
+// ParseSharedLineage provides the lineage stored in the given content of the shared lineage file.
+// Each line of this file contains the name of a branch and the name of its parent branch, separated by a space.
+func ParseSharedLineage(content string) Lineage {
+	result := Lineage{}
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			continue
+		}
+		result[domain.NewLocalBranchName(parts[0])] = domain.NewLocalBranchName(parts[1])
+	}
+	return result
+}
+
+// SharedLineageContent provides the content of the shared lineage file for the given lineage.
+func SharedLineageContent(lineage Lineage) string {
+	lines := make([]string, 0, len(lineage))
+	for child, parent := range lineage {
+		lines = append(lines, child.String()+" "+parent.String()+"\n")
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "")
+}
+
+// MergeSharedLineage determines how to update the local lineage with the lineage shared through the origin repository.
+// The base lineage is the shared lineage that was merged last time.
+// It provides the parent branches to set locally and the branches whose parent was changed both locally and in the shared lineage.
+// The local parent wins such conflicts and gets shared with the next push.
+func MergeSharedLineage(args MergeSharedLineageArgs) (Lineage, []SharedLineageConflict) {
+	updates := Lineage{}
+	conflicts := []SharedLineageConflict{}
+	for _, branch := range args.Remote.BranchNames() {
+		if !slice.Contains(args.LocalBranches, branch) {
+			// lineage of branches that don't exist locally doesn't matter
+			continue
+		}
+		remoteParent := args.Remote[branch]
+		localParent, hasLocalParent := args.Local[branch]
+		switch {
+		case !hasLocalParent:
+			updates[branch] = remoteParent
+		case localParent == remoteParent:
+		case localParent == args.Base[branch]:
+			// only the shared lineage has changed
+			updates[branch] = remoteParent
+		case remoteParent != args.Base[branch]:
+			conflicts = append(conflicts, SharedLineageConflict{
+				Branch:       branch,
+				LocalParent:  localParent,
+				RemoteParent: remoteParent,
+			})
+		}
+	}
+	return updates, conflicts
+}
+
+// NewSharedLineage provides the lineage to share through the origin repository.
+// It contains the given local lineage and the given shared lineage for the branches that exist at origin.
+// The local lineage wins conflicts.
+func NewSharedLineage(local, shared Lineage, originBranches domain.LocalBranchNames) Lineage {
+	result := Lineage{}
+	for _, branch := range originBranches {
+		if parent, has := local[branch]; has {
+			result[branch] = parent
+		} else if parent, has := shared[branch]; has {
+			result[branch] = parent
+		}
+	}
+	return result
+}
+
+type MergeSharedLineageArgs struct {
+	Base          Lineage
+	Local         Lineage
+	LocalBranches domain.LocalBranchNames
+	Remote        Lineage
+}
+
+// SharedLineageConflict describes a branch that has different parents locally and in the shared lineage.
+type SharedLineageConflict struct {
+	Branch       domain.LocalBranchName
+	LocalParent  domain.LocalBranchName
+	RemoteParent domain.LocalBranchName
+}
@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedLineage(t *testing.T) {
+	t.Parallel()
+	main := domain.NewLocalBranchName("main")
+	one := domain.NewLocalBranchName("one")
+	two := domain.NewLocalBranchName("two")
+
+	t.Run("MergeSharedLineage", func(t *testing.T) {
+		t.Parallel()
+		t.Run("adopts the parents of local branches without a local parent", func(t *testing.T) {
+			t.Parallel()
+			updates, conflicts := config.MergeSharedLineage(config.MergeSharedLineageArgs{
+				Base:          config.Lineage{},
+				Local:         config.Lineage{},
+				LocalBranches: domain.LocalBranchNames{main, one},
+				Remote:        config.Lineage{one: main, two: one},
+			})
+			assert.Equal(t, config.Lineage{one: main}, updates)
+			assert.Empty(t, conflicts)
+		})
+		t.Run("adopts parents that were changed only in the shared lineage", func(t *testing.T) {
+			t.Parallel()
+			updates, conflicts := config.MergeSharedLineage(config.MergeSharedLineageArgs{
+				Base:          config.Lineage{two: one},
+				Local:         config.Lineage{one: main, two: one},
+				LocalBranches: domain.LocalBranchNames{main, one, two},
+				Remote:        config.Lineage{one: main, two: main},
+			})
+			assert.Equal(t, config.Lineage{two: main}, updates)
+			assert.Empty(t, conflicts)
+		})
+		t.Run("keeps parents that were changed only locally", func(t *testing.T) {
+			t.Parallel()
+			updates, conflicts := config.MergeSharedLineage(config.MergeSharedLineageArgs{
+				Base:          config.Lineage{two: one},
+				Local:         config.Lineage{one: main, two: main},
+				LocalBranches: domain.LocalBranchNames{main, one, two},
+				Remote:        config.Lineage{one: main, two: one},
+			})
+			assert.Empty(t, updates)
+			assert.Empty(t, conflicts)
+		})
+		t.Run("keeps local parents that conflict with the shared lineage", func(t *testing.T) {
+			t.Parallel()
+			updates, conflicts := config.MergeSharedLineage(config.MergeSharedLineageArgs{
+				Base:          config.Lineage{two: main},
+				Local:         config.Lineage{one: main, two: one},
+				LocalBranches: domain.LocalBranchNames{main, one, two},
+				Remote:        config.Lineage{two: domain.NewLocalBranchName("other")},
+			})
+			assert.Empty(t, updates)
+			want := []config.SharedLineageConflict{
+				{Branch: two, LocalParent: one, RemoteParent: domain.NewLocalBranchName("other")},
+			}
+			assert.Equal(t, want, conflicts)
+		})
+	})
+
+	t.Run("NewSharedLineage", func(t *testing.T) {
+		t.Parallel()
+		three := domain.NewLocalBranchName("three")
+		local := config.Lineage{one: main, two: main}
+		shared := config.Lineage{two: one, three: one, domain.NewLocalBranchName("deleted"): main}
+		have := config.NewSharedLineage(local, shared, domain.LocalBranchNames{main, one, two, three})
+		want := config.Lineage{one: main, two: main, three: one}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("ParseSharedLineage", func(t *testing.T) {
+		t.Parallel()
+		have := config.ParseSharedLineage("one main\ntwo one\n\ninvalid\n")
+		want := config.Lineage{one: main, two: one}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("SharedLineageContent", func(t *testing.T) {
+		t.Parallel()
+		have := config.SharedLineageContent(config.Lineage{two: one, one: main})
+		want := "one main\ntwo one\n"
+		assert.Equal(t, want, have)
+		assert.Equal(t, config.Lineage{one: main, two: one}, config.ParseSharedLineage(have))
+	})
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// ShipMessageData provides the values that the "ship-message-template" setting can use.
+type ShipMessageData struct {
+	// Branch is the name of the shipped branch.
+	Branch string
+	// Commits contains the subjects of the commits on the shipped branch, oldest first.
+	Commits []string
+	// Parent is the name of the branch that the branch gets shipped into.
+	Parent string
+	// ProposalNumber is the number of the proposal for the shipped branch, 0 if there is none.
+	ProposalNumber int
+	// ProposalTitle is the title of the proposal for the shipped branch, empty if there is none.
+	ProposalTitle string
+}
+
+// ParseShipMessageTemplate provides the Go template defined by the given "ship-message-template" setting.
+func ParseShipMessageTemplate(text string) (*template.Template, error) {
+	result, err := template.New(KeyShipMessageTemplate.Name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf(messages.ShipMessageTemplateInvalid, err)
+	}
+	return result, nil
+}
+
+// RenderShipMessage provides the commit message that the given "ship-message-template" setting defines for the given data.
+func RenderShipMessage(text string, data ShipMessageData) (string, error) {
+	tmpl, err := ParseShipMessageTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var result bytes.Buffer
+	err = tmpl.Execute(&result, data)
+	if err != nil {
+		return "", fmt.Errorf(messages.ShipMessageTemplateProblem, err)
+	}
+	message := strings.TrimSpace(result.String())
+	if message == "" {
+		return "", fmt.Errorf(messages.ShipMessageTemplateEmpty)
+	}
+	return message, nil
+}
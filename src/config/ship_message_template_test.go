@@ -0,0 +1,46 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderShipMessage(t *testing.T) {
+	t.Parallel()
+	data := config.ShipMessageData{
+		Branch:         "feature",
+		Commits:        []string{"commit 1", "commit 2"},
+		Parent:         "main",
+		ProposalNumber: 123,
+		ProposalTitle:  "my proposal",
+	}
+
+	t.Run("renders all fields", func(t *testing.T) {
+		t.Parallel()
+		give := "{{.ProposalTitle}} (#{{.ProposalNumber}})\n\nships {{.Branch}} into {{.Parent}}\n{{range .Commits}}\n- {{.}}{{end}}\n"
+		have, err := config.RenderShipMessage(give, data)
+		assert.Nil(t, err)
+		want := "my proposal (#123)\n\nships feature into main\n\n- commit 1\n- commit 2"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		t.Parallel()
+		_, err := config.RenderShipMessage("{{.Branch", data)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+		_, err := config.RenderShipMessage("{{.Zonk}}", data)
+		assert.Error(t, err)
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		t.Parallel()
+		_, err := config.RenderShipMessage("{{if .ProposalTitle}}{{else}} {{end}}", config.ShipMessageData{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// ShipStrategy defines legal values for the "ship-strategy" setting of branches that feature branches get shipped into.
+type ShipStrategy struct {
+	name string
+}
+
+func (s ShipStrategy) String() string { return s.name }
+
+var (
+	ShipStrategyMerge       = ShipStrategy{"merge"}        //nolint:gochecknoglobals
+	ShipStrategySquashMerge = ShipStrategy{"squash-merge"} //nolint:gochecknoglobals
+)
+
+func ToShipStrategy(text string) (ShipStrategy, error) {
+	switch text {
+	case "squash-merge", "":
+		return ShipStrategySquashMerge, nil
+	case "merge":
+		return ShipStrategyMerge, nil
+	default:
+		return ShipStrategySquashMerge, fmt.Errorf(messages.ConfigShipStrategyUnknown, text)
+	}
+}
@@ -0,0 +1,38 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToShipStrategy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid content", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]config.ShipStrategy{
+			"merge":        config.ShipStrategyMerge,
+			"squash-merge": config.ShipStrategySquashMerge,
+		}
+		for give, want := range tests {
+			have, err := config.ToShipStrategy(give)
+			assert.Nil(t, err)
+			assert.Equal(t, want, have)
+		}
+	})
+
+	t.Run("defaults to squash-merge", func(t *testing.T) {
+		t.Parallel()
+		have, err := config.ToShipStrategy("")
+		assert.Nil(t, err)
+		assert.Equal(t, config.ShipStrategySquashMerge, have)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		_, err := config.ToShipStrategy("zonk")
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/messages"
 )
 
@@ -28,3 +29,26 @@ func ToSyncStrategy(text string) (SyncStrategy, error) {
 		return SyncStrategyMerge, fmt.Errorf(messages.ConfigSyncStrategyUnknown, text)
 	}
 }
+
+// BranchSyncStrategies contains the sync strategies that individual branches use instead of the general sync strategy.
+// Example: rebasing private branches while merging into branches that other people have pulled.
+type BranchSyncStrategies map[domain.LocalBranchName]SyncStrategy
+
+// Branches provides the names of the branches that have their own sync strategy, sorted alphabetically.
+func (bss BranchSyncStrategies) Branches() domain.LocalBranchNames {
+	result := make(domain.LocalBranchNames, 0, len(bss))
+	for branch := range bss {
+		result = append(result, branch)
+	}
+	result.Sort()
+	return result
+}
+
+// For provides the sync strategy to use for the given branch,
+// given the general sync strategy.
+func (bss BranchSyncStrategies) For(branch domain.LocalBranchName, general SyncStrategy) SyncStrategy {
+	if strategy, has := bss[branch]; has {
+		return strategy
+	}
+	return general
+}
@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchSyncStrategies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Branches", func(t *testing.T) {
+		t.Parallel()
+		strategies := config.BranchSyncStrategies{
+			domain.NewLocalBranchName("beta"):  config.SyncStrategyMerge,
+			domain.NewLocalBranchName("alpha"): config.SyncStrategyRebase,
+		}
+		have := strategies.Branches()
+		want := domain.NewLocalBranchNames("alpha", "beta")
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("For", func(t *testing.T) {
+		t.Parallel()
+		strategies := config.BranchSyncStrategies{
+			domain.NewLocalBranchName("private"): config.SyncStrategyRebase,
+		}
+		t.Run("branch with its own sync strategy", func(t *testing.T) {
+			t.Parallel()
+			have := strategies.For(domain.NewLocalBranchName("private"), config.SyncStrategyMerge)
+			assert.Equal(t, config.SyncStrategyRebase, have)
+		})
+		t.Run("branch without its own sync strategy", func(t *testing.T) {
+			t.Parallel()
+			have := strategies.For(domain.NewLocalBranchName("shared"), config.SyncStrategyMerge)
+			assert.Equal(t, config.SyncStrategyMerge, have)
+		})
+	})
+}
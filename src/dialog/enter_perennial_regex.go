@@ -0,0 +1,48 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	survey "gopkg.in/AlecAivazis/survey.v1"
+)
+
+// EnterPerennialRegex lets the user update the regular expression that matches the names of additional perennial branches.
+// This includes asking the user and updating the respective setting based on the user input.
+// An empty answer removes the regular expression.
+func EnterPerennialRegex(backend *git.BackendCommands, current domain.PerennialRegex) (domain.PerennialRegex, error) {
+	text := ""
+	prompt := &survey.Input{
+		Message: perennialRegexPrompt(current),
+	}
+	err := survey.AskOne(prompt, &text, validatePerennialRegex)
+	if err != nil {
+		return current, fmt.Errorf(messages.DialogCannotReadAnswer, err)
+	}
+	regex, err := domain.NewPerennialRegex(text)
+	if err != nil {
+		return current, fmt.Errorf(messages.PerennialRegexInvalid, text, err)
+	}
+	return regex, backend.Config.SetPerennialRegex(regex)
+}
+
+func perennialRegexPrompt(current domain.PerennialRegex) string {
+	result := messages.PerennialRegexPrompt
+	if !current.IsEmpty() {
+		coloredRegex := color.New(color.Bold).Add(color.FgCyan).Sprintf(current.String())
+		result += fmt.Sprintf(" (current value: %s, leave empty to remove it)", coloredRegex)
+	}
+	return result
+}
+
+func validatePerennialRegex(answer interface{}) error {
+	text, _ := answer.(string)
+	_, err := domain.NewPerennialRegex(text)
+	if err != nil {
+		return fmt.Errorf(messages.PerennialRegexInvalid, text, err)
+	}
+	return nil
+}
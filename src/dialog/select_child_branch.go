@@ -0,0 +1,22 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// SelectChildBranch lets the user select one of the given child branches of the given branch.
+func SelectChildBranch(branch domain.LocalBranchName, children domain.LocalBranchNames) (domain.LocalBranchName, error) {
+	choice, err := Select(SelectArgs{
+		Options: children.Strings(),
+		Message: fmt.Sprintf(childBranchPromptTemplate, branch),
+		Default: children[0].String(),
+	})
+	if err != nil {
+		return domain.LocalBranchName{}, err
+	}
+	return domain.NewLocalBranchName(choice), nil
+}
+
+const childBranchPromptTemplate = "Branch %q has several child branches. Please select the one to switch to:"
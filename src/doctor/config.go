@@ -0,0 +1,171 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/steps"
+)
+
+// CheckConfig finds invalid entries in the Git Town configuration.
+func CheckConfig(gitTown *config.GitTown, allBranches domain.BranchInfos) Problems {
+	result := Problems{}
+	mainBranch := gitTown.MainBranch()
+	switch {
+	case mainBranch.IsEmpty():
+		result = append(result, Problem{
+			Description: messages.DoctorMainBranchUnknown,
+			Fix:         []steps.Step{},
+			Remedy:      messages.DoctorHintConfigSetup,
+		})
+	case !allBranches.HasLocalBranch(mainBranch):
+		result = append(result, Problem{
+			Description: fmt.Sprintf(messages.DoctorMainBranchMissing, mainBranch),
+			Fix:         []steps.Step{},
+			Remedy:      messages.DoctorHintConfigSetup,
+		})
+	}
+	for _, perennialBranch := range gitTown.PerennialBranches() {
+		if allBranches.HasLocalBranch(perennialBranch) || allBranches.HasMatchingRemoteBranchFor(perennialBranch) {
+			continue
+		}
+		result = append(result, Problem{
+			Description: fmt.Sprintf(messages.DoctorPerennialBranchMissing, perennialBranch),
+			Fix:         []steps.Step{&steps.RemoveFromPerennialBranchesStep{Branch: perennialBranch}},
+			Remedy:      fmt.Sprintf(messages.DoctorFixRemovePerennialBranch, perennialBranch),
+		})
+	}
+	for _, global := range []bool{false, true} {
+		for _, check := range configChecks() {
+			value := gitTown.LocalConfigValue(check.key)
+			scope := "local"
+			if global {
+				value = gitTown.GlobalConfigValue(check.key)
+				scope = "global"
+			}
+			if value == "" || check.isValid(value) {
+				continue
+			}
+			result = append(result, Problem{
+				Description: fmt.Sprintf(messages.DoctorConfigValueInvalid, scope, value, check.key),
+				Fix:         []steps.Step{&steps.RemoveConfigValueStep{Global: global, Key: check.key}},
+				Remedy:      messages.DoctorFixRemoveConfigValue,
+			})
+		}
+	}
+	for _, check := range branchConfigChecks() {
+		for _, key := range gitTown.LocalConfigKeysMatching(check.pattern) {
+			value := gitTown.LocalConfigValue(key)
+			if value == "" || check.isValid(value) {
+				continue
+			}
+			result = append(result, Problem{
+				Description: fmt.Sprintf(messages.DoctorConfigValueInvalid, "local", value, key),
+				Fix:         []steps.Step{&steps.RemoveConfigValueStep{Global: false, Key: key}},
+				Remedy:      messages.DoctorFixRemoveConfigValue,
+			})
+		}
+	}
+	for _, check := range configChecks() {
+		value := gitTown.ConfigFileValue(check.key)
+		if value == "" || check.isValid(value) {
+			continue
+		}
+		result = append(result, Problem{
+			Description: fmt.Sprintf(messages.DoctorConfigFileValueInvalid, config.ConfigFileName, value, config.ConfigFileKeyName(check.key)),
+			Fix:         []steps.Step{},
+			Remedy:      fmt.Sprintf(messages.DoctorHintEditConfigFile, config.ConfigFileName),
+		})
+	}
+	return result
+}
+
+// CheckConfigFile verifies that Git Town can read the configuration file in the given root directory of the repo.
+func CheckConfigFile(rootDir domain.RepoRootDir) Problems {
+	_, err := config.LoadConfigFile(rootDir)
+	if err == nil {
+		return Problems{}
+	}
+	return Problems{{
+		Description: err.Error(),
+		Fix:         []steps.Step{},
+		Remedy:      fmt.Sprintf(messages.DoctorHintEditConfigFile, config.ConfigFileName),
+	}}
+}
+
+// configCheck verifies the value of a configuration entry.
+type configCheck struct {
+	key     config.Key
+	isValid func(string) bool
+}
+
+// configChecks provides the checks for all configuration entries that have a restricted set of values.
+func configChecks() []configCheck {
+	return []configCheck{
+		{key: config.KeyCodeHostingDriver, isValid: isValidHosting},
+		{key: config.KeyCommandLog, isValid: isValidBool},
+		{key: config.KeyOffline, isValid: isValidBool},
+		{key: config.KeyPerennialRegex, isValid: isValidRegex},
+		{key: config.KeyPullBranchStrategy, isValid: isValidPullBranchStrategy},
+		{key: config.KeyPushHook, isValid: isValidBool},
+		{key: config.KeyPushNewBranches, isValid: isValidBool},
+		{key: config.KeyShareLineage, isValid: isValidBool},
+		{key: config.KeyShipChangelogTrailers, isValid: isValidBool},
+		{key: config.KeyShipDeleteRemoteBranch, isValid: isValidBool},
+		{key: config.KeyShipMessageTemplate, isValid: isValidShipMessageTemplate},
+		{key: config.KeySyncStrategy, isValid: isValidSyncStrategy},
+		{key: config.KeySyncUpdateRefs, isValid: isValidBool},
+		{key: config.KeySyncUpstream, isValid: isValidBool},
+	}
+}
+
+// branchConfigCheck verifies the values of the configuration entries of individual branches.
+type branchConfigCheck struct {
+	pattern string // regular expression matching the keys of the configuration entries
+	isValid func(string) bool
+}
+
+// branchConfigChecks provides the checks for the configuration entries of individual branches that have a restricted set of values.
+func branchConfigChecks() []branchConfigCheck {
+	return []branchConfigCheck{
+		{pattern: `^git-town-branch\..*\.ship-delete-remote-branch$`, isValid: isValidBool},
+		{pattern: `^git-town-branch\..*\.ship-strategy$`, isValid: isValidShipStrategy},
+	}
+}
+
+func isValidBool(text string) bool {
+	_, err := config.ParseBool(text)
+	return err == nil
+}
+
+func isValidHosting(text string) bool {
+	_, err := config.NewHosting(text)
+	return err == nil
+}
+
+func isValidPullBranchStrategy(text string) bool {
+	_, err := config.NewPullBranchStrategy(text)
+	return err == nil
+}
+
+func isValidRegex(text string) bool {
+	_, err := domain.NewPerennialRegex(text)
+	return err == nil
+}
+
+func isValidShipMessageTemplate(text string) bool {
+	_, err := config.ParseShipMessageTemplate(text)
+	return err == nil
+}
+
+func isValidShipStrategy(text string) bool {
+	_, err := config.ToShipStrategy(text)
+	return err == nil
+}
+
+func isValidSyncStrategy(text string) bool {
+	_, err := config.ToSyncStrategy(text)
+	return err == nil
+}
@@ -0,0 +1,43 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/steps"
+)
+
+// CheckHosting verifies that Git Town can talk to the code hosting platform of the origin remote.
+// The connector is nil if Git Town cannot determine the code hosting platform.
+func CheckHosting(gitTown *config.GitTown, connector hosting.Connector) Problems {
+	if connector == nil {
+		return Problems{{
+			Description: fmt.Sprintf(messages.DoctorHostingUnknown, gitTown.OriginURLString()),
+			Fix:         []steps.Step{},
+			Remedy:      messages.DoctorHintHostingDriver,
+		}}
+	}
+	var token string
+	var tokenKey config.Key
+	switch connector.HostingServiceName() {
+	case "GitHub":
+		token, tokenKey = hosting.GetGitHubAPIToken(gitTown), config.KeyGithubToken
+	case "GitLab":
+		token, tokenKey = gitTown.GitLabToken(), config.KeyGitlabToken
+	case "Gitea":
+		token, tokenKey = gitTown.GiteaToken(), config.KeyGiteaToken
+	default:
+		// Git Town doesn't use the API of this platform
+		return Problems{}
+	}
+	if token != "" {
+		return Problems{}
+	}
+	return Problems{{
+		Description: fmt.Sprintf(messages.DoctorHostingToken, connector.HostingServiceName()),
+		Fix:         []steps.Step{},
+		Remedy:      fmt.Sprintf(messages.DoctorHintStoreToken, tokenKey),
+	}}
+}
@@ -0,0 +1,138 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/git-town/git-town/v9/src/validate"
+)
+
+// CheckLineage finds problems in the given lineage.
+// The fixes of later problems build on the fixes of earlier problems.
+func CheckLineage(args CheckLineageArgs) (Problems, error) {
+	result, repaired := CheckLineageStructure(args.Lineage, args.AllBranches, args.BranchTypes)
+	ancestryProblems, err := checkLineageAncestry(repaired, args)
+	return append(result, ancestryProblems...), err
+}
+
+type CheckLineageArgs struct {
+	AllBranches domain.BranchInfos
+	Backend     *git.BackendCommands
+	BranchTypes domain.BranchTypes
+	Lineage     config.Lineage
+}
+
+// CheckLineageStructure finds lineage entries of branches that don't exist or aren't feature branches,
+// cycles, and parent branches that don't exist.
+// Also provides the lineage that results from applying all fixes.
+func CheckLineageStructure(lineage config.Lineage, allBranches domain.BranchInfos, branchTypes domain.BranchTypes) (Problems, config.Lineage) {
+	result := Problems{}
+	repaired := config.Lineage{}
+	for branch, parent := range lineage {
+		repaired[branch] = parent
+	}
+	for _, branch := range lineage.BranchNames() {
+		parent := lineage[branch]
+		var description string
+		switch {
+		case !allBranches.HasLocalBranch(branch):
+			description = fmt.Sprintf(messages.DoctorLineageStaleEntry, branch)
+		case !branchTypes.IsFeatureBranch(branch):
+			description = fmt.Sprintf(messages.DoctorLineagePerennialParent, branch, parent)
+		default:
+			continue
+		}
+		result = append(result, Problem{
+			Description: description,
+			Fix:         []steps.Step{&steps.DeleteParentBranchStep{Branch: branch, Parent: parent}},
+			Remedy:      fmt.Sprintf(messages.DoctorFixDeleteParent, branch),
+		})
+		delete(repaired, branch)
+	}
+	for _, cycle := range repaired.Cycles() {
+		branch := cycle[0]
+		result = append(result, Problem{
+			Description: fmt.Sprintf(messages.DoctorLineageCycle, cycle.Join(", ")),
+			Fix:         []steps.Step{&steps.SetParentStep{Branch: branch, ParentBranch: branchTypes.MainBranch}},
+			Remedy:      fmt.Sprintf(messages.DoctorFixSetParent, branch, branchTypes.MainBranch),
+		})
+		repaired[branch] = branchTypes.MainBranch
+	}
+	for _, branch := range repaired.BranchNames() {
+		parent := repaired[branch]
+		if allBranches.HasLocalBranch(parent) {
+			continue
+		}
+		newParent := closestExistingAncestor(branch, lineage, repaired, allBranches, branchTypes.MainBranch)
+		result = append(result, Problem{
+			Description: fmt.Sprintf(messages.DoctorLineageParentMissing, parent, branch),
+			Fix:         []steps.Step{&steps.SetParentStep{Branch: branch, ParentBranch: newParent}},
+			Remedy:      fmt.Sprintf(messages.DoctorFixSetParent, branch, newParent),
+		})
+		repaired[branch] = newParent
+	}
+	return result, repaired
+}
+
+// checkLineageAncestry finds branches that don't descend from their parent branch in the commit history.
+func checkLineageAncestry(lineage config.Lineage, args CheckLineageArgs) (Problems, error) {
+	result := Problems{}
+	for _, branch := range lineage.BranchNames() {
+		parent := lineage[branch]
+		if !args.BranchTypes.IsFeatureBranch(parent) {
+			// feature branches regularly fall behind perennial branches
+			continue
+		}
+		ahead, behind, err := args.Backend.CommitsAheadAndBehind(branch, parent)
+		if err != nil {
+			return result, err
+		}
+		if ahead == 0 || behind == 0 {
+			// the branch contains its parent or is merely behind it
+			continue
+		}
+		inference, err := validate.InferParent(branch, validate.InferParentArgs{
+			AllBranches: args.AllBranches,
+			Backend:     args.Backend,
+			BranchTypes: args.BranchTypes,
+			Connector:   nil,
+			Lineage:     lineage,
+		})
+		if err != nil {
+			return result, err
+		}
+		inferred := inference.Candidate
+		if inferred.Name == parent || ahead < inferred.Ahead || (ahead == inferred.Ahead && !inferred.IsContained()) {
+			// the parent is as near as any other branch, the branch just needs to be synced with it
+			continue
+		}
+		problem := Problem{
+			Description: fmt.Sprintf(messages.DoctorLineageNotAncestor, branch, parent, inferred.Name, inference.Confidence),
+			Fix:         []steps.Step{},
+			Remedy:      fmt.Sprintf(messages.DoctorHintInferParent, branch),
+		}
+		if inference.Confidence == validate.ParentConfidenceHigh {
+			problem.Fix = []steps.Step{&steps.SetParentStep{Branch: branch, ParentBranch: inferred.Name}}
+			problem.Remedy = fmt.Sprintf(messages.DoctorFixSetParent, branch, inferred.Name)
+		}
+		result = append(result, problem)
+	}
+	return result, nil
+}
+
+// closestExistingAncestor provides the closest ancestor of the given branch in the given original lineage
+// that exists and doesn't descend from the branch in the given repaired lineage.
+func closestExistingAncestor(branch domain.LocalBranchName, original, repaired config.Lineage, allBranches domain.BranchInfos, mainBranch domain.LocalBranchName) domain.LocalBranchName {
+	ancestors := original.Ancestors(branch)
+	for a := len(ancestors) - 1; a >= 0; a-- {
+		ancestor := ancestors[a]
+		if allBranches.HasLocalBranch(ancestor) && ancestor != branch && !repaired.IsAncestor(branch, ancestor) {
+			return ancestor
+		}
+	}
+	return mainBranch
+}
@@ -0,0 +1,81 @@
+package doctor_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/doctor"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/steps"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLineageStructure(t *testing.T) {
+	t.Parallel()
+	main := domain.NewLocalBranchName("main")
+	one := domain.NewLocalBranchName("one")
+	two := domain.NewLocalBranchName("two")
+	three := domain.NewLocalBranchName("three")
+	perennial := domain.NewLocalBranchName("perennial")
+	branchTypes := domain.BranchTypes{MainBranch: main, ObservedBranches: domain.LocalBranchNames{}, PerennialBranches: domain.LocalBranchNames{perennial}, PerennialRegex: domain.PerennialRegex{}, PrototypeBranches: domain.LocalBranchNames{}}
+	localBranches := func(names ...domain.LocalBranchName) domain.BranchInfos {
+		result := domain.BranchInfos{}
+		for _, name := range names {
+			result = append(result, domain.BranchInfo{
+				LocalName:  name,
+				LocalSHA:   domain.NewSHA("111111"),
+				SyncStatus: domain.SyncStatusLocalOnly,
+				RemoteName: domain.RemoteBranchName{},
+				RemoteSHA:  domain.SHA{},
+			})
+		}
+		return result
+	}
+
+	t.Run("healthy lineage", func(t *testing.T) {
+		t.Parallel()
+		lineage := config.Lineage{one: main, two: one}
+		problems, repaired := doctor.CheckLineageStructure(lineage, localBranches(main, one, two), branchTypes)
+		assert.Equal(t, doctor.Problems{}, problems)
+		assert.Equal(t, lineage, repaired)
+	})
+
+	t.Run("entries of branches that don't exist", func(t *testing.T) {
+		t.Parallel()
+		lineage := config.Lineage{one: main, two: one}
+		problems, repaired := doctor.CheckLineageStructure(lineage, localBranches(main, one), branchTypes)
+		assert.Len(t, problems, 1)
+		assert.Equal(t, []steps.Step{&steps.DeleteParentBranchStep{Branch: two, Parent: one}}, problems[0].Fix)
+		assert.Equal(t, config.Lineage{one: main}, repaired)
+	})
+
+	t.Run("perennial branches with a parent", func(t *testing.T) {
+		t.Parallel()
+		lineage := config.Lineage{one: main, perennial: main}
+		problems, repaired := doctor.CheckLineageStructure(lineage, localBranches(main, one, perennial), branchTypes)
+		assert.Len(t, problems, 1)
+		assert.Equal(t, []steps.Step{&steps.DeleteParentBranchStep{Branch: perennial, Parent: main}}, problems[0].Fix)
+		assert.Equal(t, config.Lineage{one: main}, repaired)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+		lineage := config.Lineage{one: two, two: one, three: two}
+		problems, repaired := doctor.CheckLineageStructure(lineage, localBranches(main, one, two, three), branchTypes)
+		assert.Len(t, problems, 1)
+		assert.Equal(t, "the branches one, two form a cycle in the lineage", problems[0].Description)
+		assert.Equal(t, []steps.Step{&steps.SetParentStep{Branch: one, ParentBranch: main}}, problems[0].Fix)
+		assert.Equal(t, config.Lineage{one: main, two: one, three: two}, repaired)
+	})
+
+	t.Run("parent doesn't exist", func(t *testing.T) {
+		t.Parallel()
+		lineage := config.Lineage{one: main, two: one, three: two}
+		problems, repaired := doctor.CheckLineageStructure(lineage, localBranches(main, one, three), branchTypes)
+		assert.Len(t, problems, 2)
+		assert.Equal(t, `the lineage contains the branch "two" that doesn't exist`, problems[0].Description)
+		assert.Equal(t, `the parent "two" of branch "three" doesn't exist`, problems[1].Description)
+		assert.Equal(t, []steps.Step{&steps.SetParentStep{Branch: three, ParentBranch: one}}, problems[1].Fix)
+		assert.Equal(t, config.Lineage{one: main, three: one}, repaired)
+	})
+}
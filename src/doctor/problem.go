@@ -0,0 +1,30 @@
+// Package doctor finds and repairs problems in the Git Town setup of a repository.
+package doctor
+
+import "github.com/git-town/git-town/v9/src/steps"
+
+// Problem describes a problem in the Git Town setup of a repository.
+type Problem struct {
+	Description string
+	// the steps that repair this problem, empty if Git Town cannot repair it
+	Fix []steps.Step
+	// describes what the fix steps do, or what the user can do if there are no fix steps
+	Remedy string
+}
+
+// CanFix indicates whether Git Town can repair this problem.
+func (p Problem) CanFix() bool {
+	return len(p.Fix) > 0
+}
+
+// Problems is a collection of Problem instances.
+type Problems []Problem
+
+// Fixes provides the steps that repair all problems in this collection.
+func (ps Problems) Fixes() []steps.Step {
+	result := []steps.Step{}
+	for _, problem := range ps {
+		result = append(result, problem.Fix...)
+	}
+	return result
+}
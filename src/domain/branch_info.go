@@ -20,6 +20,12 @@ type BranchInfo struct {
 
 	// RemoteSHA contains the SHA of the tracking branch before Git Town ran.
 	RemoteSHA SHA
+
+	// Ahead contains how many commits the local branch has that its tracking branch doesn't have.
+	Ahead int `exhaustruct:"optional"`
+
+	// Behind contains how many commits the tracking branch has that the local branch doesn't have.
+	Behind int `exhaustruct:"optional"`
 }
 
 func (bi BranchInfo) HasTrackingBranch() bool {
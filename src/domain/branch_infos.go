@@ -14,7 +14,7 @@ type BranchInfos []BranchInfo
 // IsKnown indicates whether the given local branch is already known to this BranchesSyncStatus instance.
 func (bs BranchInfos) HasLocalBranch(localBranch LocalBranchName) bool {
 	for _, branch := range bs {
-		if branch.LocalName == localBranch {
+		if branch.IsLocal() && branch.LocalName == localBranch {
 			return true
 		}
 	}
@@ -5,24 +5,41 @@ import "github.com/git-town/git-town/v9/src/slice"
 // BranchTypes answers questions about whether branches are long-lived or not.
 type BranchTypes struct {
 	MainBranch        LocalBranchName
+	ObservedBranches  LocalBranchNames
 	PerennialBranches LocalBranchNames
+	PerennialRegex    PerennialRegex
+	PrototypeBranches LocalBranchNames
 }
 
 func (pb BranchTypes) IsFeatureBranch(branch LocalBranchName) bool {
-	return !pb.IsMainBranch(branch) && !pb.IsPerennialBranch(branch)
+	return !pb.IsMainBranch(branch) && !pb.IsPerennialBranch(branch) && !pb.IsObservedBranch(branch)
 }
 
 func (pb BranchTypes) IsMainBranch(branch LocalBranchName) bool {
 	return branch == pb.MainBranch
 }
 
+// IsObservedBranch indicates whether the given branch belongs to somebody else
+// and should therefore only receive the changes from its tracking branch.
+func (pb BranchTypes) IsObservedBranch(branch LocalBranchName) bool {
+	return slice.Contains(pb.ObservedBranches, branch)
+}
+
 func (pb BranchTypes) IsPerennialBranch(branch LocalBranchName) bool {
-	return slice.Contains(pb.PerennialBranches, branch)
+	return slice.Contains(pb.PerennialBranches, branch) || pb.PerennialRegex.MatchesBranch(branch)
+}
+
+// IsPrototypeBranch indicates whether the given feature branch should stay local and never get pushed.
+func (pb BranchTypes) IsPrototypeBranch(branch LocalBranchName) bool {
+	return slice.Contains(pb.PrototypeBranches, branch)
 }
 
 func EmptyBranchTypes() BranchTypes {
 	return BranchTypes{
 		MainBranch:        LocalBranchName{},
+		ObservedBranches:  LocalBranchNames{},
 		PerennialBranches: LocalBranchNames{},
+		PerennialRegex:    PerennialRegex{regex: nil},
+		PrototypeBranches: LocalBranchNames{},
 	}
 }
@@ -14,10 +14,14 @@ func TestBranchTypes(t *testing.T) {
 		t.Parallel()
 		bt := domain.BranchTypes{
 			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.NewLocalBranchNames("observed"),
 			PerennialBranches: domain.NewLocalBranchNames("peren1", "peren2"),
+			PerennialRegex:    domain.PerennialRegex{},
+			PrototypeBranches: domain.LocalBranchNames{},
 		}
 		assert.True(t, bt.IsFeatureBranch(domain.NewLocalBranchName("feature")))
 		assert.False(t, bt.IsFeatureBranch(domain.NewLocalBranchName("main")))
+		assert.False(t, bt.IsFeatureBranch(domain.NewLocalBranchName("observed")))
 		assert.False(t, bt.IsFeatureBranch(domain.NewLocalBranchName("peren1")))
 		assert.False(t, bt.IsFeatureBranch(domain.NewLocalBranchName("peren2")))
 	})
@@ -26,7 +30,10 @@ func TestBranchTypes(t *testing.T) {
 		t.Parallel()
 		bt := domain.BranchTypes{
 			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.NewLocalBranchNames("observed"),
 			PerennialBranches: domain.NewLocalBranchNames("peren1", "peren2"),
+			PerennialRegex:    domain.PerennialRegex{},
+			PrototypeBranches: domain.LocalBranchNames{},
 		}
 		assert.False(t, bt.IsMainBranch(domain.NewLocalBranchName("feature")))
 		assert.True(t, bt.IsMainBranch(domain.NewLocalBranchName("main")))
@@ -34,15 +41,65 @@ func TestBranchTypes(t *testing.T) {
 		assert.False(t, bt.IsMainBranch(domain.NewLocalBranchName("peren2")))
 	})
 
+	t.Run("IsObservedBranch", func(t *testing.T) {
+		t.Parallel()
+		bt := domain.BranchTypes{
+			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.NewLocalBranchNames("observed"),
+			PerennialBranches: domain.NewLocalBranchNames("peren1", "peren2"),
+			PerennialRegex:    domain.PerennialRegex{},
+			PrototypeBranches: domain.LocalBranchNames{},
+		}
+		assert.False(t, bt.IsObservedBranch(domain.NewLocalBranchName("feature")))
+		assert.False(t, bt.IsObservedBranch(domain.NewLocalBranchName("main")))
+		assert.True(t, bt.IsObservedBranch(domain.NewLocalBranchName("observed")))
+		assert.False(t, bt.IsObservedBranch(domain.NewLocalBranchName("peren1")))
+	})
+
 	t.Run("IsPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		bt := domain.BranchTypes{
 			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.NewLocalBranchNames("observed"),
 			PerennialBranches: domain.NewLocalBranchNames("peren1", "peren2"),
+			PerennialRegex:    domain.PerennialRegex{},
+			PrototypeBranches: domain.LocalBranchNames{},
 		}
 		assert.False(t, bt.IsPerennialBranch(domain.NewLocalBranchName("feature")))
 		assert.False(t, bt.IsPerennialBranch(domain.NewLocalBranchName("main")))
 		assert.True(t, bt.IsPerennialBranch(domain.NewLocalBranchName("peren1")))
 		assert.True(t, bt.IsPerennialBranch(domain.NewLocalBranchName("peren2")))
 	})
+
+	t.Run("IsPerennialBranch with a perennial regex", func(t *testing.T) {
+		t.Parallel()
+		regex, err := domain.NewPerennialRegex("^release/")
+		assert.NoError(t, err)
+		bt := domain.BranchTypes{
+			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.LocalBranchNames{},
+			PerennialBranches: domain.NewLocalBranchNames("qa"),
+			PerennialRegex:    regex,
+			PrototypeBranches: domain.LocalBranchNames{},
+		}
+		assert.True(t, bt.IsPerennialBranch(domain.NewLocalBranchName("qa")))
+		assert.True(t, bt.IsPerennialBranch(domain.NewLocalBranchName("release/2026.10")))
+		assert.False(t, bt.IsPerennialBranch(domain.NewLocalBranchName("feature/release/1")))
+		assert.False(t, bt.IsFeatureBranch(domain.NewLocalBranchName("release/2026.11")))
+	})
+
+	t.Run("IsPrototypeBranch", func(t *testing.T) {
+		t.Parallel()
+		bt := domain.BranchTypes{
+			MainBranch:        domain.NewLocalBranchName("main"),
+			ObservedBranches:  domain.LocalBranchNames{},
+			PerennialBranches: domain.NewLocalBranchNames("peren1"),
+			PerennialRegex:    domain.PerennialRegex{},
+			PrototypeBranches: domain.NewLocalBranchNames("prototype"),
+		}
+		assert.False(t, bt.IsPrototypeBranch(domain.NewLocalBranchName("feature")))
+		assert.False(t, bt.IsPrototypeBranch(domain.NewLocalBranchName("main")))
+		assert.True(t, bt.IsPrototypeBranch(domain.NewLocalBranchName("prototype")))
+		assert.True(t, bt.IsFeatureBranch(domain.NewLocalBranchName("prototype")))
+	})
 }
@@ -0,0 +1,41 @@
+package domain
+
+import "regexp"
+
+// PerennialRegex matches the names of branches that are perennial
+// in addition to the explicitly configured perennial branches.
+// Like all Go regular expressions, it matches anywhere in the branch name unless it is anchored.
+type PerennialRegex struct {
+	regex *regexp.Regexp
+}
+
+// NewPerennialRegex provides a PerennialRegex for the given regular expression.
+// An empty expression matches no branches.
+func NewPerennialRegex(text string) (PerennialRegex, error) {
+	if text == "" {
+		return PerennialRegex{regex: nil}, nil
+	}
+	regex, err := regexp.Compile(text)
+	if err != nil {
+		return PerennialRegex{regex: nil}, err
+	}
+	return PerennialRegex{regex: regex}, nil
+}
+
+// IsEmpty indicates whether this PerennialRegex is not set.
+func (pr PerennialRegex) IsEmpty() bool {
+	return pr.regex == nil
+}
+
+// MatchesBranch indicates whether the given branch name matches this PerennialRegex.
+func (pr PerennialRegex) MatchesBranch(branch LocalBranchName) bool {
+	return pr.regex != nil && pr.regex.MatchString(branch.String())
+}
+
+// Implementation of the fmt.Stringer interface.
+func (pr PerennialRegex) String() string {
+	if pr.regex == nil {
+		return ""
+	}
+	return pr.regex.String()
+}
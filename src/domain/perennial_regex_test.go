@@ -0,0 +1,35 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerennialRegex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewPerennialRegex", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid regex", func(t *testing.T) {
+			t.Parallel()
+			regex, err := domain.NewPerennialRegex("^release/")
+			assert.NoError(t, err)
+			assert.False(t, regex.IsEmpty())
+			assert.Equal(t, "^release/", regex.String())
+		})
+		t.Run("empty regex", func(t *testing.T) {
+			t.Parallel()
+			regex, err := domain.NewPerennialRegex("")
+			assert.NoError(t, err)
+			assert.True(t, regex.IsEmpty())
+			assert.False(t, regex.MatchesBranch(domain.NewLocalBranchName("release/1")))
+		})
+		t.Run("invalid regex", func(t *testing.T) {
+			t.Parallel()
+			_, err := domain.NewPerennialRegex("release/(")
+			assert.Error(t, err)
+		})
+	})
+}
@@ -12,6 +12,12 @@ import (
 func TestSHA(t *testing.T) {
 	t.Parallel()
 
+	t.Run("IsEmpty", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, domain.SHA{}.IsEmpty())
+		assert.False(t, domain.NewSHA("123456").IsEmpty())
+	})
+
 	t.Run("MarshalJSON", func(t *testing.T) {
 		t.Parallel()
 		sha := domain.NewSHA("123456")
@@ -31,7 +31,7 @@ func LoadBranches(args LoadBranchesArgs) (domain.Branches, bool, error) {
 			return domain.EmptyBranches(), false, err
 		}
 		if remotes.HasOrigin() && !args.Repo.IsOffline {
-			err = args.Repo.Runner.Frontend.Fetch()
+			err = args.Repo.Runner.Frontend.Fetch(remotes, args.Repo.ShareLineage)
 			if err != nil {
 				return domain.EmptyBranches(), false, err
 			}
@@ -41,6 +41,12 @@ func LoadBranches(args LoadBranchesArgs) (domain.Branches, bool, error) {
 	if err != nil {
 		return domain.EmptyBranches(), false, err
 	}
+	if args.Fetch && args.Repo.ShareLineage {
+		err = assumeSharedLineage(args.Repo, args.Lineage)
+		if err != nil {
+			return domain.EmptyBranches(), false, err
+		}
+	}
 	branchTypes := args.Repo.Runner.Config.BranchTypes()
 	result := domain.Branches{
 		All:     allBranches,
@@ -48,7 +54,7 @@ func LoadBranches(args LoadBranchesArgs) (domain.Branches, bool, error) {
 		Initial: initialBranch,
 	}
 	if args.ValidateIsConfigured {
-		result.Types, err = validate.IsConfigured(&args.Repo.Runner.Backend, result)
+		result.Types, err = validate.IsConfigured(&args.Repo.Runner.Backend, result, args.ReparentOrphans)
 	}
 	return result, false, err
 }
@@ -60,4 +66,5 @@ type LoadBranchesArgs struct {
 	Lineage               config.Lineage
 	ValidateIsConfigured  bool
 	ValidateNoOpenChanges bool
+	ReparentOrphans       bool `exhaustruct:"optional"` // whether to make branches whose parent branch no longer exists children of their closest existing ancestor
 }
@@ -2,9 +2,13 @@ package execute
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/git-town/git-town/v9/src/cache"
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/commandlog"
 	"github.com/git-town/git-town/v9/src/config"
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/git"
@@ -15,11 +19,10 @@ import (
 )
 
 func OpenRepo(args OpenRepoArgs) (result OpenRepoResult, err error) {
-	var stats Statistics
-	if args.Debug {
-		stats = &statistics.CommandsRun{CommandsCount: 0}
-	} else {
-		stats = &statistics.None{}
+	stats := &statistics.Collector{
+		Debug:     args.Debug,
+		Profile:   args.Profile,
+		TraceFile: args.TraceFile,
 	}
 	backendRunner := subshell.BackendRunner{
 		Dir:     nil,
@@ -28,6 +31,7 @@ func OpenRepo(args OpenRepoArgs) (result OpenRepoResult, err error) {
 	}
 	backendCommands := git.BackendCommands{
 		BackendRunner:      backendRunner,
+		BranchInfosCache:   &cache.BranchInfos{},
 		Config:             nil, // initializing to nil here to validate the Git version before running any Git commands, setting to the correct value after that is done
 		CurrentBranchCache: &cache.LocalBranch{},
 		RemoteBranchCache:  &cache.RemoteBranch{},
@@ -47,19 +51,41 @@ func OpenRepo(args OpenRepoArgs) (result OpenRepoResult, err error) {
 		DryRun:  false, // to bootstrap this, DryRun always gets initialized as false and later enabled if needed
 	}
 	backendCommands.Config = &repoConfig
+	rootDir := backendCommands.RootDirectory()
+	if !rootDir.IsEmpty() {
+		err = repoConfig.LoadConfigFile(rootDir)
+		if err != nil {
+			// problems with the shared configuration file shouldn't block commands that don't need the affected settings
+			if !args.TolerateInvalidConfig {
+				cli.PrintWarning(fmt.Sprintf(messages.ConfigFileIgnored, err))
+			}
+			err = nil
+		}
+	}
+	_, err = repoConfig.PerennialRegex()
+	if err != nil {
+		if !args.TolerateInvalidConfig {
+			return
+		}
+		err = nil
+	}
+	commandLogEnabled := openCommandLog(repoConfig, rootDir, stats)
+	if !commandLogEnabled && !args.Debug && !args.Profile && args.TraceFile == "" {
+		stats.Disable()
+	}
 	prodRunner := git.ProdRunner{
 		Config:  repoConfig,
 		Backend: backendCommands,
 		Frontend: git.FrontendCommands{
-			FrontendRunner:         NewFrontendRunner(args.OmitBranchNames, args.DryRun, backendCommands.CurrentBranch, stats),
-			SetCachedCurrentBranch: backendCommands.CurrentBranchCache.Set,
+			FrontendRunner:             NewFrontendRunner(args.OmitBranchNames, args.DryRun, commandLogEnabled, backendCommands.CurrentBranch, stats),
+			InvalidateBranchInfosCache: backendCommands.BranchInfosCache.Invalidate,
+			SetCachedCurrentBranch:     backendCommands.CurrentBranchCache.Set,
 		},
 		Stats: stats,
 	}
 	if args.DryRun {
 		prodRunner.Config.DryRun = true
 	}
-	rootDir := backendCommands.RootDirectory()
 	if args.ValidateGitRepo {
 		if rootDir.IsEmpty() {
 			err = errors.New(messages.RepoOutside)
@@ -68,12 +94,22 @@ func OpenRepo(args OpenRepoArgs) (result OpenRepoResult, err error) {
 	}
 	isOffline, err := repoConfig.IsOffline()
 	if err != nil {
-		return
+		if !args.TolerateInvalidConfig {
+			return
+		}
+		err = nil
 	}
 	if args.ValidateIsOnline && isOffline {
 		err = errors.New(messages.OfflineNotAllowed)
 		return
 	}
+	shareLineage, err := repoConfig.ShouldShareLineage()
+	if err != nil {
+		if !args.TolerateInvalidConfig {
+			return
+		}
+		err = nil
+	}
 	if args.ValidateGitRepo {
 		var currentDirectory string
 		currentDirectory, err = os.Getwd()
@@ -86,28 +122,62 @@ func OpenRepo(args OpenRepoArgs) (result OpenRepoResult, err error) {
 		}
 	}
 	return OpenRepoResult{
-		Runner:    prodRunner,
-		RootDir:   rootDir,
-		IsOffline: isOffline,
+		CommandLogEnabled:        commandLogEnabled,
+		Runner:                   prodRunner,
+		RootDir:                  rootDir,
+		IsOffline:                isOffline,
+		ShareLineage:             shareLineage && !isOffline,
+		SupportsRebaseUpdateRefs: validate.SupportsRebaseUpdateRefs(majorVersion, minorVersion),
 	}, err
 }
 
 type OpenRepoArgs struct {
-	Debug            bool
-	DryRun           bool
-	OmitBranchNames  bool
-	ValidateGitRepo  bool
-	ValidateIsOnline bool
+	Debug           bool
+	DryRun          bool
+	OmitBranchNames bool
+	Profile         bool   `exhaustruct:"optional"`
+	TraceFile       string `exhaustruct:"optional"`
+	// whether to use the default values for invalid configuration entries instead of failing
+	TolerateInvalidConfig bool `exhaustruct:"optional"`
+	ValidateGitRepo       bool
+	ValidateIsOnline      bool
 }
 
 type OpenRepoResult struct {
-	Runner    git.ProdRunner
-	RootDir   domain.RepoRootDir
-	IsOffline bool
+	CommandLogEnabled        bool // whether Git Town records the commands it runs in the command log
+	Runner                   git.ProdRunner
+	RootDir                  domain.RepoRootDir
+	IsOffline                bool
+	ShareLineage             bool // whether to share the lineage through the origin repository
+	SupportsRebaseUpdateRefs bool // whether the installed Git version supports "git rebase --update-refs"
 }
 
 // NewFrontendRunner provides a FrontendRunner instance that behaves according to the given configuration.
-func NewFrontendRunner(omitBranchNames, dryRun bool, getCurrentBranch subshell.GetCurrentBranchFunc, stats Statistics) git.FrontendRunner {
+// openCommandLog makes the given statistics record all commands in the command log if the user has enabled it.
+// The command log is a diagnostic tool, so problems with it don't stop Git Town.
+// It prints them as warnings and leaves the command log disabled.
+func openCommandLog(repoConfig git.RepoConfig, rootDir domain.RepoRootDir, stats *statistics.Collector) bool {
+	if rootDir.IsEmpty() {
+		return false
+	}
+	enabled, err := repoConfig.IsCommandLogEnabled()
+	if err != nil {
+		cli.PrintWarning(fmt.Sprintf(messages.CommandLogDisabled, err))
+		return false
+	}
+	if !enabled {
+		return false
+	}
+	commandLog, err := commandlog.Open(rootDir, strings.Join(os.Args[1:], " "))
+	if err != nil {
+		cli.PrintWarning(fmt.Sprintf(messages.CommandLogDisabled, err))
+		return false
+	}
+	stats.EnableLog(commandLog)
+	return true
+}
+
+func NewFrontendRunner(omitBranchNames, dryRun, captureOutput bool, getCurrentBranch subshell.GetCurrentBranchFunc, stats Statistics) git.FrontendRunner {
 	if dryRun {
 		return &subshell.FrontendDryRunner{
 			GetCurrentBranch: getCurrentBranch,
@@ -116,6 +186,7 @@ func NewFrontendRunner(omitBranchNames, dryRun bool, getCurrentBranch subshell.G
 		}
 	}
 	return &subshell.FrontendRunner{
+		CaptureOutput:    captureOutput,
 		GetCurrentBranch: getCurrentBranch,
 		OmitBranchNames:  omitBranchNames,
 		Stats:            stats,
@@ -123,6 +194,8 @@ func NewFrontendRunner(omitBranchNames, dryRun bool, getCurrentBranch subshell.G
 }
 
 type Statistics interface {
-	RegisterRun()
+	FinishStep()
+	RegisterRun(statistics.CommandRun)
 	PrintAnalysis()
+	StartStep(name string)
 }
@@ -0,0 +1,20 @@
+package execute
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+)
+
+// assumeSharedLineage makes the given lineage and the in-memory configuration contain the parent branches
+// that the lineage shared through the origin repository provides, so that the Git Town command plans its steps with them.
+// The MergeSharedLineageStep stores these parent branches in the Git configuration.
+func assumeSharedLineage(repo *OpenRepoResult, lineage config.Lineage) error {
+	updates, _, _, err := repo.Runner.Backend.SharedLineageUpdates()
+	if err != nil {
+		return err
+	}
+	for _, branch := range updates.BranchNames() {
+		repo.Runner.Config.AssumeParent(branch, updates[branch])
+		lineage[branch] = updates[branch]
+	}
+	return nil
+}
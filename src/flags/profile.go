@@ -0,0 +1,38 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// profileWithoutTrace is the value of the "--profile" flag when it is given without a trace file.
+const profileWithoutTrace = "-"
+
+// Profile provides mistake-safe access to the "--profile" Cobra command-line flag.
+// The flag optionally takes the path of a file to export a Chrome trace to, as in "--profile=trace.json".
+func Profile() (AddFunc, ReadProfileFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().String("profile", "", "Print how long the Git commands took, \"--profile=<file>\" also exports a Chrome trace to the given file")
+		cmd.PersistentFlags().Lookup("profile").NoOptDefVal = profileWithoutTrace
+	}
+	readFlag := func(cmd *cobra.Command) (bool, string) {
+		value, err := cmd.Flags().GetString("profile")
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have a string %q flag", cmd.Name(), "profile"))
+		}
+		switch value {
+		case "":
+			return false, ""
+		case profileWithoutTrace:
+			return true, ""
+		default:
+			return true, value
+		}
+	}
+	return addFlag, readFlag
+}
+
+// ReadProfileFlagFunc defines the type signature for helper functions that provide the value of the "--profile" CLI flag associated with a Cobra command:
+// whether to profile and the file to export the Chrome trace to.
+type ReadProfileFlagFunc func(*cobra.Command) (profile bool, traceFile string)
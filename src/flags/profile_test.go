@@ -0,0 +1,49 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not given", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Profile()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		assert.NoError(t, err)
+		profile, traceFile := readFlag(&cmd)
+		assert.False(t, profile)
+		assert.Equal(t, "", traceFile)
+	})
+
+	t.Run("without trace file", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Profile()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--profile"})
+		assert.NoError(t, err)
+		profile, traceFile := readFlag(&cmd)
+		assert.True(t, profile)
+		assert.Equal(t, "", traceFile)
+	})
+
+	t.Run("with trace file", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Profile()
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--profile=trace.json"})
+		assert.NoError(t, err)
+		profile, traceFile := readFlag(&cmd)
+		assert.True(t, profile)
+		assert.Equal(t, "trace.json", traceFile)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/git-town/git-town/v9/src/cache"
+	"github.com/git-town/git-town/v9/src/config"
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/messages"
 	"github.com/git-town/git-town/v9/src/stringslice"
@@ -26,6 +27,7 @@ type BackendRunner interface {
 // They are invisible to the end user unless the "debug" option is set.
 type BackendCommands struct {
 	BackendRunner                          // executes shell commands in the directory of the Git repo
+	BranchInfosCache   *cache.BranchInfos  // caches the branches of this Git repo, invalidated by commands that change branches
 	Config             *RepoConfig         // the known state of the Git repository
 	CurrentBranchCache *cache.LocalBranch  // caches the currently checked out Git branch
 	RemoteBranchCache  *cache.RemoteBranch // caches the remote branches of this Git repo
@@ -72,105 +74,126 @@ func (bc *BackendCommands) BranchHasUnmergedCommits(branch domain.LocalBranchNam
 }
 
 // BranchInfos provides detailed information about the sync status of all branches.
-func (bc *BackendCommands) BranchInfos() (branches domain.BranchInfos, currentBranch domain.LocalBranchName, err error) { //nolint:nonamedreturns
-	output, err := bc.Query("git", "branch", "-vva")
+// It caches this information until a command changes the branches.
+func (bc *BackendCommands) BranchInfos() (domain.BranchInfos, domain.LocalBranchName, error) {
+	if !bc.BranchInfosCache.Initialized() {
+		branches, currentBranch, err := bc.BranchInfosUncached()
+		if err != nil {
+			return branches, currentBranch, err
+		}
+		bc.BranchInfosCache.Set(branches)
+		return branches, currentBranch, nil
+	}
+	currentBranch := domain.LocalBranchName{}
+	if bc.CurrentBranchCache.Initialized() {
+		currentBranch = bc.CurrentBranchCache.Value()
+	}
+	return bc.BranchInfosCache.Value(), currentBranch, nil
+}
+
+// BranchInfosUncached provides detailed information about the sync status of all branches
+// using a single "git for-each-ref" call.
+func (bc *BackendCommands) BranchInfosUncached() (branches domain.BranchInfos, currentBranch domain.LocalBranchName, err error) { //nolint:nonamedreturns
+	output, err := bc.Query("git", "for-each-ref", "--format="+forEachRefFormat, "refs/heads/", "refs/remotes/")
 	if err != nil {
 		return
 	}
-	branches, currentBranch = ParseVerboseBranchesOutput(output)
+	branches, currentBranch = ParseForEachRefOutput(output)
 	if !currentBranch.IsEmpty() {
 		bc.CurrentBranchCache.Set(currentBranch)
 	}
 	return branches, currentBranch, nil
 }
 
-// ParseVerboseBranchesOutput provides the branches in the given Git output as well as the name of the currently checked out branch.
-func ParseVerboseBranchesOutput(output string) (domain.BranchInfos, domain.LocalBranchName) {
+// forEachRefFormat is the output format of the "git for-each-ref" call in BranchInfosUncached.
+// Branch names cannot contain spaces, the tracking status comes last because it can.
+// The third field is "*" for the currently checked out branch and "-" for all other branches.
+const forEachRefFormat = "%(refname) %(objectname:short) %(if)%(HEAD)%(then)*%(else)-%(end) %(upstream:short) %(upstream:track,nobracket)"
+
+// ParseForEachRefOutput provides the branches in the given Git output as well as the name of the currently checked out branch.
+// The output must be in the forEachRefFormat.
+func ParseForEachRefOutput(output string) (domain.BranchInfos, domain.LocalBranchName) {
 	result := domain.BranchInfos{}
-	spaceRE := regexp.MustCompile(" +")
-	lines := stringslice.Lines(output)
 	checkedoutBranch := domain.LocalBranchName{}
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		parts := spaceRE.Split(line[2:], 3)
-		if parts[0] == "remotes/origin/HEAD" {
+	for _, line := range stringslice.Lines(output) {
+		parts := strings.SplitN(strings.TrimSpace(line), " ", 5)
+		if len(parts) < 2 {
 			continue
 		}
-		branchName := parts[0]
-		var sha domain.SHA
-		if parts[1] != "branch," {
-			sha = domain.NewSHA(parts[1])
-		} else {
-			// we are rebasing and don't need the SHA
-			sha = domain.SHA{}
+		for len(parts) < 5 {
+			parts = append(parts, "")
 		}
-		remoteText := parts[2]
-		if line[0] == '*' && branchName != "(no" { // "(no" as in "(no branch, rebasing main)" is what we get when a rebase is active, in which case no branch is checked out
-			checkedoutBranch = domain.NewLocalBranchName(branchName)
-		}
-		syncStatus, trackingBranchName := determineSyncStatus(branchName, remoteText)
-		if isLocalBranchName(branchName) {
+		refName, sha, head, upstream, track := parts[0], domain.NewSHA(parts[1]), parts[2], parts[3], parts[4]
+		if localName, isLocal := strings.CutPrefix(refName, "refs/heads/"); isLocal {
+			if head == "*" {
+				checkedoutBranch = domain.NewLocalBranchName(localName)
+			}
+			syncStatus, ahead, behind := determineSyncStatus(upstream, track)
+			remoteName := domain.RemoteBranchName{}
+			if upstream != "" {
+				remoteName = domain.NewRemoteBranchName(upstream)
+			}
 			result = append(result, domain.BranchInfo{
-				LocalName:  domain.NewLocalBranchName(branchName),
+				LocalName:  domain.NewLocalBranchName(localName),
 				LocalSHA:   sha,
 				SyncStatus: syncStatus,
-				RemoteName: trackingBranchName,
+				RemoteName: remoteName,
 				RemoteSHA:  domain.SHA{}, // will be added later
+				Ahead:      ahead,
+				Behind:     behind,
 			})
+			continue
+		}
+		remoteName := strings.TrimPrefix(refName, "refs/remotes/")
+		if strings.HasSuffix(remoteName, "/HEAD") {
+			continue
+		}
+		remoteBranchName := domain.NewRemoteBranchName(remoteName)
+		existingBranchWithTracking := result.FindByRemote(remoteBranchName)
+		if existingBranchWithTracking != nil {
+			existingBranchWithTracking.RemoteSHA = sha
 		} else {
-			remoteBranchName := domain.NewRemoteBranchName(strings.TrimPrefix(branchName, "remotes/"))
-			existingBranchWithTracking := result.FindByRemote(remoteBranchName)
-			if existingBranchWithTracking != nil {
-				existingBranchWithTracking.RemoteSHA = sha
-			} else {
-				result = append(result, domain.BranchInfo{
-					LocalName:  domain.LocalBranchName{},
-					LocalSHA:   domain.SHA{},
-					SyncStatus: domain.SyncStatusRemoteOnly,
-					RemoteName: remoteBranchName,
-					RemoteSHA:  sha,
-				})
-			}
+			result = append(result, domain.BranchInfo{
+				LocalName:  domain.LocalBranchName{},
+				LocalSHA:   domain.SHA{},
+				SyncStatus: domain.SyncStatusRemoteOnly,
+				RemoteName: remoteBranchName,
+				RemoteSHA:  sha,
+			})
 		}
 	}
 	return result, checkedoutBranch
 }
 
-func determineSyncStatus(branchName, remoteText string) (syncStatus domain.SyncStatus, trackingBranchName domain.RemoteBranchName) {
-	if remoteText[0] == '[' {
-		closingBracketPos := strings.IndexRune(remoteText, ']')
-		textInBrackets := remoteText[1:closingBracketPos]
-		trackingBranchContent, remoteStatus, _ := strings.Cut(textInBrackets, ": ")
-		trackingBranchName := domain.NewRemoteBranchName(trackingBranchContent)
-		if remoteStatus == "" {
-			return domain.SyncStatusUpToDate, trackingBranchName
-		}
-		if remoteStatus == "gone" {
-			return domain.SyncStatusDeletedAtRemote, trackingBranchName
-		}
-		if strings.Contains(remoteStatus, ", behind ") {
-			return domain.SyncStatusAheadAndBehind, trackingBranchName
-		}
-		if strings.HasPrefix(remoteStatus, "ahead ") {
-			return domain.SyncStatusAhead, trackingBranchName
-		}
-		if strings.HasPrefix(remoteStatus, "behind ") {
-			return domain.SyncStatusBehind, trackingBranchName
+// determineSyncStatus provides the sync status and the number of commits ahead and behind
+// for a local branch with the given upstream and "%(upstream:track,nobracket)" output.
+func determineSyncStatus(upstream, track string) (syncStatus domain.SyncStatus, ahead, behind int) { //nolint:nonamedreturns
+	if upstream == "" {
+		return domain.SyncStatusLocalOnly, 0, 0
+	}
+	if track == "" {
+		return domain.SyncStatusUpToDate, 0, 0
+	}
+	if track == "gone" {
+		return domain.SyncStatusDeletedAtRemote, 0, 0
+	}
+	for _, part := range strings.Split(track, ", ") {
+		if count, isAhead := strings.CutPrefix(part, "ahead "); isAhead {
+			ahead, _ = strconv.Atoi(count)
 		}
-		panic(fmt.Sprintf("cannot determine the sync status for Git remote %q and branch name %q", remoteText, branchName))
-	} else {
-		if strings.HasPrefix(branchName, "remotes/origin/") {
-			return domain.SyncStatusRemoteOnly, domain.RemoteBranchName{}
+		if count, isBehind := strings.CutPrefix(part, "behind "); isBehind {
+			behind, _ = strconv.Atoi(count)
 		}
-		return domain.SyncStatusLocalOnly, domain.RemoteBranchName{}
 	}
-}
-
-// isLocalBranchName indicates whether the branch with the given Git ref is local or remote.
-func isLocalBranchName(branch string) bool {
-	return !strings.HasPrefix(branch, "remotes/")
+	switch {
+	case ahead > 0 && behind > 0:
+		return domain.SyncStatusAheadAndBehind, ahead, behind
+	case ahead > 0:
+		return domain.SyncStatusAhead, ahead, behind
+	case behind > 0:
+		return domain.SyncStatusBehind, ahead, behind
+	}
+	panic(fmt.Sprintf("cannot determine the sync status for Git remote %q and tracking status %q", upstream, track))
 }
 
 // CheckoutBranch checks out the Git branch with the given name.
@@ -198,10 +221,26 @@ func (bc *BackendCommands) CheckoutBranch(name domain.LocalBranchName) error {
 	return nil
 }
 
+// mergeMessageFile is the file in which Git stores the commit message for the current merge.
+const mergeMessageFile = ".git/MERGE_MSG"
+
+// AppendToMergeMessage appends the given text as a separate paragraph to the commit message for the current merge.
+func (bc *BackendCommands) AppendToMergeMessage(text string) error {
+	contentBytes, err := os.ReadFile(mergeMessageFile)
+	if err != nil {
+		return fmt.Errorf(messages.MergeCannotReadFile, mergeMessageFile, err)
+	}
+	content := strings.TrimRight(string(contentBytes), "\n") + "\n\n" + text + "\n"
+	return os.WriteFile(mergeMessageFile, []byte(content), 0o600)
+}
+
+// squashMessageFile is the file in which Git stores the commit message for the current squash merge.
+const squashMessageFile = ".git/SQUASH_MSG"
+
 // CommentOutSquashCommitMessage comments out the message for the current squash merge
 // Adds the given prefix with the newline if provided.
-func (bc *BackendCommands) CommentOutSquashCommitMessage(prefix string) error {
-	squashMessageFile := ".git/SQUASH_MSG"
+// Appends the given suffix without commenting it out if provided.
+func (bc *BackendCommands) CommentOutSquashCommitMessage(prefix, suffix string) error {
 	contentBytes, err := os.ReadFile(squashMessageFile)
 	if err != nil {
 		return fmt.Errorf(messages.SquashCannotReadFile, squashMessageFile, err)
@@ -211,9 +250,46 @@ func (bc *BackendCommands) CommentOutSquashCommitMessage(prefix string) error {
 		content = prefix + "\n" + content
 	}
 	content = regexp.MustCompile("(?m)^").ReplaceAllString(content, "# ")
+	if suffix != "" {
+		content += "\n" + suffix + "\n"
+	}
 	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
 }
 
+// CommitMessagesInBranch provides the subjects of the commits that the given branch has and the given parent branch doesn't, oldest first.
+// Merge commits are not included.
+func (bc *BackendCommands) CommitMessagesInBranch(branch, parent domain.LocalBranchName) ([]string, error) {
+	output, err := bc.QueryTrim("git", "log", "--reverse", "--no-merges", "--format=%s", parent.String()+".."+branch.String())
+	if err != nil {
+		return []string{}, fmt.Errorf(messages.CommitMessagesProblem, branch, err)
+	}
+	if output == "" {
+		return []string{}, nil
+	}
+	return stringslice.Lines(output), nil
+}
+
+// CommitsAheadAndBehind provides how many commits the given branch has that the given parent branch doesn't have, and vice versa.
+func (bc *BackendCommands) CommitsAheadAndBehind(branch, parent domain.LocalBranchName) (ahead, behind int, err error) {
+	output, err := bc.QueryTrim("git", "rev-list", "--left-right", "--count", branch.String()+"..."+parent.String())
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.BranchAheadBehindProblem, branch, parent, err)
+	}
+	parts := strings.Fields(output)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf(messages.BranchAheadBehindUnexpectedOutput, branch, parent, output)
+	}
+	ahead, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.BranchAheadBehindUnexpectedOutput, branch, parent, output)
+	}
+	behind, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.BranchAheadBehindUnexpectedOutput, branch, parent, output)
+	}
+	return ahead, behind, nil
+}
+
 func (bc *BackendCommands) CommitsInBranch(branch, parent domain.LocalBranchName) (domain.SHAs, error) {
 	if parent.IsEmpty() {
 		return bc.CommitsInPerennialBranch()
@@ -249,12 +325,25 @@ func (bc *BackendCommands) CommitsInPerennialBranch() (domain.SHAs, error) {
 	return result, nil
 }
 
+// ConflictingFiles provides the names of the files that currently have unresolved merge conflicts.
+func (bc *BackendCommands) ConflictingFiles() ([]string, error) {
+	output, err := bc.QueryTrim("git", "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return []string{}, fmt.Errorf(messages.ConflictDetectionProblem, err)
+	}
+	if output == "" {
+		return []string{}, nil
+	}
+	return strings.Split(output, "\n"), nil
+}
+
 // CreateFeatureBranch creates a feature branch with the given name in this repository.
 func (bc *BackendCommands) CreateFeatureBranch(name domain.LocalBranchName) error {
 	err := bc.RunMany([][]string{
 		{"git", "branch", name.String(), "main"},
 		{"git", "config", "git-town-branch." + name.String() + ".parent", "main"},
 	})
+	bc.BranchInfosCache.Invalidate()
 	if err != nil {
 		return fmt.Errorf(messages.BranchFeatureCannotCreate, name, err)
 	}
@@ -318,11 +407,16 @@ func (bc *BackendCommands) CurrentSHA() (domain.SHA, error) {
 // ExpectedPreviouslyCheckedOutBranch returns what is the expected previously checked out branch
 // given the inputs.
 func (bc *BackendCommands) ExpectedPreviouslyCheckedOutBranch(initialPreviouslyCheckedOutBranch, initialBranch, mainBranch domain.LocalBranchName) (domain.LocalBranchName, error) {
-	// TODO: try to avoid repeated lookups to bc.HasLocalBranch
-	if bc.HasLocalBranch(initialPreviouslyCheckedOutBranch) {
-		currentBranch, err := bc.CurrentBranch()
-		if err != nil {
-			return domain.LocalBranchName{}, err
+	branches, currentBranch, err := bc.BranchInfos()
+	if err != nil {
+		return domain.LocalBranchName{}, err
+	}
+	if branches.HasLocalBranch(initialPreviouslyCheckedOutBranch) {
+		if currentBranch.IsEmpty() {
+			currentBranch, err = bc.CurrentBranch()
+			if err != nil {
+				return domain.LocalBranchName{}, err
+			}
 		}
 		if currentBranch == initialBranch {
 			return initialPreviouslyCheckedOutBranch, nil
@@ -330,7 +424,7 @@ func (bc *BackendCommands) ExpectedPreviouslyCheckedOutBranch(initialPreviouslyC
 		if initialBranch == initialPreviouslyCheckedOutBranch {
 			return initialBranch, nil
 		}
-		if bc.HasLocalBranch(initialBranch) {
+		if branches.HasLocalBranch(initialBranch) {
 			return initialBranch, nil
 		}
 		return initialPreviouslyCheckedOutBranch, nil
@@ -338,6 +432,24 @@ func (bc *BackendCommands) ExpectedPreviouslyCheckedOutBranch(initialPreviouslyC
 	return mainBranch, nil
 }
 
+// FirstParentCommits provides the commits in the given revision range
+// that are on the first-parent history of its end, oldest first.
+func (bc *BackendCommands) FirstParentCommits(revisionRange string) ([]Commit, error) {
+	output, err := bc.Query("git", "log", "--first-parent", "--reverse", "--format=%h%x1f%B%x1e", revisionRange)
+	if err != nil {
+		return []Commit{}, fmt.Errorf(messages.CommitsInRangeProblem, revisionRange, err)
+	}
+	result := []Commit{}
+	for _, record := range strings.Split(output, "\x1e") {
+		sha, message, found := strings.Cut(strings.TrimSpace(record), "\x1f")
+		if !found {
+			continue
+		}
+		result = append(result, Commit{Message: message, SHA: domain.NewSHA(sha)})
+	}
+	return result, nil
+}
+
 // HasConflicts returns whether the local repository currently has unresolved merge conflicts.
 func (bc *BackendCommands) HasConflicts() (bool, error) {
 	output, err := bc.QueryTrim("git", "status")
@@ -347,6 +459,11 @@ func (bc *BackendCommands) HasConflicts() (bool, error) {
 	return strings.Contains(output, "Unmerged paths"), nil
 }
 
+// HasCommit indicates whether this repo contains the commit with the given SHA.
+func (bc *BackendCommands) HasCommit(sha domain.SHA) bool {
+	return bc.Run("git", "cat-file", "-e", sha.String()+"^{commit}") == nil
+}
+
 // HasLocalBranch indicates whether this repo has a local branch with the given name.
 func (bc *BackendCommands) HasLocalBranch(name domain.LocalBranchName) bool {
 	return bc.Run("git", "show-ref", "--quiet", "refs/heads/"+name.String()) == nil
@@ -392,6 +509,15 @@ func (bc *BackendCommands) HasShippableChanges(branch, mainBranch domain.LocalBr
 	return out != "", nil
 }
 
+// IsAncestor indicates whether the given commit is part of the history of the given branch.
+func (bc *BackendCommands) IsAncestor(commit domain.SHA, branch domain.BranchName) (bool, error) {
+	out, err := bc.QueryTrim("git", "rev-list", "--count", branch.String()+".."+commit.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.CommitAncestorProblem, commit, branch, err)
+	}
+	return out == "0", nil
+}
+
 // LastCommitMessage provides the commit message for the last commit.
 func (bc *BackendCommands) LastCommitMessage() (string, error) {
 	out, err := bc.QueryTrim("git", "log", "-1", "--format=%B")
@@ -435,21 +561,56 @@ func (bc *BackendCommands) Remotes() (domain.Remotes, error) {
 }
 
 // RemoveOutdatedConfiguration removes outdated Git Town configuration.
-func (bc *BackendCommands) RemoveOutdatedConfiguration(allBranches domain.BranchInfos) error {
-	for child, parent := range bc.Config.Lineage() {
+// With reparentOrphans, branches whose parent branch no longer exists, for example because it was shipped,
+// become children of their closest ancestor branch that still exists,
+// so that syncing them can move their commits onto that branch.
+func (bc *BackendCommands) RemoveOutdatedConfiguration(allBranches domain.BranchInfos, reparentOrphans bool) error {
+	lineage := bc.Config.Lineage()
+	for child, parent := range lineage {
 		hasChildBranch := allBranches.HasLocalBranch(child)
-		hasParentBranch := allBranches.HasLocalBranch(parent)
-		if !hasChildBranch || !hasParentBranch {
-			// TODO
-			err := bc.Config.RemoveParent(child)
-			if err != nil {
-				return err
+		if hasChildBranch && allBranches.HasLocalBranch(parent) {
+			continue
+		}
+		if reparentOrphans && hasChildBranch {
+			newParent := closestExistingAncestor(child, lineage, allBranches)
+			if !newParent.IsEmpty() {
+				err := bc.Config.SetParent(child, newParent)
+				if err != nil {
+					return err
+				}
+				continue
 			}
 		}
+		err := bc.Config.RemoveParent(child)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// closestExistingAncestor provides the closest ancestor of the given branch that exists locally.
+func closestExistingAncestor(branch domain.LocalBranchName, lineage config.Lineage, allBranches domain.BranchInfos) domain.LocalBranchName {
+	ancestors := lineage.Ancestors(branch)
+	for a := len(ancestors) - 1; a >= 0; a-- {
+		if allBranches.HasLocalBranch(ancestors[a]) {
+			return ancestors[a]
+		}
+	}
+	return domain.LocalBranchName{}
+}
+
+// RemoteBranchHasSHA indicates whether the given branch at origin still has the given SHA.
+// This queries origin because the tracking branch might be outdated.
+func (bc *BackendCommands) RemoteBranchHasSHA(branch domain.LocalBranchName, sha domain.SHA) (bool, error) {
+	output, err := bc.QueryTrim("git", "ls-remote", domain.OriginRemote.String(), "refs/heads/"+branch.String())
+	if err != nil {
+		return false, err
+	}
+	fields := strings.Fields(output)
+	return len(fields) > 0 && strings.HasPrefix(fields[0], sha.String()), nil
+}
+
 // RootDirectory provides the path of the rood directory of the current repository,
 // i.e. the directory that contains the ".git" folder.
 func (bc *BackendCommands) RootDirectory() domain.RepoRootDir {
@@ -469,6 +630,54 @@ func (bc *BackendCommands) SHAForBranch(name domain.BranchName) (domain.SHA, err
 	return domain.NewSHA(output), nil
 }
 
+// SetSharedLineageRef points the given shared lineage ref to the blob with the given SHA.
+// An empty SHA removes the ref.
+func (bc *BackendCommands) SetSharedLineageRef(ref string, sha domain.SHA) error {
+	if sha.IsEmpty() {
+		return bc.Run("git", "update-ref", "-d", ref)
+	}
+	return bc.Run("git", "update-ref", ref, sha.String())
+}
+
+// SharedLineage provides the lineage stored in the given shared lineage ref and the SHA of the blob containing it.
+// If the ref doesn't exist, it provides an empty lineage.
+func (bc *BackendCommands) SharedLineage(ref string) (config.Lineage, domain.SHA, error) {
+	sha, err := bc.QueryTrim("git", "rev-parse", "--verify", "--quiet", ref)
+	if err != nil || sha == "" {
+		return config.Lineage{}, domain.SHA{}, nil //nolint:nilerr // the ref doesn't exist
+	}
+	content, err := bc.Query("git", "cat-file", "blob", sha)
+	if err != nil {
+		return config.Lineage{}, domain.SHA{}, fmt.Errorf(messages.SharedLineageReadProblem, ref, err)
+	}
+	return config.ParseSharedLineage(content), domain.NewSHA(sha), nil
+}
+
+// SharedLineageUpdates determines how the lineage fetched from the origin repository updates the local lineage.
+// It provides the parents to set locally, the conflicting parents, and the SHA of the fetched shared lineage,
+// which is empty if the origin repository doesn't share a lineage.
+func (bc *BackendCommands) SharedLineageUpdates() (config.Lineage, []config.SharedLineageConflict, domain.SHA, error) {
+	remote, remoteSHA, err := bc.SharedLineage(config.SharedLineageTrackingRef)
+	if err != nil || remoteSHA.IsEmpty() {
+		return config.Lineage{}, []config.SharedLineageConflict{}, remoteSHA, err
+	}
+	base, _, err := bc.SharedLineage(config.SharedLineageRef)
+	if err != nil {
+		return config.Lineage{}, []config.SharedLineageConflict{}, remoteSHA, err
+	}
+	branches, _, err := bc.BranchInfos()
+	if err != nil {
+		return config.Lineage{}, []config.SharedLineageConflict{}, remoteSHA, err
+	}
+	updates, conflicts := config.MergeSharedLineage(config.MergeSharedLineageArgs{
+		Base:          base,
+		Local:         bc.Config.Lineage(),
+		LocalBranches: branches.LocalBranches().Names(),
+		Remote:        remote,
+	})
+	return updates, conflicts, remoteSHA, nil
+}
+
 // ShouldPushBranch returns whether the local branch with the given name
 // contains commits that have not been pushed to its tracking branch.
 func (bc *BackendCommands) ShouldPushBranch(branch domain.LocalBranchName, trackingBranch domain.RemoteBranchName) (bool, error) {
@@ -479,6 +688,30 @@ func (bc *BackendCommands) ShouldPushBranch(branch domain.LocalBranchName, track
 	return out != "", nil
 }
 
+// StoreSharedLineage stores the given lineage as a blob in the Git object database
+// and provides the SHA of this blob.
+func (bc *BackendCommands) StoreSharedLineage(lineage config.Lineage) (domain.SHA, error) {
+	file, err := os.CreateTemp("", "git-town-lineage")
+	if err != nil {
+		return domain.SHA{}, fmt.Errorf(messages.SharedLineageStoreProblem, err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(config.SharedLineageContent(lineage))
+	if err != nil {
+		file.Close()
+		return domain.SHA{}, fmt.Errorf(messages.SharedLineageStoreProblem, err)
+	}
+	err = file.Close()
+	if err != nil {
+		return domain.SHA{}, fmt.Errorf(messages.SharedLineageStoreProblem, err)
+	}
+	sha, err := bc.QueryTrim("git", "hash-object", "-w", file.Name())
+	if err != nil {
+		return domain.SHA{}, fmt.Errorf(messages.SharedLineageStoreProblem, err)
+	}
+	return domain.NewSHA(sha), nil
+}
+
 // Version indicates whether the needed Git version is installed.
 func (bc *BackendCommands) Version() (major int, minor int, err error) {
 	versionRegexp := regexp.MustCompile(`git version (\d+).(\d+).(\d+)`)
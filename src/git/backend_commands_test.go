@@ -40,6 +40,19 @@ func TestBackendCommands(t *testing.T) {
 		assert.Equal(t, []string{"user <email@example.com>"}, authors)
 	})
 
+	t.Run("BranchInfos", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := domain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial)
+		branches, currentBranch, err := runtime.Backend.BranchInfos()
+		assert.NoError(t, err)
+		assert.Equal(t, initial, currentBranch)
+		assert.True(t, branches.HasLocalBranch(branch))
+		assert.True(t, branches.HasLocalBranch(initial))
+		assert.Equal(t, domain.SyncStatusLocalOnly, branches.FindLocalBranch(branch).SyncStatus)
+	})
+
 	t.Run("CheckoutBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -54,6 +67,57 @@ func TestBackendCommands(t *testing.T) {
 		assert.Equal(t, initial, currentBranch)
 	})
 
+	t.Run("CommitMessagesInBranch", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := domain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial)
+		messages, err := runtime.Backend.CommitMessagesInBranch(branch, initial)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, messages)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileName:    "file1",
+			FileContent: "file1",
+			Message:     "first commit",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileName:    "file2",
+			FileContent: "file2",
+			Message:     "second commit",
+		})
+		messages, err = runtime.Backend.CommitMessagesInBranch(branch, initial)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first commit", "second commit"}, messages)
+	})
+
+	t.Run("CommitsAheadAndBehind", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch1 := domain.NewLocalBranchName("branch1")
+		runtime.CreateBranch(branch1, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch1,
+			Message:  "branch commit 1",
+			FileName: "file1",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch1,
+			Message:  "branch commit 2",
+			FileName: "file2",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   initial,
+			Message:  "main commit",
+			FileName: "file3",
+		})
+		ahead, behind, err := runtime.BackendCommands.CommitsAheadAndBehind(branch1, initial)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, ahead)
+		assert.Equal(t, 1, behind)
+	})
+
 	t.Run("CommitsInBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("feature branch contains commits", func(t *testing.T) {
@@ -121,6 +185,14 @@ func TestBackendCommands(t *testing.T) {
 		assert.Equal(t, lineageWant, lineageHave)
 	})
 
+	t.Run("ConflictingFiles", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		files, err := runtime.Backend.ConflictingFiles()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, files)
+	})
+
 	t.Run("CurrentBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -136,6 +208,42 @@ func TestBackendCommands(t *testing.T) {
 		assert.Equal(t, initial, branch)
 	})
 
+	t.Run("FirstParentCommits", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateBranch(domain.NewLocalBranchName("old"), initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      initial,
+			FileName:    "file1",
+			FileContent: "file1",
+			Message:     "first commit",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      initial,
+			FileName:    "file2",
+			FileContent: "file2",
+			Message:     "second commit",
+		})
+		commits, err := runtime.Backend.FirstParentCommits("old..initial")
+		assert.NoError(t, err)
+		assert.Len(t, commits, 2)
+		assert.Equal(t, "first commit", commits[0].Message)
+		assert.Equal(t, "second commit", commits[1].Message)
+		assert.Equal(t, runtime.SHAForCommit("first commit"), commits[0].SHA.String())
+	})
+
+	t.Run("HasCommit", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   initial,
+			Message:  "commit",
+			FileName: "file1",
+		})
+		assert.True(t, runtime.Backend.HasCommit(domain.NewSHA(runtime.SHAForCommit("commit"))))
+		assert.False(t, runtime.Backend.HasCommit(domain.NewSHA("1234567890123456789012345678901234567890")))
+	})
+
 	t.Run("HasLocalBranch", func(t *testing.T) {
 		t.Parallel()
 		origin := testruntime.Create(t)
@@ -168,33 +276,59 @@ func TestBackendCommands(t *testing.T) {
 		assert.False(t, has)
 	})
 
-	t.Run("ParseVerboseBranchesOutput", func(t *testing.T) {
+	t.Run("IsAncestor", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch1 := domain.NewLocalBranchName("branch1")
+		runtime.CreateBranch(branch1, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch1,
+			Message:  "branch commit",
+			FileName: "file1",
+		})
+		branchCommit := domain.NewSHA(runtime.SHAForCommit("branch commit"))
+		isAncestor, err := runtime.BackendCommands.IsAncestor(branchCommit, branch1.BranchName())
+		assert.NoError(t, err)
+		assert.True(t, isAncestor)
+		isAncestor, err = runtime.BackendCommands.IsAncestor(branchCommit, initial.BranchName())
+		assert.NoError(t, err)
+		assert.False(t, isAncestor)
+	})
+
+	t.Run("ParseForEachRefOutput", func(t *testing.T) {
 		t.Parallel()
 		t.Run("recognizes the current branch", func(t *testing.T) {
 			t.Run("marker is at the first entry", func(t *testing.T) {
 				give := `
-* branch-1                     01a7eded [origin/branch-1: ahead 1] Commit message 1
-  branch-2                     da796a69 [origin/branch-2] Commit message 2
-  branch-3                     f4ebec0a [origin/branch-3: behind 2] Commit message 3a`[1:]
-				_, currentBranch := git.ParseVerboseBranchesOutput(give)
+refs/heads/branch-1 01a7eded * origin/branch-1 ahead 1
+refs/heads/branch-2 da796a69 - origin/branch-2
+refs/heads/branch-3 f4ebec0a - origin/branch-3 behind 2`[1:]
+				_, currentBranch := git.ParseForEachRefOutput(give)
 				assert.Equal(t, domain.NewLocalBranchName("branch-1"), currentBranch)
 			})
 			t.Run("marker is at the middle entry", func(t *testing.T) {
 				give := `
-  branch-1                     01a7eded [origin/branch-1: ahead 1] Commit message 1
-* branch-2                     da796a69 [origin/branch-2] Commit message 2
-  branch-3                     f4ebec0a [origin/branch-3: behind 2] Commit message 3a`[1:]
-				_, currentBranch := git.ParseVerboseBranchesOutput(give)
+refs/heads/branch-1 01a7eded - origin/branch-1 ahead 1
+refs/heads/branch-2 da796a69 * origin/branch-2
+refs/heads/branch-3 f4ebec0a - origin/branch-3 behind 2`[1:]
+				_, currentBranch := git.ParseForEachRefOutput(give)
 				assert.Equal(t, domain.NewLocalBranchName("branch-2"), currentBranch)
 			})
 			t.Run("marker is at the last entry", func(t *testing.T) {
 				give := `
-  branch-1                     01a7eded [origin/branch-1: ahead 1] Commit message 1
-  branch-2                     da796a69 [origin/branch-2] Commit message 2
-* branch-3                     f4ebec0a [origin/branch-3: behind 2] Commit message 3a`[1:]
-				_, currentBranch := git.ParseVerboseBranchesOutput(give)
+refs/heads/branch-1 01a7eded - origin/branch-1 ahead 1
+refs/heads/branch-2 da796a69 - origin/branch-2
+refs/heads/branch-3 f4ebec0a * origin/branch-3 behind 2`[1:]
+				_, currentBranch := git.ParseForEachRefOutput(give)
 				assert.Equal(t, domain.NewLocalBranchName("branch-3"), currentBranch)
 			})
+			t.Run("no branch is checked out during a rebase", func(t *testing.T) {
+				give := `
+refs/heads/branch-1 01a7eded - origin/branch-1 ahead 1
+refs/heads/branch-2 da796a69 - origin/branch-2`[1:]
+				_, currentBranch := git.ParseForEachRefOutput(give)
+				assert.Equal(t, domain.LocalBranchName{}, currentBranch)
+			})
 		})
 
 		t.Run("recognizes the branch sync status", func(t *testing.T) {
@@ -202,8 +336,8 @@ func TestBackendCommands(t *testing.T) {
 			t.Run("branch is ahead of its remote branch", func(t *testing.T) {
 				t.Parallel()
 				give := `
-  branch-1                     111111 [origin/branch-1: ahead 1] Commit message 1a
-  remotes/origin/branch-1      222222 Commit message 1b`[1:]
+refs/heads/branch-1 111111 - origin/branch-1 ahead 1
+refs/remotes/origin/branch-1 222222 -`[1:]
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -211,17 +345,18 @@ func TestBackendCommands(t *testing.T) {
 						SyncStatus: domain.SyncStatusAhead,
 						RemoteName: domain.NewRemoteBranchName("origin/branch-1"),
 						RemoteSHA:  domain.NewSHA("222222"),
+						Ahead:      1,
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("branch is behind its remote branch", func(t *testing.T) {
 				t.Parallel()
 				give := `
-  branch-1                     111111 [origin/branch-1: behind 2] Commit message 1
-  remotes/origin/branch-1      222222 Commit message 1b`[1:]
+refs/heads/branch-1 111111 - origin/branch-1 behind 2
+refs/remotes/origin/branch-1 222222 -`[1:]
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -229,17 +364,18 @@ func TestBackendCommands(t *testing.T) {
 						SyncStatus: domain.SyncStatusBehind,
 						RemoteName: domain.NewRemoteBranchName("origin/branch-1"),
 						RemoteSHA:  domain.NewSHA("222222"),
+						Behind:     2,
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("branch is ahead and behind its remote branch", func(t *testing.T) {
 				t.Parallel()
 				give := `
-  branch-1                     111111 [origin/branch-1: ahead 31, behind 2] Commit message 1a
-  remotes/origin/branch-1      222222 Commit message 1b`[1:]
+refs/heads/branch-1 111111 - origin/branch-1 ahead 31, behind 2
+refs/remotes/origin/branch-1 222222 -`[1:]
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -247,17 +383,19 @@ func TestBackendCommands(t *testing.T) {
 						SyncStatus: domain.SyncStatusAheadAndBehind,
 						RemoteName: domain.NewRemoteBranchName("origin/branch-1"),
 						RemoteSHA:  domain.NewSHA("222222"),
+						Ahead:      31,
+						Behind:     2,
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("branch is in sync with its remote branch", func(t *testing.T) {
 				t.Parallel()
 				give := `
-  branch-1                     111111 [origin/branch-1] Commit message 1
-  remotes/origin/branch-1      111111 Commit message 1`[1:]
+refs/heads/branch-1 111111 - origin/branch-1
+refs/remotes/origin/branch-1 111111 -`[1:]
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -267,14 +405,13 @@ func TestBackendCommands(t *testing.T) {
 						RemoteSHA:  domain.NewSHA("111111"),
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("remote-only branch", func(t *testing.T) {
 				t.Parallel()
-				give := `
-  remotes/origin/branch-1    222222 Commit message 2`[1:]
+				give := `refs/remotes/origin/branch-1 222222 -`
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.LocalBranchName{},
@@ -284,13 +421,13 @@ func TestBackendCommands(t *testing.T) {
 						RemoteSHA:  domain.NewSHA("222222"),
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("local-only branch", func(t *testing.T) {
 				t.Parallel()
-				give := `  branch-1                     01a7eded Commit message 1`
+				give := `refs/heads/branch-1 01a7eded -`
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -300,13 +437,13 @@ func TestBackendCommands(t *testing.T) {
 						RemoteSHA:  domain.SHA{},
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 
 			t.Run("branch is deleted at the remote", func(t *testing.T) {
 				t.Parallel()
-				give := `  branch-1                     01a7eded [origin/branch-1: gone] Commit message 1`
+				give := `refs/heads/branch-1 01a7eded - origin/branch-1 gone`
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -316,7 +453,7 @@ func TestBackendCommands(t *testing.T) {
 						RemoteSHA:  domain.SHA{},
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 		})
@@ -324,9 +461,9 @@ func TestBackendCommands(t *testing.T) {
 		t.Run("branch with a different tracking branch name", func(t *testing.T) {
 			t.Run("a branch uses a differently named tracking branch", func(t *testing.T) {
 				give := `
-  branch-1                     111111 [origin/branch-2] Commit message 1
-  remotes/origin/branch-1      222222 Commit message 2
-  remotes/origin/branch-2      111111 Commit message 1`[1:]
+refs/heads/branch-1 111111 - origin/branch-2
+refs/remotes/origin/branch-1 222222 -
+refs/remotes/origin/branch-2 111111 -`[1:]
 				want := domain.BranchInfos{
 					domain.BranchInfo{
 						LocalName:  domain.NewLocalBranchName("branch-1"),
@@ -343,23 +480,23 @@ func TestBackendCommands(t *testing.T) {
 						RemoteSHA:  domain.NewSHA("222222"),
 					},
 				}
-				have, _ := git.ParseVerboseBranchesOutput(give)
+				have, _ := git.ParseForEachRefOutput(give)
 				assert.Equal(t, want, have)
 			})
 		})
 
 		t.Run("complex example", func(t *testing.T) {
 			give := `
-  branch-1                     01a7eded [origin/branch-1: ahead 1] Commit message 1a
-* branch-2                     da796a69 [origin/branch-2] Commit message 2
-  branch-3                     f4ebec0a [origin/branch-3: behind 2] Commit message 3a
-  main                         024df944 [origin/main] Commit message on main (#1234)
-  branch-4                     e4d6bc09 [origin/branch-4: gone] Commit message 4
-  remotes/origin/branch-1      307a7bf4 Commit message 1b
-  remotes/origin/branch-2      da796a69 Commit message 2
-  remotes/origin/branch-3      bc39378a Commit message 3b
-  remotes/origin/HEAD          -> origin/initial
-  remotes/origin/main          024df944 Commit message on main (#1234)
+refs/heads/branch-1 01a7eded - origin/branch-1 ahead 1
+refs/heads/branch-2 da796a69 * origin/branch-2
+refs/heads/branch-3 f4ebec0a - origin/branch-3 behind 2
+refs/heads/branch-4 e4d6bc09 - origin/branch-4 gone
+refs/heads/main 024df944 - origin/main
+refs/remotes/origin/HEAD 024df944 -
+refs/remotes/origin/branch-1 307a7bf4 -
+refs/remotes/origin/branch-2 da796a69 -
+refs/remotes/origin/branch-3 bc39378a -
+refs/remotes/origin/main 024df944 -
 `[1:]
 			want := domain.BranchInfos{
 				domain.BranchInfo{
@@ -368,6 +505,7 @@ func TestBackendCommands(t *testing.T) {
 					SyncStatus: domain.SyncStatusAhead,
 					RemoteName: domain.NewRemoteBranchName("origin/branch-1"),
 					RemoteSHA:  domain.NewSHA("307a7bf4"),
+					Ahead:      1,
 				},
 				domain.BranchInfo{
 					LocalName:  domain.NewLocalBranchName("branch-2"),
@@ -382,13 +520,7 @@ func TestBackendCommands(t *testing.T) {
 					SyncStatus: domain.SyncStatusBehind,
 					RemoteName: domain.NewRemoteBranchName("origin/branch-3"),
 					RemoteSHA:  domain.NewSHA("bc39378a"),
-				},
-				domain.BranchInfo{
-					LocalName:  domain.NewLocalBranchName("main"),
-					LocalSHA:   domain.NewSHA("024df944"),
-					SyncStatus: domain.SyncStatusUpToDate,
-					RemoteName: domain.NewRemoteBranchName("origin/main"),
-					RemoteSHA:  domain.NewSHA("024df944"),
+					Behind:     2,
 				},
 				domain.BranchInfo{
 					LocalName:  domain.NewLocalBranchName("branch-4"),
@@ -397,8 +529,15 @@ func TestBackendCommands(t *testing.T) {
 					RemoteName: domain.NewRemoteBranchName("origin/branch-4"),
 					RemoteSHA:  domain.SHA{},
 				},
+				domain.BranchInfo{
+					LocalName:  domain.NewLocalBranchName("main"),
+					LocalSHA:   domain.NewSHA("024df944"),
+					SyncStatus: domain.SyncStatusUpToDate,
+					RemoteName: domain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  domain.NewSHA("024df944"),
+				},
 			}
-			have, currentBranch := git.ParseVerboseBranchesOutput(give)
+			have, currentBranch := git.ParseForEachRefOutput(give)
 			assert.Equal(t, want, have)
 			assert.Equal(t, domain.NewLocalBranchName("branch-2"), currentBranch)
 		})
@@ -415,6 +554,60 @@ func TestBackendCommands(t *testing.T) {
 		assert.Equal(t, domain.NewLocalBranchName("feature1"), have)
 	})
 
+	t.Run("RemoveOutdatedConfiguration", func(t *testing.T) {
+		t.Parallel()
+		main := domain.NewLocalBranchName("main")
+		parent := domain.NewLocalBranchName("parent")
+		child := domain.NewLocalBranchName("child")
+		createRepoWithDeletedParent := func(t *testing.T) testruntime.TestRuntime {
+			t.Helper()
+			runtime := testruntime.CreateGitTown(t)
+			runtime.CreateBranch(child, main)
+			assert.NoError(t, runtime.Config.SetParent(parent, main))
+			assert.NoError(t, runtime.Config.SetParent(child, parent))
+			return runtime
+		}
+		t.Run("removes the parent of branches whose parent branch no longer exists", func(t *testing.T) {
+			t.Parallel()
+			runtime := createRepoWithDeletedParent(t)
+			branches, _, err := runtime.Backend.BranchInfos()
+			assert.NoError(t, err)
+			err = runtime.Backend.RemoveOutdatedConfiguration(branches, false)
+			assert.NoError(t, err)
+			assert.Equal(t, config.Lineage{}, runtime.Config.Lineage())
+		})
+		t.Run("makes branches whose parent branch no longer exists children of their closest existing ancestor", func(t *testing.T) {
+			t.Parallel()
+			runtime := createRepoWithDeletedParent(t)
+			branches, _, err := runtime.Backend.BranchInfos()
+			assert.NoError(t, err)
+			err = runtime.Backend.RemoveOutdatedConfiguration(branches, true)
+			assert.NoError(t, err)
+			assert.Equal(t, config.Lineage{child: main}, runtime.Config.Lineage())
+		})
+	})
+
+	t.Run("RemoteBranchHasSHA", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		origin := testruntime.Create(t)
+		runtime.AddRemote(domain.OriginRemote, origin.WorkingDir)
+		branch := domain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, domain.NewLocalBranchName("initial"))
+		runtime.PushBranchToRemote(branch, domain.OriginRemote)
+		sha, err := runtime.Backend.SHAForBranch(branch.BranchName())
+		assert.NoError(t, err)
+		have, err := runtime.Backend.RemoteBranchHasSHA(branch, sha)
+		assert.NoError(t, err)
+		assert.True(t, have)
+		have, err = runtime.Backend.RemoteBranchHasSHA(branch, domain.NewSHA("111111"))
+		assert.NoError(t, err)
+		assert.False(t, have)
+		have, err = runtime.Backend.RemoteBranchHasSHA(domain.NewLocalBranchName("zonk"), sha)
+		assert.NoError(t, err)
+		assert.False(t, have)
+	})
+
 	t.Run("Remotes", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -443,6 +636,7 @@ func TestBackendCommands(t *testing.T) {
 			}
 			cmds := git.BackendCommands{
 				BackendRunner:      runner,
+				BranchInfosCache:   &cache.BranchInfos{},
 				Config:             nil,
 				CurrentBranchCache: &cache.LocalBranch{},
 				RemoteBranchCache:  &cache.RemoteBranch{},
@@ -452,4 +646,81 @@ func TestBackendCommands(t *testing.T) {
 			assert.Empty(t, have)
 		})
 	})
+
+	t.Run("SharedLineage", func(t *testing.T) {
+		t.Parallel()
+		t.Run("stores and loads the shared lineage", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			lineage := config.Lineage{
+				domain.NewLocalBranchName("one"): domain.NewLocalBranchName("main"),
+				domain.NewLocalBranchName("two"): domain.NewLocalBranchName("one"),
+			}
+			sha, err := runtime.Backend.StoreSharedLineage(lineage)
+			assert.NoError(t, err)
+			assert.NoError(t, runtime.Backend.SetSharedLineageRef(config.SharedLineageRef, sha))
+			have, haveSHA, err := runtime.Backend.SharedLineage(config.SharedLineageRef)
+			assert.NoError(t, err)
+			assert.Equal(t, lineage, have)
+			assert.Equal(t, sha, haveSHA)
+		})
+		t.Run("ref doesn't exist", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			have, haveSHA, err := runtime.Backend.SharedLineage(config.SharedLineageRef)
+			assert.NoError(t, err)
+			assert.Equal(t, config.Lineage{}, have)
+			assert.True(t, haveSHA.IsEmpty())
+		})
+		t.Run("removes the ref", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			sha, err := runtime.Backend.StoreSharedLineage(config.Lineage{})
+			assert.NoError(t, err)
+			assert.NoError(t, runtime.Backend.SetSharedLineageRef(config.SharedLineageRef, sha))
+			assert.NoError(t, runtime.Backend.SetSharedLineageRef(config.SharedLineageRef, domain.SHA{}))
+			_, haveSHA, err := runtime.Backend.SharedLineage(config.SharedLineageRef)
+			assert.NoError(t, err)
+			assert.True(t, haveSHA.IsEmpty())
+		})
+	})
+
+	t.Run("SharedLineageUpdates", func(t *testing.T) {
+		t.Parallel()
+		main := domain.NewLocalBranchName("main")
+		one := domain.NewLocalBranchName("one")
+		two := domain.NewLocalBranchName("two")
+		t.Run("origin shares no lineage", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.CreateGitTown(t)
+			updates, conflicts, sha, err := runtime.Backend.SharedLineageUpdates()
+			assert.NoError(t, err)
+			assert.Empty(t, updates)
+			assert.Empty(t, conflicts)
+			assert.True(t, sha.IsEmpty())
+		})
+		t.Run("provides the updates for the existing local branches", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.CreateGitTown(t)
+			runtime.CreateBranch(one, main)
+			runtime.CreateBranch(two, main)
+			assert.NoError(t, runtime.Config.SetParent(two, main))
+			shared := config.Lineage{
+				one:                                main,
+				two:                                one,
+				domain.NewLocalBranchName("three"): two,
+			}
+			sharedSHA, err := runtime.Backend.StoreSharedLineage(shared)
+			assert.NoError(t, err)
+			assert.NoError(t, runtime.Backend.SetSharedLineageRef(config.SharedLineageTrackingRef, sharedSHA))
+			updates, conflicts, sha, err := runtime.Backend.SharedLineageUpdates()
+			assert.NoError(t, err)
+			assert.Equal(t, config.Lineage{one: main}, updates)
+			want := []config.SharedLineageConflict{
+				{Branch: two, LocalParent: main, RemoteParent: one},
+			}
+			assert.Equal(t, want, conflicts)
+			assert.Equal(t, sharedSHA, sha)
+		})
+	})
 }
@@ -0,0 +1,9 @@
+package git
+
+import "github.com/git-town/git-town/v9/src/domain"
+
+// Commit is a commit together with its full message.
+type Commit struct {
+	Message string
+	SHA     domain.SHA
+}
@@ -11,6 +11,7 @@ import (
 
 type FrontendRunner interface {
 	Run(executable string, args ...string) error
+	RunInteractive(executable string, args ...string) error
 	RunMany([][]string) error
 }
 
@@ -19,11 +20,33 @@ type FrontendRunner interface {
 // Git Town only needs to know the exit code of frontend commands.
 type FrontendCommands struct {
 	FrontendRunner
-	SetCachedCurrentBranch SetCachedCurrentBranchFunc
+	InvalidateBranchInfosCache InvalidateCacheFunc // frontend commands can change branches, so they invalidate the cached branch information
+	SetCachedCurrentBranch     SetCachedCurrentBranchFunc
 }
 
+type InvalidateCacheFunc func()
+
 type SetCachedCurrentBranchFunc func(domain.LocalBranchName)
 
+// Run runs the given frontend command and invalidates the cached branch information.
+func (fc *FrontendCommands) Run(executable string, args ...string) error {
+	defer fc.InvalidateBranchInfosCache()
+	return fc.FrontendRunner.Run(executable, args...)
+}
+
+// RunInteractive runs the given frontend command that interacts with the user
+// and invalidates the cached branch information.
+func (fc *FrontendCommands) RunInteractive(executable string, args ...string) error {
+	defer fc.InvalidateBranchInfosCache()
+	return fc.FrontendRunner.RunInteractive(executable, args...)
+}
+
+// RunMany runs the given frontend commands and invalidates the cached branch information.
+func (fc *FrontendCommands) RunMany(commands [][]string) error {
+	defer fc.InvalidateBranchInfosCache()
+	return fc.FrontendRunner.RunMany(commands)
+}
+
 // AbortMerge cancels a currently ongoing Git merge operation.
 func (fc *FrontendCommands) AbortMerge() error {
 	return fc.Run("git", "merge", "--abort")
@@ -82,12 +105,30 @@ func (fc *FrontendCommands) Commit(message, author string) error {
 	if author != "" {
 		gitArgs = append(gitArgs, "--author", author)
 	}
-	return fc.Run("git", gitArgs...)
+	return fc.RunInteractive("git", gitArgs...)
+}
+
+// CommitSquashMerge commits the current squash merge with the commit message that the user enters into the editor
+// and the given optional author.
+// The editor starts with the prepared squash commit message.
+// Using it as the template as well makes Git abort the commit if the user doesn't change it.
+func (fc *FrontendCommands) CommitSquashMerge(author string) error {
+	gitArgs := []string{"commit", "-t", squashMessageFile}
+	if author != "" {
+		gitArgs = append(gitArgs, "--author", author)
+	}
+	return fc.RunInteractive("git", gitArgs...)
+}
+
+// CommitWithEditor commits the staged changes with the commit message that the user enters into the editor,
+// which starts with the given message.
+func (fc *FrontendCommands) CommitWithEditor(message string) error {
+	return fc.RunInteractive("git", "commit", "-e", "-m", message)
 }
 
 // ContinueRebase continues the currently ongoing rebase.
 func (fc *FrontendCommands) ContinueRebase() error {
-	return fc.Run("git", "rebase", "--continue")
+	return fc.RunInteractive("git", "rebase", "--continue")
 }
 
 // CreateBranch creates a new branch with the given name.
@@ -130,7 +171,7 @@ func (fc *FrontendCommands) DeleteRemoteBranch(name domain.LocalBranchName) erro
 
 // DiffParent displays the diff between the given branch and its given parent branch.
 func (fc *FrontendCommands) DiffParent(branch, parentBranch domain.LocalBranchName) error {
-	return fc.Run("git", "diff", parentBranch.String()+".."+branch.String())
+	return fc.RunInteractive("git", "diff", parentBranch.String()+".."+branch.String())
 }
 
 // DiscardOpenChanges deletes all uncommitted changes.
@@ -138,9 +179,31 @@ func (fc *FrontendCommands) DiscardOpenChanges() error {
 	return fc.Run("git", "reset", "--hard")
 }
 
-// Fetch retrieves the updates from the origin repo.
-func (fc *FrontendCommands) Fetch() error {
-	return fc.Run("git", "fetch", "--prune", "--tags")
+// Fetch retrieves the updates from the given remotes.
+// When the repo has several remotes, Git fetches them in parallel.
+// With shareLineage, it also retrieves the lineage shared through the origin repo.
+func (fc *FrontendCommands) Fetch(remotes domain.Remotes, shareLineage bool) error {
+	if len(remotes) < 2 {
+		if shareLineage {
+			return fc.Run("git", "fetch", "--prune", "--tags", domain.OriginRemote.String(), config.BranchesRefspec, config.SharedLineageRefspec)
+		}
+		return fc.Run("git", "fetch", "--prune", "--tags")
+	}
+	args := []string{}
+	if shareLineage {
+		// "git fetch --multiple" accepts no refspecs, so add the refspec for the shared lineage to the configured refspecs of origin
+		args = append(args, "-c", fmt.Sprintf("remote.%s.fetch=%s", domain.OriginRemote, config.SharedLineageRefspec))
+	}
+	args = append(args, "fetch", "--prune", "--tags", "--multiple", fmt.Sprintf("--jobs=%d", len(remotes)))
+	for _, remote := range remotes {
+		args = append(args, remote.String())
+	}
+	return fc.Run("git", args...)
+}
+
+// FetchTrackingBranch fetches the tracking branch of the given branch from origin.
+func (fc *FrontendCommands) FetchTrackingBranch(branch domain.LocalBranchName) error {
+	return fc.Run("git", "fetch", domain.OriginRemote.String(), branch.String())
 }
 
 // FetchUpstream fetches updates from the upstream remote.
@@ -155,6 +218,21 @@ func (fc *FrontendCommands) MergeBranchNoEdit(branch domain.BranchName) error {
 	return err
 }
 
+// MergeBranchNoFastForward merges the given branch into the current branch
+// using a merge commit with the given message, or the default message if the given message is empty.
+func (fc *FrontendCommands) MergeBranchNoFastForward(branch domain.LocalBranchName, message string) error {
+	if message == "" {
+		return fc.Run("git", "merge", "--no-ff", "--no-edit", branch.String())
+	}
+	return fc.Run("git", "merge", "--no-ff", "-m", message, branch.String())
+}
+
+// MergeBranchNoFastForwardNoCommit merges the given branch into the current branch
+// without committing the merge, even if Git could fast-forward the current branch.
+func (fc *FrontendCommands) MergeBranchNoFastForwardNoCommit(branch domain.LocalBranchName) error {
+	return fc.Run("git", "merge", "--no-ff", "--no-commit", branch.String())
+}
+
 // NavigateToDir changes into the root directory of the current repository.
 func (fc *FrontendCommands) NavigateToDir(dir domain.RepoRootDir) error {
 	return os.Chdir(dir.String())
@@ -188,6 +266,47 @@ func (fc *FrontendCommands) ForcePushBranch(noPushHook bool) error {
 	return fc.Run("git", args...)
 }
 
+// ForcePushBranchWithLease force-pushes the current branch to origin
+// unless the tracking branch at origin no longer has the given SHA.
+func (fc *FrontendCommands) ForcePushBranchWithLease(branch domain.LocalBranchName, remoteSHA domain.SHA, noPushHook bool) error {
+	args := []string{"push", fmt.Sprintf("--force-with-lease=%s:%s", branch, remoteSHA)}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	return fc.Run("git", args...)
+}
+
+// ForcePushLocalBranch force-pushes the given local branch to origin,
+// independent of which branch is currently checked out,
+// unless the tracking branch at origin no longer has the given SHA.
+// An empty SHA leases against the tracking branch as last fetched.
+func (fc *FrontendCommands) ForcePushLocalBranch(branch domain.LocalBranchName, remoteSHA domain.SHA, noPushHook bool) error {
+	args := []string{"push", "--force-with-lease"}
+	if !remoteSHA.IsEmpty() {
+		args = []string{"push", fmt.Sprintf("--force-with-lease=%s:%s", branch, remoteSHA)}
+	}
+	if noPushHook {
+		args = append(args, "--no-verify")
+	}
+	args = append(args, domain.OriginRemote.String(), branch.String())
+	return fc.Run("git", args...)
+}
+
+// PushSharedLineage pushes the local shared lineage ref to origin
+// if the shared lineage at origin is still at the given ref or SHA.
+// An empty expectation requires that origin doesn't share a lineage yet.
+func (fc *FrontendCommands) PushSharedLineage(expected string) error {
+	lease := fmt.Sprintf("--force-with-lease=%s:%s", config.SharedLineageRef, expected)
+	return fc.Run("git", "push", lease, domain.OriginRemote.String(), config.SharedLineageRef)
+}
+
+// RemoveSharedLineage removes the shared lineage from origin
+// if it is still at the given SHA.
+func (fc *FrontendCommands) RemoveSharedLineage(expected domain.SHA) error {
+	lease := fmt.Sprintf("--force-with-lease=%s:%s", config.SharedLineageRef, expected.String())
+	return fc.Run("git", "push", lease, domain.OriginRemote.String(), ":"+config.SharedLineageRef)
+}
+
 // PushTags pushes new the Git tags to origin.
 func (fc *FrontendCommands) PushTags() error {
 	return fc.Run("git", "push", "--tags")
@@ -198,6 +317,18 @@ func (fc *FrontendCommands) Rebase(target domain.BranchName) error {
 	return fc.Run("git", "rebase", target.String())
 }
 
+// RebaseOnto moves the commits of the current branch that aren't in the given upstream commit
+// onto the given branch.
+func (fc *FrontendCommands) RebaseOnto(onto domain.LocalBranchName, upstream domain.SHA) error {
+	return fc.Run("git", "rebase", "--onto", onto.String(), upstream.String())
+}
+
+// RebaseUpdateRefs initiates a Git rebase of the current branch against the given branch
+// that also moves all local branches pointing to the rebased commits.
+func (fc *FrontendCommands) RebaseUpdateRefs(target domain.BranchName) error {
+	return fc.Run("git", "rebase", "--update-refs", target.String())
+}
+
 // RemoveGitAlias removes the given Git alias.
 func (fc *FrontendCommands) RemoveGitAlias(alias config.Alias) error {
 	return fc.Run("git", "config", "--global", "--unset", "alias."+alias.String())
@@ -213,9 +344,31 @@ func (fc *FrontendCommands) ResetCurrentBranchToSHA(sha domain.SHA, hard bool) e
 	return fc.Run("git", args...)
 }
 
+// ResetLocalBranchToSHA sets the given local branch, which must not be checked out, to the given SHA.
+func (fc *FrontendCommands) ResetLocalBranchToSHA(branch domain.LocalBranchName, sha domain.SHA) error {
+	return fc.Run("git", "branch", "--force", branch.String(), sha.String())
+}
+
+// ResetRemoteBranchToSHA sets the given branch at the origin remote to the given SHA.
+func (fc *FrontendCommands) ResetRemoteBranchToSHA(branch domain.LocalBranchName, sha domain.SHA) error {
+	return fc.Run("git", "push", "--force-with-lease", domain.OriginRemote.String(), sha.String()+":"+branch.String())
+}
+
 // RevertCommit reverts the commit with the given SHA.
 func (fc *FrontendCommands) RevertCommit(sha domain.SHA) error {
-	return fc.Run("git", "revert", sha.String())
+	return fc.RunInteractive("git", "revert", sha.String())
+}
+
+// RevertMergeCommit reverts the merge commit with the given SHA,
+// keeping the changes of its first parent.
+func (fc *FrontendCommands) RevertMergeCommit(sha domain.SHA) error {
+	return fc.RunInteractive("git", "revert", "-m", "1", sha.String())
+}
+
+// SoftResetCurrentBranch points the current branch to the given branch,
+// keeping all changes between them staged.
+func (fc *FrontendCommands) SoftResetCurrentBranch(target domain.LocalBranchName) error {
+	return fc.Run("git", "reset", "--soft", target.String())
 }
 
 // SquashMerge squash-merges the given branch into the current branch.
@@ -236,8 +389,3 @@ func (fc *FrontendCommands) StageFiles(names ...string) error {
 	args := append([]string{"add"}, names...)
 	return fc.Run("git", args...)
 }
-
-// StartCommit starts a commit and stops at asking the user for the commit message.
-func (fc *FrontendCommands) StartCommit() error {
-	return fc.Run("git", "commit")
-}
@@ -9,5 +9,7 @@ type ProdRunner struct {
 }
 
 type Statistics interface {
+	FinishStep()
 	PrintAnalysis()
+	StartStep(name string)
 }
@@ -40,6 +40,13 @@ func (ec *FailureCollector) Bool(value bool, err error) bool {
 	return value
 }
 
+// BranchSyncStrategies provides the config.BranchSyncStrategies part of the given fallible function result
+// while registering the given error.
+func (ec *FailureCollector) BranchSyncStrategies(value config.BranchSyncStrategies, err error) config.BranchSyncStrategies {
+	ec.Check(err)
+	return value
+}
+
 func (ec *FailureCollector) Branches(value domain.Branches, err error) domain.Branches {
 	ec.Check(err)
 	return value
@@ -71,6 +78,13 @@ func (ec *FailureCollector) Remotes(value domain.Remotes, err error) domain.Remo
 	return value
 }
 
+// ShipStrategy provides the config.ShipStrategy part of the given fallible function result
+// while registering the given error.
+func (ec *FailureCollector) ShipStrategy(value config.ShipStrategy, err error) config.ShipStrategy {
+	ec.Check(err)
+	return value
+}
+
 // String provides the string part of the given fallible function result
 // while registering the given error.
 func (ec *FailureCollector) String(value string, err error) string {
@@ -64,6 +64,10 @@ func (c *BitbucketConnector) NewProposalURL(branch, parentBranch domain.LocalBra
 	return fmt.Sprintf("%s/pull-request/new?%s", c.RepositoryURL(), query.Encode()), nil
 }
 
+func (c *BitbucketConnector) ProposalLabels(_ int) ([]string, error) {
+	return []string{}, errors.New(messages.HostingBitBucketNotImplemented)
+}
+
 func (c *BitbucketConnector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", c.Hostname, c.Organization, c.Repository)
 }
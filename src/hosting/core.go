@@ -21,6 +21,7 @@ type Connector interface {
 	DefaultProposalMessage(proposal Proposal) string
 
 	// FindProposal provides details about the proposal for the given branch into the given target branch.
+	// An empty target branch finds the proposal for the given branch into any branch.
 	// Returns nil if no proposal exists.
 	FindProposal(branch, target domain.LocalBranchName) (*Proposal, error)
 
@@ -28,6 +29,9 @@ type Connector interface {
 	// supported by the respective connector implementation.
 	HostingServiceName() string
 
+	// ProposalLabels provides the names of the labels of the proposal with the given number.
+	ProposalLabels(number int) ([]string, error)
+
 	// SquashMergeProposal squash-merges the proposal with the given number
 	// using the given commit message.
 	SquashMergeProposal(number int, message string) (mergeSHA domain.SHA, err error)
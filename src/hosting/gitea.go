@@ -58,6 +58,18 @@ func (c *GiteaConnector) NewProposalURL(branch, parentBranch domain.LocalBranchN
 	return fmt.Sprintf("%s/compare/%s", c.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
+func (c *GiteaConnector) ProposalLabels(number int) ([]string, error) {
+	pullRequest, err := c.client.GetPullRequest(c.Organization, c.Repository, int64(number))
+	if err != nil {
+		return []string{}, err
+	}
+	result := make([]string, len(pullRequest.Labels))
+	for l, label := range pullRequest.Labels {
+		result[l] = label.Name
+	}
+	return result, nil
+}
+
 func (c *GiteaConnector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", c.Hostname, c.Organization, c.Repository)
 }
@@ -127,7 +139,7 @@ func FilterGiteaPullRequests(pullRequests []*gitea.PullRequest, organization str
 	headName := organization + "/" + branch.String()
 	for p := range pullRequests {
 		pullRequest := pullRequests[p]
-		if pullRequest.Head.Name == headName && pullRequest.Base.Name == target.String() {
+		if pullRequest.Head.Name == headName && (target.IsEmpty() || pullRequest.Base.Name == target.String()) {
 			result = append(result, pullRequest)
 		}
 	}
@@ -150,4 +150,8 @@ func TestFilterGiteaPullRequests(t *testing.T) {
 	}
 	have := hosting.FilterGiteaPullRequests(give, "organization", domain.NewLocalBranchName("branch"), domain.NewLocalBranchName("target"))
 	assert.Equal(t, want, have)
+	// without target branch
+	want = []*gitea.PullRequest{give[0], give[2]}
+	have = hosting.FilterGiteaPullRequests(give, "organization", domain.NewLocalBranchName("branch"), domain.LocalBranchName{})
+	assert.Equal(t, want, have)
 }
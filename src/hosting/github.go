@@ -59,6 +59,18 @@ func (c *GitHubConnector) NewProposalURL(branch, parentBranch domain.LocalBranch
 	return fmt.Sprintf("%s/compare/%s?expand=1", c.RepositoryURL(), url.PathEscape(toCompare)), nil
 }
 
+func (c *GitHubConnector) ProposalLabels(number int) ([]string, error) {
+	labels, _, err := c.client.Issues.ListLabelsByIssue(context.Background(), c.Organization, c.Repository, number, nil)
+	if err != nil {
+		return []string{}, err
+	}
+	result := make([]string, len(labels))
+	for l, label := range labels {
+		result[l] = label.GetName()
+	}
+	return result, nil
+}
+
 func (c *GitHubConnector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", c.Hostname, c.Organization, c.Repository)
 }
@@ -24,7 +24,9 @@ func (c *GitLabConnector) FindProposal(branch, target domain.LocalBranchName) (*
 	opts := &gitlab.ListProjectMergeRequestsOptions{
 		State:        gitlab.String("opened"),
 		SourceBranch: gitlab.String(branch.String()),
-		TargetBranch: gitlab.String(target.String()),
+	}
+	if !target.IsEmpty() {
+		opts.TargetBranch = gitlab.String(target.String())
 	}
 	mergeRequests, _, err := c.client.MergeRequests.ListProjectMergeRequests(c.projectPath(), opts)
 	if err != nil {
@@ -40,6 +42,14 @@ func (c *GitLabConnector) FindProposal(branch, target domain.LocalBranchName) (*
 	return &proposal, nil
 }
 
+func (c *GitLabConnector) ProposalLabels(number int) ([]string, error) {
+	mergeRequest, _, err := c.client.MergeRequests.GetMergeRequest(c.projectPath(), number, nil)
+	if err != nil {
+		return []string{}, err
+	}
+	return mergeRequest.Labels, nil
+}
+
 func (c *GitLabConnector) SquashMergeProposal(number int, message string) (mergeSHA domain.SHA, err error) {
 	if number <= 0 {
 		return domain.SHA{}, fmt.Errorf(messages.ProposalNoNumberGiven)
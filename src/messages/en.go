@@ -4,6 +4,8 @@ const (
 	AbortContinueGuidance             = "\n\nTo abort, run \"git-town abort\".\nTo continue after having resolved conflicts, run \"git-town continue\".\n"
 	AbortNothingToDo                  = "nothing to abort"
 	ArgumentUnknown                   = "unknown argument: %q"
+	BranchAheadBehindProblem          = "cannot determine how many commits branch %q is ahead of and behind %q: %w"
+	BranchAheadBehindUnexpectedOutput = "unexpected output while counting the commits of branch %q relative to %q: %q"
 	BranchAlreadyExistsLocally        = "there is already a branch %q"
 	BranchAlreadyExistsRemotely       = "there is already a branch %q at the \"origin\" remote"
 	BranchCheckoutProblem             = "cannot check out branch %q: %w"
@@ -16,9 +18,33 @@ const (
 	BranchLocalProblem                = "cannot determine whether the local branch %q exists: %w"
 	BrowserOpen                       = "Please open in a browser: %s\n"
 	CacheUnitialized                  = "using a cached value before initialization"
+	ChangelogRangeInvalid             = "please provide the range of commits as <from>..<to>, for example \"v1.0..main\", not %q"
+	CommandLogDateInvalid             = "cannot parse date %q, please provide it as YYYY-MM-DD or in RFC 3339 format: %w"
+	CommandLogDisabled                = "%v, the command log remains disabled"
+	CommandLogPathProblem             = "cannot determine the path of the command log: %w"
+	CommandLogReadProblem             = "cannot read command log file %q: %w"
+	CommandLogRotateProblem           = "cannot rotate command log file %q: %w"
+	CommandLogWriteProblem            = "cannot write command log file %q: %w"
+	CommitAncestorProblem             = "cannot determine whether commit %q is part of branch %q: %w"
 	CommitMessageProblem              = "cannot determine last commit message: %w"
+	CommitMessagesProblem             = "cannot determine the commit messages of branch %q: %w"
+	CommitsInRangeProblem             = "cannot read the commits in %q: %w"
 	CompletionTypeUnknown             = "unknown completion type: %q"
+	CompressAborted                   = "aborted because commit exited with error"
+	CompressBranchNotInSync           = "branch %q is not in sync, please run \"git town sync\" before compressing it"
+	CompressNoCommits                 = "branch %q has no commits to compress"
+	CompressOnlyFeatureBranches       = "you can only compress feature branches"
+	CompressSingleCommit              = "branch %q has only one commit, use the --message flag to change its commit message"
+	CompressStackMessage              = "the --message and --stack flags cannot be combined because each compressed branch needs its own commit message"
+	ConfigExportWritten               = "wrote the configuration into %s\n"
+	ConfigFileCannotRead              = "cannot read %s: %w"
+	ConfigFileCannotWrite             = "cannot write %s: %w"
+	ConfigFileIgnored                 = "%v, ignoring the affected settings"
+	ConfigFileInvalid                 = "%s contains invalid YAML: %w"
+	ConfigFileInvalidValue            = "invalid value for %q in %s"
 	ConfigPullbranchStrategyUnknown   = "unknown pull branch strategy: %q"
+	ConfigShipStrategyUnknown         = "unknown ship strategy: %q"
+	ConfigSyncStrategyBranchGlobal    = "the --branch and --global flags cannot be combined"
 	ConfigSyncStrategyUnknown         = "unknown sync strategy: %q"
 	ConfigRemoveError                 = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
 	ContinueSkipGuidance              = "To continue by skipping the current branch, run \"git-town skip\"."
@@ -26,6 +52,8 @@ const (
 	ValueInvalid                      = "invalid value for %s: %q. Please provide either \"yes\" or \"no\""
 	ValueGlobalInvalid                = "invalid value for global %s: %q. Please provide either \"true\" or \"false\""
 	ConflictDetectionProblem          = "cannot determine conflicts: %w"
+	ContinueBranchNotFailed           = "branch %q doesn't need manual syncing"
+	ContinueBranchUnfinished          = "the last Git Town command is unfinished, please run \"git town continue\" without a branch name"
 	ContinueNothingToDo               = "nothing to continue"
 	ContinueUnresolvedConflicts       = "you must resolve the conflicts before continuing"
 	DialogOptionNotFound              = "given initial value %q not in given entries"
@@ -36,17 +64,50 @@ const (
 	DiffParentNoFeatureBranch         = "you can only diff-parent feature branches"
 	DiffProblem                       = "cannot list diff of %q and %q: %w"
 	DirCurrentProblem                 = "cannot determine the current directory"
+	DoctorConfigFileValueInvalid      = "%s contains the invalid value %q for %q"
+	DoctorConfigValueInvalid          = "the %s Git configuration contains the invalid value %q for %q"
+	DoctorFixDeleteParent             = "remove the lineage entry of %q"
+	DoctorFixRemoveConfigValue        = "remove it to use the default value"
+	DoctorFixRemovePerennialBranch    = "remove %q from the perennial branches"
+	DoctorFixSetParent                = "make %q a child of %q"
+	DoctorHintConfigSetup             = `run "git town config setup"`
+	DoctorHintEditConfigFile          = "correct it in %s"
+	DoctorHintHostingDriver           = `set "git-town.code-hosting-driver" if you use a code hosting platform`
+	DoctorHintInferParent             = `run "git town set-parent --infer" on branch %q`
+	DoctorHintStoreToken              = "store your API token in %q"
+	DoctorHostingToken                = "there is no API token for %s, Git Town cannot ship or update proposals via the API"
+	DoctorHostingUnknown              = "cannot determine the code hosting platform of origin %q"
+	DoctorLineageCycle                = "the branches %s form a cycle in the lineage"
+	DoctorLineageNotAncestor          = "branch %q doesn't descend from its parent %q, the commit history suggests %q (confidence: %s)"
+	DoctorLineageParentMissing        = "the parent %q of branch %q doesn't exist"
+	DoctorLineagePerennialParent      = "the perennial branch %q has the parent %q"
+	DoctorLineageStaleEntry           = "the lineage contains the branch %q that doesn't exist"
+	DoctorMainBranchMissing           = "the main branch %q doesn't exist"
+	DoctorMainBranchUnknown           = "no main branch is configured"
+	DoctorNoProblems                  = "Git Town found no problems"
+	DoctorPerennialBranchMissing      = "the perennial branch %q doesn't exist"
+	DoctorPromptFix                   = "Apply these fixes?"
+	DoctorPromptFixNo                 = "no, exit without changes"
+	DoctorPromptFixYes                = "yes, apply the fixes"
+	DownstreamNoPerennialBranch       = "branch %q is not a perennial branch"
+	DownstreamSelf                    = "branch %q cannot merge into itself"
 	FileContentInvalidJSON            = "cannot parse JSON content of file %q: %w"
 	FileDeleteProblem                 = "cannot delete file %q: %w"
 	FileReadProblem                   = "cannot read file %q: %w"
 	FileStatProblem                   = "cannot check file %q: %w"
 	FileWriteProblem                  = "cannot write file %q: %w"
+	ForcePushLeaseRejected            = "cannot force-push branch %q because somebody else pushed new commits to it since Git Town fetched it.\nContinuing pulls these commits into the branch and pushes it again."
+	ForcePushMovedLeaseRejected       = "cannot force-push branch %q because somebody else pushed new commits to it since Git Town fetched it.\nContinuing leaves the branch at origin unchanged."
+	ForcePushProblem                  = "cannot force-push branch %q: %w"
 	GitUserProblem                    = "cannot determine repo author: %w"
 	GitVersionMajorNotNumber          = "cannot convert major version %q to int: %w"
 	GitVersionMinorNotNumber          = "cannot convert minor version %q to int: %w"
 	GitVersionProblem                 = "cannot determine Git version: %w"
 	GitVersionUnexpectedOutput        = "'git version' returned unexpected output: %q.\nPlease open an issue and supply the output of running 'git version'"
 	GitVersionTooLow                  = "this app requires Git 2.7.0 or higher"
+	HackObserveNo                     = "no, exit"
+	HackObservePrompt                 = "Branch %q exists only at the \"origin\" remote. Check it out as an observed branch?"
+	HackObserveYes                    = "yes, observe it without pushing"
 	HostingBitBucketNotImplemented    = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
 	HostingGitlabMergingViaAPI        = "GitLab API: Merging MR !%d ... "
 	HostingGitlabUpdateMRViaAPI       = "GitLab API: Updating target branch for MR !%d to %q ... "
@@ -55,16 +116,46 @@ const (
 	HostingGithubMergingViaAPI        = "GitHub API: merging PR #%d ... "
 	HostingGithubUpdatePRViaAPI       = "GitHub API: updating base branch for PR #%d ... "
 	HostingServiceUnknown             = "unknown hosting service: %q"
+	InferParentNearest                = "branch %q: parent %q at distance %d (confidence: %s)\n"
+	InferParentNoCandidates           = "branch %q: no branch contains its start, using %q (confidence: %s)\n"
+	InferParentProposalConfirms       = "branch %q: parent %q, confirmed by proposal #%d (confidence: %s)\n"
+	InferParentProposalDisagrees      = "branch %q: parent %q, the target of proposal #%d, although %q is nearer (confidence: %s)\n"
+	InferParentTied                   = "branch %q: parent %q, equally near as %s (confidence: %s)\n"
 	InputAddOrRemove                  = `invalid argument %q. Please provide either "add" or "remove"`
 	InputYesOrNo                      = `invalid argument: %q. Please provide either "yes" or "no".\n`
-	KillOnlyFeatureBranches           = "you can only kill feature branches"
+	KillOnlyFeatureBranches           = "you can only kill feature and observed branches"
+	MergeCannotReadFile               = "cannot read merge message file %q: %w"
+	MergeMessageProblem               = "cannot add the trailers to the merge commit message: %w"
+	MoveBranchOnlyFeatureBranches     = "you can only move feature branches"
+	MoveBranchOntoDescendant          = "cannot move branch %q onto itself or its descendant %q"
+	MoveBranchParentUnchanged         = "branch %q already has the parent %q"
+	NavigateLineageCycle              = "the child branches of branch %q form a cycle, please run \"git town doctor\" to repair the lineage"
+	NavigateNoChildBranch             = "branch %q has no child branches"
+	NavigateNoParentBranch            = "branch %q has no parent branch"
+	NavigateNotInStack                = "branch %q is not part of a stack of feature branches"
+	ObserveAlreadyObserved            = "branch %q is already observed"
+	ObserveDone                       = "branch %q is now observed\n"
+	ObserveNoFeatureBranch            = "branch %q is not a feature branch, only feature branches can be observed"
 	OfflineNotAllowed                 = "this command requires an active internet connection"
 	OpenChangesProblem                = "cannot determine open changes: %w"
+	PerennialRegexInvalid             = "invalid perennial regex %q: %w"
+	PerennialRegexPrompt              = "Please specify a regular expression for the names of additional perennial branches:"
+	ProfileTraceProblem               = "cannot write the profile trace to %q: %w"
+	ProposalLabelsProblem             = "cannot load the labels of proposal #%d: %w"
 	ProposalMultipleFound             = "found %d proposals from branch %q to branch %q"
 	ProposalNoNumberGiven             = "no pull request number given"
 	ProposalNotFoundForBranch         = "cannot determine proposal for branch %q: %w"
 	ProposalTargetBranchUpdateProblem = "cannot update the target branch of proposal %d via the API"
 	ProposalURLProblem                = "cannot determine proposal URL from %q to %q: %w"
+	PrototypeAlready                  = "branch %q is already a prototype branch"
+	PrototypeConvertNo                = "no, exit"
+	PrototypeConvertPrompt            = "Branch %q is a prototype branch that Git Town doesn't push. Convert it into a feature branch?"
+	PrototypeConvertYes               = "yes, convert it into a feature branch"
+	PrototypeDone                     = "branch %q is now a prototype branch\n"
+	PrototypeNeedsConversion          = "branch %q is a prototype branch, please convert it into a feature branch first"
+	PrototypeNoFeatureBranch          = "branch %q is not a feature branch, only feature branches can be prototypes"
+	PrototypeNotPrototype             = "branch %q is not a prototype branch"
+	PrototypeRemoved                  = "branch %q is now a feature branch\n"
 	RebaseProblem                     = "cannot determine rebase in progress: %w"
 	RemoteExistsProblem               = "cannot determine if remote %q exists: %w"
 	RemotesProblem                    = "cannot determine remotes: %w"
@@ -73,8 +164,12 @@ const (
 	RenamePerennialBranchWarning      = "%q is a perennial branch. Renaming a perennial branch typically requires other updates. If you are sure you want to do this, use '--force'"
 	RenameToSameName                  = "cannot rename branch to current name"
 	RepoOutside                       = "this is not a Git repository"
+	RollbackGuidance                  = "\n\nTo abort, run \"git-town abort\".\nTo try this part of the command again, run \"git-town continue\".\nTo finish the command without this part, run \"git-town skip\".\n"
 	RunAutoAborting                   = "%s\nAuto-aborting... "
 	RunCommandProblem                 = "error running command %q: %w"
+	RunKeepGoing                      = "cannot sync branch %q, skipping it: %v"
+	RunKeepGoingCleanupProblem        = "cannot clean up branch %q after a failed sync: %w"
+	RunRollingBack                    = "%s\nUndoing the changes since the last completed part of this command... "
 	RunstateAbortStepProblem          = "cannot run the abort steps: %w"
 	RunstateDeleteProblem             = "cannot delete previous run state: %w"
 	RunstateLoadProblem               = "cannot load previous run state: %w"
@@ -83,16 +178,26 @@ const (
 	RunstateSaveProblem               = "cannot save run state: %w"
 	RunstateStepUnknown               = "unknown step type: %q, run \"git town status reset\" to reset it"
 	SetParentNoFeatureBranch          = "the branch %q is not a feature branch. Only feature branches can have parent branches"
+	SharedLineageConflict             = "branch %q has parent %q locally but %q in the shared lineage, keeping %q\n"
+	SharedLineageReadProblem          = "cannot read the shared lineage in %q: %w"
+	SharedLineageStoreProblem         = "cannot store the shared lineage: %w"
 	ShipAbortedMergeError             = "aborted because commit exited with error"
 	ShipBranchNothingToDo             = "the branch %q has no shippable changes"
+	ShipMessageTemplateEmpty          = "the ship message template renders an empty commit message"
+	ShipMessageTemplateInvalid        = "cannot parse the ship message template: %w"
+	ShipMessageTemplateProblem        = "cannot render the ship message template: %w"
 	ShipNoFeatureBranch               = "the branch %q is not a feature branch. Only feature branches can be shipped"
 	ShipOpenChanges                   = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShipStackMessage                  = "the --message and --stack flags cannot be combined because each shipped branch needs its own commit message"
 	ShippableChangesProblem           = "cannot determine whether branch %q has shippable changes: %w"
 	SkipBranchHasConflicts            = "cannot skip branch that resulted in conflicts"
 	SkipNothingToDo                   = "nothing to skip"
 	SquashCannotReadFile              = "cannot read squash message file %q: %w"
 	SquashCommitAuthorProblem         = "error getting squash commit author: %w"
 	SquashMessageProblem              = "cannot comment out the squash commit message: %w"
+	SyncFailedBranches                = "These branches need manual syncing:\n%s\nTo sync them one at a time, run \"git town continue\".\nTo sync a particular one of them, run \"git town continue <branch>\".\n"
 	UndoCreateStepProblem             = "cannot create undo step for %q: %w"
 	UndoNothingToDo                   = "nothing to undo"
+	UnobserveDone                     = "branch %q is now a feature branch\n"
+	UnobserveNotObserved              = "branch %q is not observed"
 )
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/git-town/git-town/v9/src/config"
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/persistence"
 	"github.com/git-town/git-town/v9/src/runstate"
@@ -21,23 +22,44 @@ func TestLoadSave(t *testing.T) {
 		runState := runstate.RunState{
 			AbortStepList: runstate.StepList{},
 			Command:       "command",
-			IsAbort:       true,
-			IsUndo:        true,
+			FailedBranches: []runstate.FailedBranch{
+				{
+					Branch: domain.NewLocalBranchName("failed-branch"),
+					RunStepList: runstate.StepList{
+						List: []steps.Step{&steps.MergeStep{Branch: domain.NewBranchName("main")}},
+					},
+				},
+			},
+			IsAbort:   true,
+			IsUndo:    true,
+			KeepGoing: true,
 			RunStepList: runstate.StepList{
 				List: []steps.Step{
 					&steps.AbortMergeStep{},
 					&steps.AbortRebaseStep{},
+					&steps.AddToObservedBranchesStep{Branch: domain.NewLocalBranchName("branch")},
 					&steps.AddToPerennialBranchesStep{Branch: domain.NewLocalBranchName("branch")},
 					&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch")},
 					&steps.CommitOpenChangesStep{},
+					&steps.CompressBranchStep{
+						CommitMessage:     "commit message",
+						EditCommitMessage: true,
+						Parent:            domain.NewLocalBranchName("parent"),
+					},
 					&steps.ConnectorMergeProposalStep{
 						Branch:          domain.NewLocalBranchName("branch"),
 						CommitMessage:   "commit message",
+						Parent:          domain.NewLocalBranchName("parent"),
 						ProposalMessage: "proposal message",
 						ProposalNumber:  123,
+						ProposalTitle:   "proposal title",
 					},
 					&steps.ContinueMergeStep{},
 					&steps.ContinueRebaseStep{},
+					&steps.ContinueRebaseUpdateRefsStep{
+						MovedBranches: domain.NewLocalBranchNames("branch"),
+						PreviousSHAs:  []domain.SHA{domain.NewSHA("123456")},
+					},
 					&steps.CreateBranchStep{
 						Branch:        domain.NewLocalBranchName("branch"),
 						StartingPoint: domain.NewSHA("123456").Location(),
@@ -74,13 +96,34 @@ func TestLoadSave(t *testing.T) {
 						Branch: domain.NewLocalBranchName("branch"),
 						Parent: domain.NewLocalBranchName("parent"),
 					},
+					&steps.FetchTrackingBranchStep{
+						Branch: domain.NewLocalBranchName("branch"),
+					},
 					&steps.FetchUpstreamStep{
 						Branch: domain.NewLocalBranchName("branch"),
 					},
 					&steps.ForcePushBranchStep{
 						Branch:     domain.NewLocalBranchName("branch"),
 						NoPushHook: true,
+						Parent:     domain.NewLocalBranchName("parent"),
+						RemoteSHA:  domain.NewSHA("123456"),
+					},
+					&steps.ForcePushLocalBranchStep{
+						Branch:     domain.NewLocalBranchName("branch"),
+						NoPushHook: true,
+						RemoteSHA:  domain.NewSHA("123456"),
 					},
+					&steps.ForcePushMovedBranchStep{
+						Branch:     domain.NewLocalBranchName("branch"),
+						NoPushHook: true,
+						RemoteSHA:  domain.NewSHA("123456"),
+					},
+					&steps.MergeNoFastForwardStep{
+						Branch:        domain.NewLocalBranchName("branch"),
+						CommitMessage: "commit message",
+						Parent:        domain.NewLocalBranchName("parent"),
+					},
+					&steps.MergeSharedLineageStep{},
 					&steps.MergeStep{Branch: domain.NewBranchName("branch")},
 					&steps.PreserveCheckoutHistoryStep{
 						InitialBranch:                     domain.NewLocalBranchName("initial-branch"),
@@ -94,8 +137,26 @@ func TestLoadSave(t *testing.T) {
 						NoPushHook:    true,
 						Undoable:      true,
 					},
+					&steps.PushSharedLineageStep{},
 					&steps.PushTagsStep{},
 					&steps.RebaseBranchStep{Branch: domain.NewBranchName("branch")},
+					&steps.RebaseOntoStep{Onto: domain.NewLocalBranchName("branch"), Upstream: domain.NewSHA("123456")},
+					&steps.RebaseUpdateRefsStep{
+						Branch:        domain.NewBranchName("main"),
+						MovedBranches: domain.NewLocalBranchNames("branch"),
+					},
+					&steps.RecordParentSHAStep{
+						Branch: domain.NewLocalBranchName("branch"),
+						Parent: domain.NewLocalBranchName("parent"),
+					},
+					&steps.ReleaseSavepoint{},
+					&steps.RemoveConfigValueStep{
+						Key:    config.KeyOffline,
+						Global: true,
+					},
+					&steps.RemoveFromObservedBranchesStep{
+						Branch: domain.NewLocalBranchName("branch"),
+					},
 					&steps.RemoveFromPerennialBranchesStep{
 						Branch: domain.NewLocalBranchName("branch"),
 					},
@@ -103,14 +164,40 @@ func TestLoadSave(t *testing.T) {
 						Hard: true,
 						SHA:  domain.NewSHA("123456"),
 					},
+					&steps.ResetLocalBranchToSHAStep{
+						Branch: domain.NewLocalBranchName("branch"),
+						SHA:    domain.NewSHA("123456"),
+					},
+					&steps.ResetRemoteBranchToSHAStep{
+						Branch: domain.NewLocalBranchName("branch"),
+						SHA:    domain.NewSHA("123456"),
+					},
+					&steps.ResetSharedLineageStep{
+						SHA:       domain.NewSHA("123456"),
+						PushedSHA: domain.NewSHA("234567"),
+					},
 					&steps.RestoreOpenChangesStep{},
 					&steps.RevertCommitStep{
 						SHA: domain.NewSHA("123456"),
 					},
+					&steps.RevertMergeCommitStep{
+						SHA: domain.NewSHA("123456"),
+					},
+					&steps.SetConfigValueStep{
+						Key:    config.KeyOffline,
+						Global: true,
+						Value:  "yes",
+					},
+					&steps.SetMergedSharedLineageStep{SHA: domain.NewSHA("123456")},
 					&steps.SetParentStep{
 						Branch:       domain.NewLocalBranchName("branch"),
 						ParentBranch: domain.NewLocalBranchName("parent"),
 					},
+					&steps.SetParentSHAStep{
+						Branch: domain.NewLocalBranchName("branch"),
+						SHA:    domain.NewSHA("123456"),
+					},
+					&steps.SetSavepoint{},
 					&steps.SkipCurrentBranchSteps{},
 					&steps.SquashMergeStep{
 						Branch:        domain.NewLocalBranchName("branch"),
@@ -125,11 +212,20 @@ func TestLoadSave(t *testing.T) {
 					},
 				},
 			},
+			Savepoint: &runstate.Savepoint{
+				RunStepList: runstate.StepList{
+					List: []steps.Step{
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch")},
+					},
+				},
+				UndoStepList: runstate.StepList{},
+			},
 			UndoStepList: runstate.StepList{},
 			UnfinishedDetails: &runstate.UnfinishedRunStateDetails{
-				CanSkip:   true,
-				EndBranch: domain.NewLocalBranchName("end-branch"),
-				EndTime:   time.Time{},
+				CanSkip:    true,
+				EndBranch:  domain.NewLocalBranchName("end-branch"),
+				EndTime:    time.Time{},
+				FailedStep: &runstate.JSONStep{Step: &steps.ContinueMergeStep{}},
 			},
 		}
 
@@ -137,8 +233,23 @@ func TestLoadSave(t *testing.T) {
 {
   "AbortStepList": [],
   "Command": "command",
+  "CurrentBranchSteps": [],
+  "FailedBranches": [
+    {
+      "Branch": "failed-branch",
+      "RunStepList": [
+        {
+          "data": {
+            "Branch": "main"
+          },
+          "type": "MergeStep"
+        }
+      ]
+    }
+  ],
   "IsAbort": true,
   "IsUndo": true,
+  "KeepGoing": true,
   "RunStepList": [
     {
       "data": {},
@@ -148,6 +259,12 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "AbortRebaseStep"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "AddToObservedBranchesStep"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -164,12 +281,22 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "CommitOpenChangesStep"
     },
+    {
+      "data": {
+        "CommitMessage": "commit message",
+        "EditCommitMessage": true,
+        "Parent": "parent"
+      },
+      "type": "CompressBranchStep"
+    },
     {
       "data": {
         "Branch": "branch",
         "CommitMessage": "commit message",
         "ProposalMessage": "proposal message",
-        "ProposalNumber": 123
+        "Parent": "parent",
+        "ProposalNumber": 123,
+        "ProposalTitle": "proposal title"
       },
       "type": "ConnectorMergeProposalStep"
     },
@@ -181,6 +308,17 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "ContinueRebaseStep"
     },
+    {
+      "data": {
+        "MovedBranches": [
+          "branch"
+        ],
+        "PreviousSHAs": [
+          "123456"
+        ]
+      },
+      "type": "ContinueRebaseUpdateRefsStep"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -249,6 +387,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "EnsureHasShippableChangesStep"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "FetchTrackingBranchStep"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -258,10 +402,40 @@ func TestLoadSave(t *testing.T) {
     {
       "data": {
         "Branch": "branch",
-        "NoPushHook": true
+        "NoPushHook": true,
+        "Parent": "parent",
+        "RemoteSHA": "123456"
       },
       "type": "ForcePushBranchStep"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "NoPushHook": true,
+        "RemoteSHA": "123456"
+      },
+      "type": "ForcePushLocalBranchStep"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "NoPushHook": true,
+        "RemoteSHA": "123456"
+      },
+      "type": "ForcePushMovedBranchStep"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "CommitMessage": "commit message",
+        "Parent": "parent"
+      },
+      "type": "MergeNoFastForwardStep"
+    },
+    {
+      "data": {},
+      "type": "MergeSharedLineageStep"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -292,6 +466,10 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "PushCurrentBranchStep"
     },
+    {
+      "data": {},
+      "type": "PushSharedLineageStep"
+    },
     {
       "data": {},
       "type": "PushTagsStep"
@@ -302,6 +480,48 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseBranchStep"
     },
+    {
+      "data": {
+        "Onto": "branch",
+        "Upstream": "123456"
+      },
+      "type": "RebaseOntoStep"
+    },
+    {
+      "data": {
+        "Branch": "main",
+        "MovedBranches": [
+          "branch"
+        ]
+      },
+      "type": "RebaseUpdateRefsStep"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "Parent": "parent"
+      },
+      "type": "RecordParentSHAStep"
+    },
+    {
+      "data": {},
+      "type": "ReleaseSavepoint"
+    },
+    {
+      "data": {
+        "Global": true,
+        "Key": {
+          "Name": "git-town.offline"
+        }
+      },
+      "type": "RemoveConfigValueStep"
+    },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveFromObservedBranchesStep"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -315,6 +535,27 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ResetCurrentBranchToSHAStep"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "SHA": "123456"
+      },
+      "type": "ResetLocalBranchToSHAStep"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "SHA": "123456"
+      },
+      "type": "ResetRemoteBranchToSHAStep"
+    },
+    {
+      "data": {
+        "SHA": "123456",
+        "PushedSHA": "234567"
+      },
+      "type": "ResetSharedLineageStep"
+    },
     {
       "data": {},
       "type": "RestoreOpenChangesStep"
@@ -325,6 +566,28 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RevertCommitStep"
     },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "RevertMergeCommitStep"
+    },
+    {
+      "data": {
+        "Global": true,
+        "Key": {
+          "Name": "git-town.offline"
+        },
+        "Value": "yes"
+      },
+      "type": "SetConfigValueStep"
+    },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "SetMergedSharedLineageStep"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -332,6 +595,17 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "SetParentStep"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "SHA": "123456"
+      },
+      "type": "SetParentSHAStep"
+    },
+    {
+      "data": {},
+      "type": "SetSavepoint"
+    },
     {
       "data": {},
       "type": "SkipCurrentBranchSteps"
@@ -357,11 +631,26 @@ func TestLoadSave(t *testing.T) {
       "type": "UpdateProposalTargetStep"
     }
   ],
+  "Savepoint": {
+    "RunStepList": [
+      {
+        "data": {
+          "Branch": "branch"
+        },
+        "type": "CheckoutStep"
+      }
+    ],
+    "UndoStepList": []
+  },
   "UndoStepList": [],
   "UnfinishedDetails": {
     "CanSkip": true,
     "EndBranch": "end-branch",
-    "EndTime": "0001-01-01T00:00:00Z"
+    "EndTime": "0001-01-01T00:00:00Z",
+    "FailedStep": {
+      "data": {},
+      "type": "ContinueMergeStep"
+    }
   }
 }`[1:]
 
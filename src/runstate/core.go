@@ -12,9 +12,30 @@ import (
 
 // UnfinishedRunStateDetails has details about an unfinished run state.
 type UnfinishedRunStateDetails struct {
-	CanSkip   bool
-	EndBranch domain.LocalBranchName
-	EndTime   time.Time
+	CanSkip    bool
+	EndBranch  domain.LocalBranchName
+	EndTime    time.Time
+	FailedStep *JSONStep `exhaustruct:"optional"`
+}
+
+// BranchSteps contains the steps that a Git Town command runs on a particular branch.
+type BranchSteps struct {
+	Branch domain.LocalBranchName
+	Steps  StepList
+}
+
+// FailedBranch describes a branch that Git Town could not sync
+// and the steps that finish syncing it.
+type FailedBranch struct {
+	Branch      domain.LocalBranchName
+	RunStepList StepList
+}
+
+// Savepoint contains the state of a Git Town command at the point
+// that the command rolls back to when a step after it fails.
+type Savepoint struct {
+	RunStepList  StepList
+	UndoStepList StepList
 }
 
 func isCheckoutStep(step steps.Step) bool {
@@ -47,18 +47,24 @@ func DetermineStep(stepType string) steps.Step {
 		return &steps.AbortMergeStep{}
 	case "AbortRebaseStep":
 		return &steps.AbortRebaseStep{}
+	case "AddToObservedBranchesStep":
+		return &steps.AddToObservedBranchesStep{}
 	case "AddToPerennialBranchesStep":
 		return &steps.AddToPerennialBranchesStep{}
 	case "CheckoutStep":
 		return &steps.CheckoutStep{}
 	case "CommitOpenChangesStep":
 		return &steps.CommitOpenChangesStep{}
+	case "CompressBranchStep":
+		return &steps.CompressBranchStep{}
 	case "ConnectorMergeProposalStep":
 		return &steps.ConnectorMergeProposalStep{}
 	case "ContinueMergeStep":
 		return &steps.ContinueMergeStep{}
 	case "ContinueRebaseStep":
 		return &steps.ContinueRebaseStep{}
+	case "ContinueRebaseUpdateRefsStep":
+		return &steps.ContinueRebaseUpdateRefsStep{}
 	case "CreateBranchStep":
 		return &steps.CreateBranchStep{}
 	case "CreateProposalStep":
@@ -81,10 +87,20 @@ func DetermineStep(stepType string) steps.Step {
 		return &steps.EmptyStep{}
 	case "EnsureHasShippableChangesStep":
 		return &steps.EnsureHasShippableChangesStep{}
+	case "FetchTrackingBranchStep":
+		return &steps.FetchTrackingBranchStep{}
 	case "FetchUpstreamStep":
 		return &steps.FetchUpstreamStep{}
 	case "ForcePushBranchStep":
 		return &steps.ForcePushBranchStep{}
+	case "ForcePushLocalBranchStep":
+		return &steps.ForcePushLocalBranchStep{}
+	case "ForcePushMovedBranchStep":
+		return &steps.ForcePushMovedBranchStep{}
+	case "MergeNoFastForwardStep":
+		return &steps.MergeNoFastForwardStep{}
+	case "MergeSharedLineageStep":
+		return &steps.MergeSharedLineageStep{}
 	case "MergeStep":
 		return &steps.MergeStep{}
 	case "PreserveCheckoutHistoryStep":
@@ -95,20 +111,50 @@ func DetermineStep(stepType string) steps.Step {
 		return &steps.PushBranchAfterCurrentBranchSteps{}
 	case "PushCurrentBranchStep":
 		return &steps.PushCurrentBranchStep{}
+	case "PushSharedLineageStep":
+		return &steps.PushSharedLineageStep{}
 	case "PushTagsStep":
 		return &steps.PushTagsStep{}
 	case "RebaseBranchStep":
 		return &steps.RebaseBranchStep{}
+	case "RebaseOntoStep":
+		return &steps.RebaseOntoStep{}
+	case "RebaseUpdateRefsStep":
+		return &steps.RebaseUpdateRefsStep{}
+	case "RecordParentSHAStep":
+		return &steps.RecordParentSHAStep{}
+	case "ReleaseSavepoint":
+		return &steps.ReleaseSavepoint{}
+	case "RemoveConfigValueStep":
+		return &steps.RemoveConfigValueStep{}
+	case "RemoveFromObservedBranchesStep":
+		return &steps.RemoveFromObservedBranchesStep{}
 	case "RemoveFromPerennialBranchesStep":
 		return &steps.RemoveFromPerennialBranchesStep{}
 	case "ResetCurrentBranchToSHAStep":
 		return &steps.ResetCurrentBranchToSHAStep{}
+	case "ResetLocalBranchToSHAStep":
+		return &steps.ResetLocalBranchToSHAStep{}
+	case "ResetRemoteBranchToSHAStep":
+		return &steps.ResetRemoteBranchToSHAStep{}
+	case "ResetSharedLineageStep":
+		return &steps.ResetSharedLineageStep{}
 	case "RestoreOpenChangesStep":
 		return &steps.RestoreOpenChangesStep{}
 	case "RevertCommitStep":
 		return &steps.RevertCommitStep{}
+	case "RevertMergeCommitStep":
+		return &steps.RevertMergeCommitStep{}
+	case "SetConfigValueStep":
+		return &steps.SetConfigValueStep{}
+	case "SetMergedSharedLineageStep":
+		return &steps.SetMergedSharedLineageStep{}
 	case "SetParentStep":
 		return &steps.SetParentStep{}
+	case "SetParentSHAStep":
+		return &steps.SetParentSHAStep{}
+	case "SetSavepoint":
+		return &steps.SetSavepoint{}
 	case "SquashMergeStep":
 		return &steps.SquashMergeStep{}
 	case "SkipCurrentBranchSteps":
@@ -3,7 +3,9 @@ package runstate
 import (
 	"time"
 
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/gohacks"
 	"github.com/git-town/git-town/v9/src/steps"
 )
 
@@ -11,13 +13,17 @@ import (
 // including which operations are left to do,
 // and how to undo what has been done so far.
 type RunState struct {
-	AbortStepList     StepList                   `exhaustruct:"optional" json:"AbortStepList"`
-	Command           string                     `json:"Command"`
-	IsAbort           bool                       `exhaustruct:"optional" json:"IsAbort"`
-	IsUndo            bool                       `exhaustruct:"optional"`
-	RunStepList       StepList                   `json:"RunStepList"`
-	UndoStepList      StepList                   `exhaustruct:"optional" json:"UndoStepList"`
-	UnfinishedDetails *UnfinishedRunStateDetails `exhaustruct:"optional" json:"UnfinishedDetails"`
+	AbortStepList      StepList                   `exhaustruct:"optional" json:"AbortStepList"`
+	Command            string                     `json:"Command"`
+	CurrentBranchSteps StepList                   `exhaustruct:"optional" json:"CurrentBranchSteps"`
+	FailedBranches     []FailedBranch             `exhaustruct:"optional" json:"FailedBranches"`
+	IsAbort            bool                       `exhaustruct:"optional" json:"IsAbort"`
+	IsUndo             bool                       `exhaustruct:"optional"`
+	KeepGoing          bool                       `exhaustruct:"optional" json:"KeepGoing"`
+	RunStepList        StepList                   `json:"RunStepList"`
+	Savepoint          *Savepoint                 `exhaustruct:"optional" json:"Savepoint"`
+	UndoStepList       StepList                   `exhaustruct:"optional" json:"UndoStepList"`
+	UnfinishedDetails  *UnfinishedRunStateDetails `exhaustruct:"optional" json:"UnfinishedDetails"`
 }
 
 // AddPushBranchStepAfterCurrentBranchSteps inserts a PushBranchStep
@@ -41,6 +47,15 @@ func (runState *RunState) AddPushBranchStepAfterCurrentBranchSteps(backend *git.
 	return nil
 }
 
+// AddFailedBranch registers the given branch as not synced.
+// The given steps finish syncing this branch.
+func (runState *RunState) AddFailedBranch(branch domain.LocalBranchName, stepList StepList) {
+	runState.FailedBranches = append(runState.FailedBranches, FailedBranch{
+		Branch:      branch,
+		RunStepList: stepList,
+	})
+}
+
 // CreateAbortRunState returns a new runstate
 // to be run to aborting and undoing the Git Town command
 // represented by this runstate.
@@ -54,12 +69,63 @@ func (runState *RunState) CreateAbortRunState() RunState {
 	}
 }
 
+// CreateFailedBranchRunState returns a new runstate
+// that resumes syncing the given branch that a previous "sync --keep-going" could not sync
+// and returns to the given branch afterwards.
+// The new runstate keeps the undo steps of this runstate so that undoing it undoes the entire sync.
+// It doesn't keep going because the user wants to sync this branch now and resolve its conflicts.
+func (runState *RunState) CreateFailedBranchRunState(branch, returnBranch domain.LocalBranchName, hasOpenChanges bool) RunState {
+	failedBranches := []FailedBranch{}
+	stepList := StepList{}
+	for _, failedBranch := range runState.FailedBranches {
+		if failedBranch.Branch != branch {
+			failedBranches = append(failedBranches, failedBranch)
+			continue
+		}
+		stepList.Append(&steps.CheckoutStep{Branch: failedBranch.Branch})
+		stepList.AppendList(failedBranch.RunStepList)
+	}
+	stepList.Append(&steps.CheckoutStep{Branch: returnBranch})
+	if hasOpenChanges {
+		stepList.Prepend(&steps.StashOpenChangesStep{})
+		stepList.Append(&steps.RestoreOpenChangesStep{})
+	}
+	return RunState{
+		Command:        runState.Command,
+		FailedBranches: failedBranches,
+		KeepGoing:      false,
+		RunStepList:    stepList,
+		UndoStepList:   runState.UndoStepList,
+	}
+}
+
+// CreateRollbackRunState returns a new runstate
+// that undoes what the Git Town command represented by this runstate
+// has done since its savepoint.
+func (runState *RunState) CreateRollbackRunState() RunState {
+	stepList := runState.AbortStepList
+	newUndoStepCount := len(runState.UndoStepList.List) - len(runState.Savepoint.UndoStepList.List)
+	stepList.Append(runState.UndoStepList.List[:newUndoStepCount]...)
+	return RunState{
+		Command:     runState.Command,
+		IsAbort:     true,
+		RunStepList: stepList,
+	}
+}
+
 // CreateSkipRunState returns a new Runstate
 // that skips operations for the current branch.
+// If this runstate got rolled back to its savepoint,
+// the new runstate skips the steps until the end of the savepoint.
 func (runState *RunState) CreateSkipRunState() RunState {
+	if runState.Savepoint != nil {
+		return runState.createSkipSavepointRunState()
+	}
 	result := RunState{
-		Command:     runState.Command,
-		RunStepList: runState.AbortStepList,
+		Command:        runState.Command,
+		FailedBranches: runState.FailedBranches,
+		KeepGoing:      runState.KeepGoing,
+		RunStepList:    runState.AbortStepList,
 	}
 	for _, step := range runState.UndoStepList.List {
 		if isCheckoutStep(step) {
@@ -79,6 +145,26 @@ func (runState *RunState) CreateSkipRunState() RunState {
 	return result
 }
 
+// createSkipSavepointRunState returns a new runstate
+// that continues this rolled back runstate after the end of its savepoint.
+func (runState *RunState) createSkipSavepointRunState() RunState {
+	result := RunState{
+		Command:      runState.Command,
+		RunStepList:  StepList{},
+		UndoStepList: runState.UndoStepList,
+	}
+	skipping := true
+	for _, step := range runState.RunStepList.List {
+		if !skipping {
+			result.RunStepList.Append(step)
+		}
+		if gohacks.TypeName(step) == "ReleaseSavepoint" {
+			skipping = false
+		}
+	}
+	return result
+}
+
 // CreateUndoRunState returns a new runstate
 // to be run when undoing the Git Town command
 // represented by this runstate.
@@ -90,6 +176,20 @@ func (runState *RunState) CreateUndoRunState() RunState {
 	}
 }
 
+// HasFailedBranch indicates whether a previous "sync --keep-going" could not sync the given branch.
+func (runState *RunState) HasFailedBranch(branch domain.LocalBranchName) bool {
+	for _, failedBranch := range runState.FailedBranches {
+		if failedBranch.Branch == branch {
+			return true
+		}
+	}
+	return false
+}
+
+func (runState *RunState) HasFailedBranches() bool {
+	return len(runState.FailedBranches) > 0
+}
+
 func (runState *RunState) HasAbortSteps() bool {
 	return !runState.AbortStepList.IsEmpty()
 }
@@ -113,27 +213,73 @@ func (runState *RunState) MarkAsFinished() {
 }
 
 // MarkAsUnfinished updates the run state to be marked as unfinished and populates informational fields.
-func (runState *RunState) MarkAsUnfinished(backend *git.BackendCommands) error {
+func (runState *RunState) MarkAsUnfinished(backend *git.BackendCommands, failedStep steps.Step) error {
 	currentBranch, err := backend.CurrentBranch()
 	if err != nil {
 		return err
 	}
 	runState.UnfinishedDetails = &UnfinishedRunStateDetails{
-		CanSkip:   false,
-		EndBranch: currentBranch,
-		EndTime:   time.Now(),
+		CanSkip:    false,
+		EndBranch:  currentBranch,
+		EndTime:    time.Now(),
+		FailedStep: &JSONStep{Step: failedStep},
 	}
 	return nil
 }
 
+// RegisterFinishedStep remembers the given step as executed on the current branch.
+// Checking out another branch starts a new list of steps.
+func (runState *RunState) RegisterFinishedStep(step steps.Step) {
+	if isCheckoutStep(step) {
+		runState.CurrentBranchSteps = StepList{}
+		return
+	}
+	runState.CurrentBranchSteps.Append(step)
+}
+
+// ReleaseSavepoint forgets the savepoint of this runstate.
+func (runState *RunState) ReleaseSavepoint() {
+	runState.Savepoint = nil
+}
+
+// RemoveCurrentBranchUndoSteps removes the undo steps for the current branch
+// from this run state and provides them.
+func (runState *RunState) RemoveCurrentBranchUndoSteps() StepList {
+	result := StepList{}
+	for {
+		step := runState.UndoStepList.Peek()
+		if step == nil || isCheckoutStep(step) {
+			return result
+		}
+		result.Append(runState.UndoStepList.Pop())
+	}
+}
+
+// RollBackToSavepoint resets this runstate to the state at its savepoint.
+func (runState *RunState) RollBackToSavepoint() {
+	runState.AbortStepList = StepList{}
+	runState.RunStepList = StepList{List: append([]steps.Step{}, runState.Savepoint.RunStepList.List...)}
+	runState.UndoStepList = StepList{List: append([]steps.Step{}, runState.Savepoint.UndoStepList.List...)}
+}
+
+// SetSavepoint remembers the current state of this runstate
+// as the state to roll back to when a step after this point fails.
+func (runState *RunState) SetSavepoint() {
+	runState.Savepoint = &Savepoint{
+		RunStepList:  StepList{List: append([]steps.Step{}, runState.RunStepList.List...)},
+		UndoStepList: StepList{List: append([]steps.Step{}, runState.UndoStepList.List...)},
+	}
+}
+
 // SkipCurrentBranchSteps removes the steps for the current branch
-// from this run state.
-func (runState *RunState) SkipCurrentBranchSteps() {
+// from this run state and provides them.
+func (runState *RunState) SkipCurrentBranchSteps() StepList {
+	result := StepList{}
 	for {
 		step := runState.RunStepList.Peek()
-		if isCheckoutStep(step) {
-			break
+		if step == nil || isCheckoutStep(step) {
+			return result
 		}
-		runState.RunStepList.Pop()
+		result.Append(runState.RunStepList.Pop())
 	}
 }
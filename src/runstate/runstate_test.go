@@ -41,8 +41,11 @@ func TestRunState(t *testing.T) {
     }
   ],
   "Command": "sync",
+  "CurrentBranchSteps": [],
+  "FailedBranches": null,
   "IsAbort": false,
   "IsUndo": false,
+  "KeepGoing": false,
   "RunStepList": [
     {
       "data": {
@@ -52,6 +55,7 @@ func TestRunState(t *testing.T) {
       "type": "ResetCurrentBranchToSHAStep"
     }
   ],
+  "Savepoint": null,
   "UndoStepList": [
     {
       "data": {
@@ -69,4 +73,176 @@ func TestRunState(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, runState, newRunState)
 	})
+
+	t.Run("CreateFailedBranchRunState", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.RunState{
+			Command: "sync",
+			FailedBranches: []runstate.FailedBranch{
+				{
+					Branch:      domain.NewLocalBranchName("alpha"),
+					RunStepList: runstate.NewStepList(&steps.MergeStep{Branch: domain.NewBranchName("main")}),
+				},
+				{
+					Branch:      domain.NewLocalBranchName("beta"),
+					RunStepList: runstate.NewStepList(&steps.MergeStep{Branch: domain.NewBranchName("origin/beta")}),
+				},
+			},
+			KeepGoing:    true,
+			RunStepList:  runstate.StepList{},
+			UndoStepList: runstate.NewStepList(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")}),
+		}
+		t.Run("first failed branch", func(t *testing.T) {
+			t.Parallel()
+			have := runState.CreateFailedBranchRunState(domain.NewLocalBranchName("alpha"), domain.NewLocalBranchName("main"), true)
+			want := runstate.RunState{
+				Command: "sync",
+				FailedBranches: []runstate.FailedBranch{
+					{
+						Branch:      domain.NewLocalBranchName("beta"),
+						RunStepList: runstate.NewStepList(&steps.MergeStep{Branch: domain.NewBranchName("origin/beta")}),
+					},
+				},
+				KeepGoing: false,
+				RunStepList: runstate.StepList{
+					List: []steps.Step{
+						&steps.StashOpenChangesStep{},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("alpha")},
+						&steps.MergeStep{Branch: domain.NewBranchName("main")},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")},
+						&steps.RestoreOpenChangesStep{},
+					},
+				},
+				UndoStepList: runstate.NewStepList(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")}),
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("later failed branch", func(t *testing.T) {
+			t.Parallel()
+			have := runState.CreateFailedBranchRunState(domain.NewLocalBranchName("beta"), domain.NewLocalBranchName("main"), false)
+			want := runstate.RunState{
+				Command: "sync",
+				FailedBranches: []runstate.FailedBranch{
+					{
+						Branch:      domain.NewLocalBranchName("alpha"),
+						RunStepList: runstate.NewStepList(&steps.MergeStep{Branch: domain.NewBranchName("main")}),
+					},
+				},
+				KeepGoing: false,
+				RunStepList: runstate.StepList{
+					List: []steps.Step{
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("beta")},
+						&steps.MergeStep{Branch: domain.NewBranchName("origin/beta")},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")},
+					},
+				},
+				UndoStepList: runstate.NewStepList(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")}),
+			}
+			assert.Equal(t, want, have)
+		})
+	})
+
+	t.Run("CreateRollbackRunState", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.RunState{
+			Command:     "ship",
+			RunStepList: runstate.StepList{},
+		}
+		runState.UndoStepList.Append(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")})
+		runState.SetSavepoint()
+		runState.AbortStepList.Append(&steps.DiscardOpenChangesStep{})
+		runState.UndoStepList.Prepend(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("alpha")})
+		have := runState.CreateRollbackRunState()
+		want := runstate.RunState{
+			Command: "ship",
+			IsAbort: true,
+			RunStepList: runstate.StepList{
+				List: []steps.Step{
+					&steps.DiscardOpenChangesStep{},
+					&steps.CheckoutStep{Branch: domain.NewLocalBranchName("alpha")},
+				},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("CreateSkipRunState", func(t *testing.T) {
+		t.Parallel()
+		t.Run("rolled back to a savepoint", func(t *testing.T) {
+			t.Parallel()
+			runState := runstate.RunState{
+				Command: "ship",
+				RunStepList: runstate.StepList{
+					List: []steps.Step{
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("beta")},
+						&steps.SquashMergeStep{Branch: domain.NewLocalBranchName("beta"), CommitMessage: "", Parent: domain.NewLocalBranchName("main")},
+						&steps.ReleaseSavepoint{},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")},
+					},
+				},
+				UndoStepList: runstate.NewStepList(&steps.RevertCommitStep{SHA: domain.NewSHA("123456")}),
+			}
+			runState.SetSavepoint()
+			runState.RollBackToSavepoint()
+			have := runState.CreateSkipRunState()
+			want := runstate.RunState{
+				Command:      "ship",
+				RunStepList:  runstate.NewStepList(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("main")}),
+				UndoStepList: runstate.NewStepList(&steps.RevertCommitStep{SHA: domain.NewSHA("123456")}),
+			}
+			assert.Equal(t, want, have)
+		})
+	})
+
+	t.Run("RegisterFinishedStep", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.RunState{
+			Command:     "sync",
+			RunStepList: runstate.StepList{},
+		}
+		runState.RegisterFinishedStep(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("alpha")})
+		runState.RegisterFinishedStep(&steps.MergeStep{Branch: domain.NewBranchName("main")})
+		runState.RegisterFinishedStep(&steps.CheckoutStep{Branch: domain.NewLocalBranchName("beta")})
+		runState.RegisterFinishedStep(&steps.MergeStep{Branch: domain.NewBranchName("origin/beta")})
+		runState.RegisterFinishedStep(&steps.MergeStep{Branch: domain.NewBranchName("main")})
+		want := []steps.Step{
+			&steps.MergeStep{Branch: domain.NewBranchName("origin/beta")},
+			&steps.MergeStep{Branch: domain.NewBranchName("main")},
+		}
+		assert.Equal(t, want, runState.CurrentBranchSteps.List)
+	})
+
+	t.Run("SkipCurrentBranchSteps", func(t *testing.T) {
+		t.Parallel()
+		t.Run("steps for other branches follow", func(t *testing.T) {
+			t.Parallel()
+			runState := runstate.RunState{
+				Command: "sync",
+				RunStepList: runstate.StepList{
+					List: []steps.Step{
+						&steps.MergeStep{Branch: domain.NewBranchName("main")},
+						&steps.PushCurrentBranchStep{CurrentBranch: domain.NewLocalBranchName("alpha"), NoPushHook: false, Undoable: false},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("beta")},
+					},
+				},
+			}
+			have := runState.SkipCurrentBranchSteps()
+			want := []steps.Step{
+				&steps.MergeStep{Branch: domain.NewBranchName("main")},
+				&steps.PushCurrentBranchStep{CurrentBranch: domain.NewLocalBranchName("alpha"), NoPushHook: false, Undoable: false},
+			}
+			assert.Equal(t, want, have.List)
+			assert.Equal(t, []steps.Step{&steps.CheckoutStep{Branch: domain.NewLocalBranchName("beta")}}, runState.RunStepList.List)
+		})
+		t.Run("no more steps", func(t *testing.T) {
+			t.Parallel()
+			runState := runstate.RunState{
+				Command:     "sync",
+				RunStepList: runstate.NewStepList(&steps.MergeStep{Branch: domain.NewBranchName("main")}),
+			}
+			have := runState.SkipCurrentBranchSteps()
+			assert.Equal(t, []steps.Step{&steps.MergeStep{Branch: domain.NewBranchName("main")}}, have.List)
+			assert.True(t, runState.RunStepList.IsEmpty())
+		})
+	})
 }
@@ -32,6 +32,28 @@ func (stepList *StepList) AppendList(otherList StepList) {
 	stepList.List = append(stepList.List, otherList.List...)
 }
 
+// GroupByBranch splits this StepList into the steps that run on the individual branches.
+// Steps before the first CheckoutStep run on the given current branch.
+// Checking out the branch that the steps already run on does not start a new group.
+func (stepList *StepList) GroupByBranch(currentBranch domain.LocalBranchName) []BranchSteps {
+	result := []BranchSteps{}
+	group := BranchSteps{Branch: currentBranch, Steps: StepList{}}
+	for _, step := range stepList.List {
+		checkoutStep, isCheckout := step.(*steps.CheckoutStep)
+		if isCheckout && checkoutStep.Branch != group.Branch {
+			if !group.Steps.IsEmpty() {
+				result = append(result, group)
+			}
+			group = BranchSteps{Branch: checkoutStep.Branch, Steps: StepList{}}
+		}
+		group.Steps.Append(step)
+	}
+	if !group.Steps.IsEmpty() {
+		result = append(result, group)
+	}
+	return result
+}
+
 // IsEmpty returns whether or not this StepList has any elements.
 func (stepList *StepList) IsEmpty() bool {
 	return len(stepList.List) == 0
@@ -108,11 +130,16 @@ type WrapOptions struct {
 	MainBranch       domain.LocalBranchName
 	InitialBranch    domain.LocalBranchName
 	PreviousBranch   domain.LocalBranchName
+	ShareLineage     bool
 }
 
 // Wrap wraps the list with steps that
-// change to the Git root directory or stash away open changes.
+// change to the Git root directory, stash away open changes, or share the lineage.
 func (stepList *StepList) Wrap(options WrapOptions) error {
+	if options.ShareLineage {
+		stepList.Prepend(&steps.MergeSharedLineageStep{})
+		stepList.Append(&steps.PushSharedLineageStep{})
+	}
 	if !options.PreviousBranch.IsEmpty() {
 		stepList.Append(&steps.PreserveCheckoutHistoryStep{
 			InitialBranch:                     options.InitialBranch,
@@ -56,6 +56,71 @@ func TestStepList(t *testing.T) {
 		})
 	})
 
+	t.Run("GroupByBranch", func(t *testing.T) {
+		t.Parallel()
+		t.Run("steps on several branches", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{List: []steps.Step{
+				&steps.ContinueMergeStep{},
+				&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-2")},
+				&steps.MergeStep{Branch: domain.NewBranchName("main")},
+			}}
+			have := list.GroupByBranch(domain.NewLocalBranchName("branch-1"))
+			want := []runstate.BranchSteps{
+				{
+					Branch: domain.NewLocalBranchName("branch-1"),
+					Steps:  runstate.StepList{List: []steps.Step{&steps.ContinueMergeStep{}}},
+				},
+				{
+					Branch: domain.NewLocalBranchName("branch-2"),
+					Steps: runstate.StepList{List: []steps.Step{
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-2")},
+						&steps.MergeStep{Branch: domain.NewBranchName("main")},
+					}},
+				},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("starts with a checkout", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{List: []steps.Step{
+				&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-2")},
+			}}
+			have := list.GroupByBranch(domain.NewLocalBranchName("branch-1"))
+			want := []runstate.BranchSteps{
+				{
+					Branch: domain.NewLocalBranchName("branch-2"),
+					Steps:  runstate.StepList{List: []steps.Step{&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-2")}}},
+				},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("checks out the current branch", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{List: []steps.Step{
+				&steps.ContinueMergeStep{},
+				&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-1")},
+			}}
+			have := list.GroupByBranch(domain.NewLocalBranchName("branch-1"))
+			want := []runstate.BranchSteps{
+				{
+					Branch: domain.NewLocalBranchName("branch-1"),
+					Steps: runstate.StepList{List: []steps.Step{
+						&steps.ContinueMergeStep{},
+						&steps.CheckoutStep{Branch: domain.NewLocalBranchName("branch-1")},
+					}},
+				},
+			}
+			assert.Equal(t, want, have)
+		})
+		t.Run("empty list", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{}
+			have := list.GroupByBranch(domain.NewLocalBranchName("branch-1"))
+			assert.Equal(t, []runstate.BranchSteps{}, have)
+		})
+	})
+
 	t.Run("IsEmpty", func(t *testing.T) {
 		t.Parallel()
 		t.Run("list is empty", func(t *testing.T) {
@@ -220,4 +285,43 @@ StepList:
 		}}
 		assert.Equal(t, want, have)
 	})
+
+	t.Run("Wrap", func(t *testing.T) {
+		t.Parallel()
+		t.Run("shares the lineage", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{List: []steps.Step{&steps.AbortMergeStep{}}}
+			err := list.Wrap(runstate.WrapOptions{
+				RunInGitRoot:     false,
+				StashOpenChanges: true,
+				MainBranch:       domain.NewLocalBranchName("main"),
+				InitialBranch:    domain.NewLocalBranchName("branch"),
+				PreviousBranch:   domain.LocalBranchName{},
+				ShareLineage:     true,
+			})
+			assert.NoError(t, err)
+			want := []steps.Step{
+				&steps.StashOpenChangesStep{},
+				&steps.MergeSharedLineageStep{},
+				&steps.AbortMergeStep{},
+				&steps.PushSharedLineageStep{},
+				&steps.RestoreOpenChangesStep{},
+			}
+			assert.Equal(t, want, list.List)
+		})
+		t.Run("doesn't share the lineage", func(t *testing.T) {
+			t.Parallel()
+			list := runstate.StepList{List: []steps.Step{&steps.AbortMergeStep{}}}
+			err := list.Wrap(runstate.WrapOptions{
+				RunInGitRoot:     false,
+				StashOpenChanges: false,
+				MainBranch:       domain.NewLocalBranchName("main"),
+				InitialBranch:    domain.NewLocalBranchName("branch"),
+				PreviousBranch:   domain.LocalBranchName{},
+				ShareLineage:     false,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []steps.Step{&steps.AbortMergeStep{}}, list.List)
+		})
+	})
 }
@@ -10,26 +10,31 @@ import (
 
 // errored handles the situation when the given step was executed and has resulted in the given error.
 func errored(step steps.Step, runErr error, args ExecuteArgs) error {
+	if args.RunState.KeepGoing {
+		canSkip, err := canSkip(step, args)
+		if err != nil {
+			return err
+		}
+		if canSkip {
+			return keepGoing(step, runErr, args)
+		}
+	}
 	args.RunState.AbortStepList.Append(step.CreateAbortSteps()...)
 	if step.ShouldAutomaticallyAbortOnError() {
+		if args.RunState.Savepoint != nil {
+			return rollBack(step, runErr, args)
+		}
 		return autoAbort(step, runErr, args)
 	}
 	args.RunState.RunStepList.Prepend(step.CreateContinueSteps()...)
-	err := args.RunState.MarkAsUnfinished(&args.Run.Backend)
-	if err != nil {
-		return err
-	}
-	currentBranch, err := args.Run.Backend.CurrentBranch()
+	err := args.RunState.MarkAsUnfinished(&args.Run.Backend, step)
 	if err != nil {
 		return err
 	}
-	rebasing, err := args.Run.Backend.HasRebaseInProgress()
+	args.RunState.UnfinishedDetails.CanSkip, err = canSkip(step, args)
 	if err != nil {
 		return err
 	}
-	if args.RunState.Command == "sync" && !(rebasing && args.Run.Config.IsMainBranch(currentBranch)) {
-		args.RunState.UnfinishedDetails.CanSkip = true
-	}
 	err = persistence.Save(args.RunState, args.RootDir)
 	if err != nil {
 		return fmt.Errorf(messages.RunstateSaveProblem, err)
@@ -41,3 +46,19 @@ func errored(step steps.Step, runErr error, args ExecuteArgs) error {
 	message += "\n"
 	return fmt.Errorf(message)
 }
+
+// canSkip indicates whether the user can skip the branch on which the given step failed.
+func canSkip(step steps.Step, args ExecuteArgs) (bool, error) {
+	if args.RunState.Command != "sync" || step.ShouldAutomaticallyAbortOnError() {
+		return false, nil
+	}
+	currentBranch, err := args.Run.Backend.CurrentBranch()
+	if err != nil {
+		return false, err
+	}
+	rebasing, err := args.Run.Backend.HasRebaseInProgress()
+	if err != nil {
+		return false, err
+	}
+	return !(rebasing && args.Run.Config.IsMainBranch(currentBranch)), nil
+}
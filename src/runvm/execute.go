@@ -32,11 +32,21 @@ func Execute(args ExecuteArgs) error {
 			}
 			continue
 		}
+		if stepName == "SetSavepoint" {
+			args.RunState.SetSavepoint()
+			continue
+		}
+		if stepName == "ReleaseSavepoint" {
+			args.RunState.ReleaseSavepoint()
+			continue
+		}
+		args.Run.Stats.StartStep(stepName)
 		err := step.Run(steps.RunArgs{
 			Runner:    args.Run,
 			Connector: args.Connector,
 			Lineage:   args.Lineage,
 		})
+		args.Run.Stats.FinishStep()
 		if err != nil {
 			return errored(step, err, args)
 		}
@@ -45,6 +55,9 @@ func Execute(args ExecuteArgs) error {
 			return fmt.Errorf(messages.UndoCreateStepProblem, step, err)
 		}
 		args.RunState.UndoStepList.Prepend(undoSteps...)
+		if args.RunState.KeepGoing {
+			args.RunState.RegisterFinishedStep(step)
+		}
 	}
 }
 
@@ -2,7 +2,9 @@ package runvm
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/git-town/git-town/v9/src/cli"
 	"github.com/git-town/git-town/v9/src/messages"
 	"github.com/git-town/git-town/v9/src/persistence"
 )
@@ -22,6 +24,18 @@ func finished(args ExecuteArgs) error {
 		}
 	}
 	fmt.Println()
+	if args.RunState.HasFailedBranches() {
+		printFailedBranches(args)
+	}
 	args.Run.Stats.PrintAnalysis()
 	return nil
 }
+
+// printFailedBranches prints the branches that this Git Town command could not sync.
+func printFailedBranches(args ExecuteArgs) {
+	lines := make([]string, len(args.RunState.FailedBranches))
+	for f, failedBranch := range args.RunState.FailedBranches {
+		lines[f] = "- " + failedBranch.Branch.String()
+	}
+	cli.Printf(messages.SyncFailedBranches, strings.Join(lines, "\n"))
+}
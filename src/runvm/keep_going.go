@@ -0,0 +1,43 @@
+package runvm
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/runstate"
+	"github.com/git-town/git-town/v9/src/steps"
+)
+
+// keepGoing skips the branch on which the given step failed
+// and continues executing the steps for the remaining branches.
+//
+// It cleans up the failed operation, reverts the changes made to the failed branch so far,
+// and registers the failed branch together with all steps for it,
+// including the already reverted ones, to sync it later.
+func keepGoing(step steps.Step, runErr error, args ExecuteArgs) error {
+	currentBranch, err := args.Run.Backend.CurrentBranch()
+	if err != nil {
+		return err
+	}
+	cli.PrintError(fmt.Errorf(messages.RunKeepGoing, currentBranch, runErr))
+	cleanupSteps := runstate.StepList{}
+	cleanupSteps.Append(step.CreateAbortSteps()...)
+	cleanupSteps.AppendList(args.RunState.RemoveCurrentBranchUndoSteps())
+	for _, cleanupStep := range cleanupSteps.List {
+		err = cleanupStep.Run(steps.RunArgs{
+			Runner:    args.Run,
+			Connector: args.Connector,
+			Lineage:   args.Lineage,
+		})
+		if err != nil {
+			return fmt.Errorf(messages.RunKeepGoingCleanupProblem, currentBranch, err)
+		}
+	}
+	remainingSteps := args.RunState.CurrentBranchSteps
+	remainingSteps.Append(step)
+	remainingSteps.AppendList(args.RunState.SkipCurrentBranchSteps())
+	args.RunState.CurrentBranchSteps = runstate.StepList{}
+	args.RunState.AddFailedBranch(currentBranch, remainingSteps)
+	return Execute(args)
+}
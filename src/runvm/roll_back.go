@@ -0,0 +1,39 @@
+package runvm
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/persistence"
+	"github.com/git-town/git-town/v9/src/steps"
+)
+
+// rollBack undoes what the current Git Town command has done since its savepoint
+// and saves the runstate so that the user can continue the command from the savepoint,
+// skip the part of the command after the savepoint, or abort the entire command.
+func rollBack(step steps.Step, runErr error, args ExecuteArgs) error {
+	cli.PrintError(fmt.Errorf(messages.RunRollingBack, runErr.Error()))
+	rollbackRunState := args.RunState.CreateRollbackRunState()
+	err := Execute(ExecuteArgs{
+		RunState:  &rollbackRunState,
+		Run:       args.Run,
+		Connector: args.Connector,
+		RootDir:   args.RootDir,
+		Lineage:   args.Lineage,
+	})
+	if err != nil {
+		return fmt.Errorf(messages.RunstateAbortStepProblem, err)
+	}
+	args.RunState.RollBackToSavepoint()
+	err = args.RunState.MarkAsUnfinished(&args.Run.Backend, step)
+	if err != nil {
+		return err
+	}
+	args.RunState.UnfinishedDetails.CanSkip = true
+	err = persistence.Save(args.RunState, args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSaveProblem, err)
+	}
+	return fmt.Errorf(step.CreateAutomaticAbortError().Error() + messages.RollbackGuidance)
+}
@@ -0,0 +1,172 @@
+package statistics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/cli"
+)
+
+// Collector is a Statistics implementation that records all commands that Git Town runs.
+type Collector struct {
+	Debug     bool         // whether to print how many commands were run
+	Disabled  bool         `exhaustruct:"optional"` // whether this Collector ignores commands because nothing needs them
+	Log       RunLog       `exhaustruct:"optional"` // if set, records all commands in this persistent log
+	Profile   bool         // whether to print how long the commands took
+	Runs      []CommandRun `exhaustruct:"optional"`
+	Step      string       `exhaustruct:"optional"` // the step that currently executes, empty outside of steps
+	TraceFile string       // if set, exports the recorded commands to this file in the Chrome trace-event format
+}
+
+// Disable makes this Collector ignore all further commands and forget the commands it has collected.
+// Git Town uses this when neither the command log nor the debug or profile output needs them.
+func (c *Collector) Disable() {
+	c.Disabled = true
+	c.Runs = nil
+}
+
+// EnableLog makes this Collector record all commands in the given persistent log,
+// including the commands that it has already collected.
+func (c *Collector) EnableLog(log RunLog) {
+	c.Log = log
+	for _, run := range c.Runs {
+		c.writeLog(run)
+	}
+}
+
+// FinishStep signals that the current step is done,
+// so that the commands that run afterwards don't get attributed to it.
+func (c *Collector) FinishStep() {
+	c.Step = ""
+}
+
+func (c *Collector) PrintAnalysis() {
+	if c.Debug {
+		fmt.Printf("Ran %d shell commands.", len(c.Runs))
+	}
+	if c.Profile {
+		if c.Debug {
+			fmt.Print("\n\n")
+		}
+		c.printProfile()
+	}
+	if c.TraceFile != "" {
+		err := c.ExportTrace(c.TraceFile)
+		if err != nil {
+			cli.PrintError(err)
+		}
+	}
+}
+
+func (c *Collector) RegisterRun(run CommandRun) {
+	if c.Disabled {
+		return
+	}
+	run.Step = c.Step
+	c.Runs = append(c.Runs, run)
+	c.writeLog(run)
+}
+
+func (c *Collector) StartStep(name string) {
+	c.Step = name
+}
+
+// StepDurations provides the total time that the commands of each step took, longest first.
+func (c *Collector) StepDurations() []StepDuration {
+	result := []StepDuration{}
+	for _, run := range c.Runs {
+		found := false
+		for s := range result {
+			if result[s].Step == stepName(run.Step) {
+				result[s].Duration += run.Duration
+				result[s].Commands++
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, StepDuration{Commands: 1, Duration: run.Duration, Step: stepName(run.Step)})
+		}
+	}
+	sort.SliceStable(result, func(a, b int) bool {
+		return result[a].Duration > result[b].Duration
+	})
+	return result
+}
+
+// SortedRuns provides the recorded commands, longest first.
+func (c *Collector) SortedRuns() []CommandRun {
+	result := make([]CommandRun, len(c.Runs))
+	copy(result, c.Runs)
+	sort.SliceStable(result, func(a, b int) bool {
+		return result[a].Duration > result[b].Duration
+	})
+	return result
+}
+
+// TotalDuration provides how long all recorded commands took together.
+func (c *Collector) TotalDuration() time.Duration {
+	var result time.Duration
+	for _, run := range c.Runs {
+		result += run.Duration
+	}
+	return result
+}
+
+func (c *Collector) printProfile() {
+	fmt.Printf("Profile: %d shell commands took %s.\n", len(c.Runs), formatDuration(c.TotalDuration()))
+	fmt.Println("\nCommands:")
+	fmt.Printf("  %10s  %-8s  %-4s  %-30s  %s\n", "DURATION", "TYPE", "EXIT", "STEP", "COMMAND")
+	for _, run := range c.SortedRuns() {
+		fmt.Printf("  %10s  %-8s  %-4d  %-30s  %s\n", formatDuration(run.Duration), commandType(run.Frontend), run.ExitCode, stepName(run.Step), run.Command)
+	}
+	fmt.Println("\nSteps:")
+	for _, step := range c.StepDurations() {
+		fmt.Printf("  %10s  %-30s  %d commands\n", formatDuration(step.Duration), step.Step, step.Commands)
+	}
+}
+
+// writeLog records the given command in the persistent log.
+// Since the log is a diagnostic tool, problems writing it don't stop Git Town.
+// They disable the log for the rest of the current command.
+func (c *Collector) writeLog(run CommandRun) {
+	if c.Log == nil {
+		return
+	}
+	err := c.Log.RecordRun(run)
+	if err != nil {
+		cli.PrintError(err)
+		c.Log = nil
+	}
+}
+
+// RunLog is a persistent log of the commands that Git Town runs.
+type RunLog interface {
+	RecordRun(CommandRun) error
+}
+
+// StepDuration describes how long the commands of a step took together.
+type StepDuration struct {
+	Commands int
+	Duration time.Duration
+	Step     string
+}
+
+func commandType(frontend bool) string {
+	if frontend {
+		return "frontend"
+	}
+	return "backend"
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Microsecond).String()
+}
+
+func stepName(step string) string {
+	if step == "" {
+		return "(no step)"
+	}
+	return step
+}
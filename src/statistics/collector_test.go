@@ -0,0 +1,110 @@
+package statistics_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v9/src/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCollector := func() statistics.Collector {
+		collector := statistics.Collector{Debug: false, Profile: true, TraceFile: ""}
+		collector.RegisterRun(statistics.CommandRun{Command: "git version", Duration: 2 * time.Millisecond, ExitCode: 0, Frontend: false, Start: start})
+		collector.StartStep("CheckoutStep")
+		collector.RegisterRun(statistics.CommandRun{Command: "git checkout main", Duration: 5 * time.Millisecond, ExitCode: 0, Frontend: true, Start: start.Add(3 * time.Millisecond)})
+		collector.RegisterRun(statistics.CommandRun{Command: "git rev-parse HEAD", Duration: 1 * time.Millisecond, ExitCode: 128, Frontend: false, Start: start.Add(9 * time.Millisecond)})
+		return collector
+	}
+
+	t.Run("EnableLog", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		log := testLog{}
+		collector.EnableLog(&log)
+		collector.RegisterRun(statistics.CommandRun{Command: "git status", Duration: 1 * time.Millisecond, ExitCode: 0, Frontend: false, Start: start.Add(10 * time.Millisecond)})
+		have := []string{}
+		for _, run := range log.runs {
+			have = append(have, run.Command)
+		}
+		want := []string{"git version", "git checkout main", "git rev-parse HEAD", "git status"}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("RegisterRun", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		assert.Len(t, collector.Runs, 3)
+		assert.Equal(t, "", collector.Runs[0].Step)
+		assert.Equal(t, "CheckoutStep", collector.Runs[1].Step)
+		assert.Equal(t, "CheckoutStep", collector.Runs[2].Step)
+		collector.FinishStep()
+		collector.RegisterRun(statistics.CommandRun{Command: "git status", Duration: 1 * time.Millisecond, ExitCode: 0, Frontend: false, Start: start.Add(10 * time.Millisecond)})
+		assert.Equal(t, "", collector.Runs[3].Step)
+	})
+
+	t.Run("SortedRuns", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		have := collector.SortedRuns()
+		assert.Equal(t, "git checkout main", have[0].Command)
+		assert.Equal(t, "git version", have[1].Command)
+		assert.Equal(t, "git rev-parse HEAD", have[2].Command)
+		assert.Equal(t, "git version", collector.Runs[0].Command)
+	})
+
+	t.Run("StepDurations", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		want := []statistics.StepDuration{
+			{Commands: 2, Duration: 6 * time.Millisecond, Step: "CheckoutStep"},
+			{Commands: 1, Duration: 2 * time.Millisecond, Step: "(no step)"},
+		}
+		assert.Equal(t, want, collector.StepDurations())
+	})
+
+	t.Run("TotalDuration", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		assert.Equal(t, 8*time.Millisecond, collector.TotalDuration())
+	})
+
+	t.Run("Trace", func(t *testing.T) {
+		t.Parallel()
+		collector := newCollector()
+		have := collector.Trace()
+		assert.Equal(t, "ms", have.DisplayTimeUnit)
+		assert.Len(t, have.TraceEvents, 3)
+		want := statistics.TraceEvent{
+			Args:      statistics.TraceEventArgs{ExitCode: 128, Step: "CheckoutStep"},
+			Category:  "backend",
+			Duration:  1000,
+			Name:      "git rev-parse HEAD",
+			Phase:     "X",
+			ProcessID: 1,
+			ThreadID:  1,
+			Timestamp: 9000,
+		}
+		assert.Equal(t, want, have.TraceEvents[2])
+	})
+}
+
+// testLog is a RunLog implementation for testing.
+type testLog struct {
+	runs []statistics.CommandRun
+}
+
+func (l *testLog) RecordRun(run statistics.CommandRun) error {
+	l.runs = append(l.runs, run)
+	return nil
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 0, statistics.ExitCode(nil))
+	assert.Equal(t, -1, statistics.ExitCode(errors.New("cannot start")))
+}
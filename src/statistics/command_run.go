@@ -0,0 +1,42 @@
+package statistics
+
+import (
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// CommandRun describes a shell command that Git Town has executed.
+type CommandRun struct {
+	Command  string        // the executed command including its arguments
+	Duration time.Duration // how long the command ran
+	ExitCode int           // the exit code of the command, -1 if it could not be started
+	Frontend bool          // whether this is a frontend command that the user sees
+	Output   string        `exhaustruct:"optional"` // the output of the command
+	Start    time.Time     // when the command started
+	Step     string        `exhaustruct:"optional"` // the step that ran this command, empty for commands run while loading the repo
+}
+
+// NewCommandRun provides a CommandRun describing a command that started at the given time
+// and ended with the given error just now.
+func NewCommandRun(command string, start time.Time, err error, frontend bool) CommandRun {
+	return CommandRun{
+		Command:  command,
+		Duration: time.Since(start),
+		ExitCode: ExitCode(err),
+		Frontend: frontend,
+		Start:    start,
+	}
+}
+
+// ExitCode provides the exit code of a command that ended with the given error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
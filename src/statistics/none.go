@@ -3,6 +3,10 @@ package statistics
 // None is a statistics implementation that does nothing.
 type None struct{}
 
-func (s *None) RegisterRun() {}
+func (s *None) FinishStep() {}
+
+func (s *None) RegisterRun(CommandRun) {}
 
 func (s *None) PrintAnalysis() {}
+
+func (s *None) StartStep(string) {}
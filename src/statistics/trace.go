@@ -0,0 +1,75 @@
+package statistics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// ExportTrace writes the recorded commands to the file with the given path
+// in the Chrome trace-event format, which chrome://tracing and https://ui.perfetto.dev can display.
+func (c *Collector) ExportTrace(path string) error {
+	content, err := json.MarshalIndent(c.Trace(), "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.ProfileTraceProblem, path, err)
+	}
+	err = os.WriteFile(path, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.ProfileTraceProblem, path, err)
+	}
+	return nil
+}
+
+// Trace provides the recorded commands as Chrome trace events.
+func (c *Collector) Trace() Trace {
+	result := Trace{
+		DisplayTimeUnit: "ms",
+		TraceEvents:     make([]TraceEvent, len(c.Runs)),
+	}
+	if len(c.Runs) == 0 {
+		return result
+	}
+	start := c.Runs[0].Start
+	for r, run := range c.Runs {
+		result.TraceEvents[r] = TraceEvent{
+			Args: TraceEventArgs{
+				ExitCode: run.ExitCode,
+				Step:     stepName(run.Step),
+			},
+			Category:  commandType(run.Frontend),
+			Duration:  run.Duration.Microseconds(),
+			Name:      run.Command,
+			Phase:     "X",
+			ProcessID: 1,
+			ThreadID:  1,
+			Timestamp: run.Start.Sub(start).Microseconds(),
+		}
+	}
+	return result
+}
+
+// Trace is a Chrome trace-event file, see https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU.
+type Trace struct {
+	DisplayTimeUnit string       `json:"displayTimeUnit"`
+	TraceEvents     []TraceEvent `json:"traceEvents"`
+}
+
+// TraceEvent is a complete event in a Chrome trace-event file.
+type TraceEvent struct {
+	Args      TraceEventArgs `json:"args"`
+	Category  string         `json:"cat"`
+	Duration  int64          `json:"dur"`
+	Name      string         `json:"name"`
+	Phase     string         `json:"ph"`
+	ProcessID int            `json:"pid"`
+	ThreadID  int            `json:"tid"`
+	Timestamp int64          `json:"ts"`
+}
+
+// TraceEventArgs contains additional information about a TraceEvent.
+type TraceEventArgs struct {
+	ExitCode int    `json:"exitCode"`
+	Step     string `json:"step"`
+}
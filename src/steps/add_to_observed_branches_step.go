@@ -0,0 +1,20 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// AddToObservedBranchesStep adds the branch with the given name as an observed branch.
+type AddToObservedBranchesStep struct {
+	Branch domain.LocalBranchName
+	EmptyStep
+}
+
+func (step *AddToObservedBranchesStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&RemoveFromObservedBranchesStep{Branch: step.Branch}}, nil
+}
+
+func (step *AddToObservedBranchesStep) Run(args RunArgs) error {
+	return args.Runner.Config.AddToObservedBranches(step.Branch)
+}
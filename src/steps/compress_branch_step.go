@@ -0,0 +1,52 @@
+package steps
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// CompressBranchStep squashes all commits that the current branch has on top of the given parent branch
+// into a single commit.
+// The current branch must contain the latest commit of its parent.
+type CompressBranchStep struct {
+	CommitMessage     string
+	EditCommitMessage bool // whether the user edits the given commit message before committing
+	Parent            domain.LocalBranchName
+	previousSHA       domain.SHA `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *CompressBranchStep) CreateAbortSteps() []Step {
+	return []Step{&ResetCurrentBranchToSHAStep{Hard: true, SHA: step.previousSHA}}
+}
+
+func (step *CompressBranchStep) CreateAutomaticAbortError() error {
+	return fmt.Errorf(messages.CompressAborted)
+}
+
+func (step *CompressBranchStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&ResetCurrentBranchToSHAStep{Hard: true, SHA: step.previousSHA}}, nil
+}
+
+func (step *CompressBranchStep) Run(args RunArgs) error {
+	var err error
+	step.previousSHA, err = args.Runner.Backend.CurrentSHA()
+	if err != nil {
+		return err
+	}
+	err = args.Runner.Frontend.SoftResetCurrentBranch(step.Parent)
+	if err != nil {
+		return err
+	}
+	if step.EditCommitMessage {
+		return args.Runner.Frontend.CommitWithEditor(step.CommitMessage)
+	}
+	return args.Runner.Frontend.Commit(step.CommitMessage, "")
+}
+
+func (step *CompressBranchStep) ShouldAutomaticallyAbortOnError() bool {
+	return true
+}
@@ -16,7 +16,9 @@ type ConnectorMergeProposalStep struct {
 	enteredEmptyCommitMessage bool
 	mergeError                error
 	mergeSHA                  domain.SHA
+	Parent                    domain.LocalBranchName
 	ProposalNumber            int
+	ProposalTitle             string
 	EmptyStep
 }
 
@@ -40,20 +42,39 @@ func (step *ConnectorMergeProposalStep) CreateAutomaticAbortError() error {
 
 func (step *ConnectorMergeProposalStep) Run(args RunArgs) error {
 	commitMessage := step.CommitMessage
+	var err error
+	if commitMessage == "" {
+		commitMessage, err = templatedShipMessage(args, step.Branch, step.Parent, step.ProposalNumber, step.ProposalTitle)
+		if err != nil {
+			return err
+		}
+	}
+	branchAuthors, err := args.Runner.Backend.BranchAuthors(step.Branch, step.Parent)
+	if err != nil {
+		return err
+	}
+	repoAuthor, err := args.Runner.Backend.Author()
+	if err != nil {
+		return fmt.Errorf(messages.GitUserProblem, err)
+	}
+	trailers, err := shipTrailers(args, step.Branch, step.ProposalNumber, branchAuthors, repoAuthor)
+	if err != nil {
+		return err
+	}
 	//nolint:nestif
 	if commitMessage == "" {
 		// Allow the user to enter the commit message as if shipping without a connector
 		// then revert the commit since merging via the connector will perform the actual squash merge.
 		step.enteredEmptyCommitMessage = true
-		err := args.Runner.Frontend.SquashMerge(step.Branch)
+		err = args.Runner.Frontend.SquashMerge(step.Branch)
 		if err != nil {
 			return err
 		}
-		err = args.Runner.Backend.CommentOutSquashCommitMessage(step.ProposalMessage + "\n\n")
+		err = args.Runner.Backend.CommentOutSquashCommitMessage(step.ProposalMessage+"\n\n", trailers)
 		if err != nil {
 			return fmt.Errorf(messages.SquashMessageProblem, err)
 		}
-		err = args.Runner.Frontend.StartCommit()
+		err = args.Runner.Frontend.CommitSquashMerge("")
 		if err != nil {
 			return err
 		}
@@ -66,6 +87,8 @@ func (step *ConnectorMergeProposalStep) Run(args RunArgs) error {
 			return err
 		}
 		step.enteredEmptyCommitMessage = false
+	} else {
+		commitMessage = withTrailers(commitMessage, trailers)
 	}
 	step.mergeSHA, step.mergeError = args.Connector.SquashMergeProposal(step.ProposalNumber, commitMessage)
 	return step.mergeError
@@ -0,0 +1,39 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// ContinueRebaseUpdateRefsStep finishes an ongoing "git rebase --update-refs" operation
+// assuming all conflicts have been resolved by the user.
+// It remembers where the moved branches were before the rebase started so that they can be undone.
+type ContinueRebaseUpdateRefsStep struct {
+	MovedBranches domain.LocalBranchNames
+	PreviousSHAs  []domain.SHA
+	EmptyStep
+}
+
+func (step *ContinueRebaseUpdateRefsStep) CreateAbortSteps() []Step {
+	result := []Step{&AbortRebaseStep{}}
+	return append(result, resetLocalBranchesSteps(step.MovedBranches, step.PreviousSHAs)...)
+}
+
+func (step *ContinueRebaseUpdateRefsStep) CreateContinueSteps() []Step {
+	return []Step{step}
+}
+
+func (step *ContinueRebaseUpdateRefsStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return resetLocalBranchesSteps(step.MovedBranches, step.PreviousSHAs), nil
+}
+
+func (step *ContinueRebaseUpdateRefsStep) Run(args RunArgs) error {
+	hasRebaseInProgress, err := args.Runner.Backend.HasRebaseInProgress()
+	if err != nil {
+		return err
+	}
+	if hasRebaseInProgress {
+		return args.Runner.Frontend.ContinueRebase()
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// FetchTrackingBranchStep updates the tracking branch of the given branch
+// with the commits that exist at the origin remote.
+type FetchTrackingBranchStep struct {
+	Branch domain.LocalBranchName
+	EmptyStep
+}
+
+func (step *FetchTrackingBranchStep) Run(args RunArgs) error {
+	return args.Runner.Frontend.FetchTrackingBranch(step.Branch)
+}
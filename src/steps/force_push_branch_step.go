@@ -1,17 +1,43 @@
 package steps
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
 )
 
-// ForcePushBranchStep force-pushes the branch with the given name to the origin remote.
+// ForcePushBranchStep force-pushes the branch with the given name to the origin remote
+// unless somebody else has pushed to it in the meantime.
 type ForcePushBranchStep struct {
 	Branch     domain.LocalBranchName
 	NoPushHook bool
+	// the parent branch that the branch got rebased onto before pushing it
+	Parent domain.LocalBranchName
+	// the SHA that the tracking branch had when Git Town loaded the branches,
+	// when empty, the push leases against the tracking branch as last fetched
+	RemoteSHA     domain.SHA
+	leaseRejected bool `exhaustruct:"optional"`
 	EmptyStep
 }
 
+func (step *ForcePushBranchStep) CreateContinueSteps() []Step {
+	if !step.leaseRejected {
+		// the push failed for another reason, for example a pre-push hook --> try the same push again
+		return []Step{&ForcePushBranchStep{Branch: step.Branch, NoPushHook: step.NoPushHook, Parent: step.Parent, RemoteSHA: step.RemoteSHA}}
+	}
+	// sync the branch again with the new commits at origin before pushing it again
+	result := []Step{
+		&FetchTrackingBranchStep{Branch: step.Branch},
+		&RebaseBranchStep{Branch: step.Branch.RemoteBranch().BranchName()},
+	}
+	if !step.Parent.IsEmpty() {
+		result = append(result, &RebaseBranchStep{Branch: step.Parent.BranchName()})
+	}
+	return append(result, &ForcePushBranchStep{Branch: step.Branch, NoPushHook: step.NoPushHook, Parent: step.Parent, RemoteSHA: domain.SHA{}})
+}
+
 func (step *ForcePushBranchStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
 	return []Step{&SkipCurrentBranchSteps{}}, nil
 }
@@ -24,5 +50,31 @@ func (step *ForcePushBranchStep) Run(args RunArgs) error {
 	if !shouldPush && !args.Runner.Config.DryRun {
 		return nil
 	}
-	return args.Runner.Frontend.ForcePushBranch(step.NoPushHook)
+	leaseSHA := step.RemoteSHA
+	if leaseSHA.IsEmpty() {
+		leaseSHA, err = args.Runner.Backend.SHAForBranch(step.Branch.RemoteBranch().BranchName())
+		if err != nil {
+			return err
+		}
+		err = args.Runner.Frontend.ForcePushBranch(step.NoPushHook)
+	} else {
+		err = args.Runner.Frontend.ForcePushBranchWithLease(step.Branch, step.RemoteSHA, step.NoPushHook)
+	}
+	if err != nil {
+		step.leaseRejected, err = forcePushError(step.Branch, leaseSHA, messages.ForcePushLeaseRejected, err, &args.Runner.Backend)
+		return err
+	}
+	return nil
+}
+
+// forcePushError indicates whether the failed force-push of the given branch
+// that expected the branch at origin to have the given SHA
+// failed because somebody else has pushed to the branch in the meantime,
+// and provides the error for it: the given lease rejection message in this case.
+func forcePushError(branch domain.LocalBranchName, leaseSHA domain.SHA, leaseRejectedMessage string, pushErr error, backend *git.BackendCommands) (bool, error) {
+	hasSHA, err := backend.RemoteBranchHasSHA(branch, leaseSHA)
+	if err == nil && !hasSHA {
+		return true, fmt.Errorf(leaseRejectedMessage, branch)
+	}
+	return false, fmt.Errorf(messages.ForcePushProblem, branch, pushErr)
 }
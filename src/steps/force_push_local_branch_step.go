@@ -0,0 +1,46 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// ForcePushLocalBranchStep force-pushes the branch with the given name to the origin remote
+// while another branch is checked out,
+// unless somebody else has pushed to it in the meantime.
+type ForcePushLocalBranchStep struct {
+	Branch     domain.LocalBranchName
+	NoPushHook bool
+	// the SHA that the tracking branch had when Git Town loaded the branches,
+	// when empty, the push leases against the tracking branch as last fetched
+	RemoteSHA domain.SHA
+	EmptyStep
+}
+
+func (step *ForcePushLocalBranchStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&SkipCurrentBranchSteps{}}, nil
+}
+
+func (step *ForcePushLocalBranchStep) Run(args RunArgs) error {
+	shouldPush, err := args.Runner.Backend.ShouldPushBranch(step.Branch, step.Branch.RemoteBranch())
+	if err != nil {
+		return err
+	}
+	if !shouldPush && !args.Runner.Config.DryRun {
+		return nil
+	}
+	leaseSHA := step.RemoteSHA
+	if leaseSHA.IsEmpty() {
+		leaseSHA, err = args.Runner.Backend.SHAForBranch(step.Branch.RemoteBranch().BranchName())
+		if err != nil {
+			return err
+		}
+	}
+	err = args.Runner.Frontend.ForcePushLocalBranch(step.Branch, step.RemoteSHA, step.NoPushHook)
+	if err != nil {
+		_, err = forcePushError(step.Branch, leaseSHA, messages.ForcePushMovedLeaseRejected, err, &args.Runner.Backend)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// ForcePushMovedBranchStep force-pushes the branch with the given name
+// after Git Town has moved its commits,
+// unless the tracking branch no longer has the given SHA that it had before Git Town moved the branch.
+// Unlike ForcePushBranchStep, undoing this step resets the tracking branch to that SHA.
+type ForcePushMovedBranchStep struct {
+	Branch     domain.LocalBranchName
+	NoPushHook bool
+	RemoteSHA  domain.SHA
+	EmptyStep
+}
+
+func (step *ForcePushMovedBranchStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&ResetRemoteBranchToSHAStep{Branch: step.Branch, SHA: step.RemoteSHA}}, nil
+}
+
+func (step *ForcePushMovedBranchStep) Run(args RunArgs) error {
+	err := args.Runner.Frontend.ForcePushBranchWithLease(step.Branch, step.RemoteSHA, step.NoPushHook)
+	if err != nil {
+		_, err = forcePushError(step.Branch, step.RemoteSHA, messages.ForcePushMovedLeaseRejected, err, &args.Runner.Backend)
+		return err
+	}
+	return nil
+}
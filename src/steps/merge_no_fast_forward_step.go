@@ -0,0 +1,62 @@
+package steps
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// MergeNoFastForwardStep merges the branch with the given name into the current branch
+// using a merge commit, even if Git could fast-forward the current branch.
+type MergeNoFastForwardStep struct {
+	Branch        domain.LocalBranchName
+	CommitMessage string
+	Parent        domain.LocalBranchName
+	EmptyStep
+}
+
+func (step *MergeNoFastForwardStep) CreateAbortSteps() []Step {
+	return []Step{&AbortMergeStep{}}
+}
+
+func (step *MergeNoFastForwardStep) CreateUndoSteps(backend *git.BackendCommands) ([]Step, error) {
+	currentSHA, err := backend.CurrentSHA()
+	if err != nil {
+		return []Step{}, err
+	}
+	return []Step{&RevertMergeCommitStep{SHA: currentSHA}}, nil
+}
+
+func (step *MergeNoFastForwardStep) Run(args RunArgs) error {
+	commitMessage := step.CommitMessage
+	var err error
+	if commitMessage == "" {
+		commitMessage, err = templatedShipMessage(args, step.Branch, step.Parent, 0, "")
+		if err != nil {
+			return err
+		}
+	}
+	// merge commits keep the authors of the merged commits, so they need no "Co-authored-by" trailers
+	trailers, err := shipTrailers(args, step.Branch, 0, []string{}, "")
+	if err != nil {
+		return err
+	}
+	if commitMessage != "" {
+		return args.Runner.Frontend.MergeBranchNoFastForward(step.Branch, withTrailers(commitMessage, trailers))
+	}
+	if trailers == "" {
+		return args.Runner.Frontend.MergeBranchNoFastForward(step.Branch, "")
+	}
+	// add the trailers to the default merge message
+	err = args.Runner.Frontend.MergeBranchNoFastForwardNoCommit(step.Branch)
+	if err != nil {
+		return err
+	}
+	err = args.Runner.Backend.AppendToMergeMessage(trailers)
+	if err != nil {
+		return fmt.Errorf(messages.MergeMessageProblem, err)
+	}
+	return args.Runner.Frontend.CommitNoEdit()
+}
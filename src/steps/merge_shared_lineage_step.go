@@ -0,0 +1,57 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/cli"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/messages"
+)
+
+// MergeSharedLineageStep stores the parent branches that the lineage
+// shared through the origin repository provides in the local lineage.
+type MergeSharedLineageStep struct {
+	previousLineage config.Lineage          `exhaustruct:"optional"`
+	previousSHA     domain.SHA              `exhaustruct:"optional"`
+	updatedBranches domain.LocalBranchNames `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *MergeSharedLineageStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	result := []Step{}
+	for _, branch := range step.updatedBranches {
+		previousParent := step.previousLineage[branch]
+		if previousParent.IsEmpty() {
+			result = append(result, &DeleteParentBranchStep{Branch: branch, Parent: previousParent})
+		} else {
+			result = append(result, &SetParentStep{Branch: branch, ParentBranch: previousParent})
+		}
+	}
+	result = append(result, &SetMergedSharedLineageStep{SHA: step.previousSHA})
+	return result, nil
+}
+
+func (step *MergeSharedLineageStep) Run(args RunArgs) error {
+	// the configuration in memory already contains the shared parent branches, see execute.LoadBranches
+	args.Runner.Config.Reload()
+	updates, conflicts, sharedSHA, err := args.Runner.Backend.SharedLineageUpdates()
+	if err != nil || sharedSHA.IsEmpty() {
+		return err
+	}
+	step.previousLineage = args.Runner.Config.Lineage()
+	step.updatedBranches = updates.BranchNames()
+	for _, branch := range step.updatedBranches {
+		err = args.Runner.Config.SetParent(branch, updates[branch])
+		if err != nil {
+			return err
+		}
+	}
+	for _, conflict := range conflicts {
+		cli.Printf(messages.SharedLineageConflict, conflict.Branch, conflict.LocalParent, conflict.RemoteParent, conflict.LocalParent)
+	}
+	_, step.previousSHA, err = args.Runner.Backend.SharedLineage(config.SharedLineageRef)
+	if err != nil {
+		return err
+	}
+	return args.Runner.Backend.SetSharedLineageRef(config.SharedLineageRef, sharedSHA)
+}
@@ -0,0 +1,76 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// PushSharedLineageStep shares the lineage of the branches that exist at origin
+// with the origin repository.
+type PushSharedLineageStep struct {
+	previousSHA domain.SHA `exhaustruct:"optional"`
+	pushedSHA   domain.SHA `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *PushSharedLineageStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	if step.pushedSHA.IsEmpty() {
+		return []Step{}, nil
+	}
+	return []Step{&ResetSharedLineageStep{SHA: step.previousSHA, PushedSHA: step.pushedSHA}}, nil
+}
+
+func (step *PushSharedLineageStep) Run(args RunArgs) error {
+	remotes, err := args.Runner.Backend.Remotes()
+	if err != nil || !remotes.HasOrigin() {
+		return err
+	}
+	shared, sharedSHA, err := args.Runner.Backend.SharedLineage(config.SharedLineageTrackingRef)
+	if err != nil {
+		return err
+	}
+	branches, _, err := args.Runner.Backend.BranchInfos()
+	if err != nil {
+		return err
+	}
+	originBranches := domain.LocalBranchNames{}
+	for _, branch := range branches {
+		if !branch.HasTrackingBranch() {
+			continue
+		}
+		remote, localName := branch.RemoteName.Parts()
+		if remote == domain.OriginRemote {
+			originBranches = append(originBranches, localName)
+		}
+	}
+	lineage := config.NewSharedLineage(args.Runner.Config.Lineage(), shared, originBranches)
+	if config.SharedLineageContent(lineage) == config.SharedLineageContent(shared) {
+		return nil
+	}
+	sha, err := args.Runner.Backend.StoreSharedLineage(lineage)
+	if err != nil {
+		return err
+	}
+	_, mergedSHA, err := args.Runner.Backend.SharedLineage(config.SharedLineageRef)
+	if err != nil {
+		return err
+	}
+	err = args.Runner.Backend.SetSharedLineageRef(config.SharedLineageRef, sha)
+	if err != nil {
+		return err
+	}
+	expected := ""
+	if !sharedSHA.IsEmpty() {
+		expected = config.SharedLineageTrackingRef
+	}
+	err = args.Runner.Frontend.PushSharedLineage(expected)
+	if err != nil {
+		// keep the last merged shared lineage so that the next Git Town command merges the changes at origin correctly
+		_ = args.Runner.Backend.SetSharedLineageRef(config.SharedLineageRef, mergedSHA)
+		return err
+	}
+	step.previousSHA = sharedSHA
+	step.pushedSHA = sha
+	return args.Runner.Backend.SetSharedLineageRef(config.SharedLineageTrackingRef, sha)
+}
@@ -0,0 +1,36 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// RebaseOntoStep moves the commits of the current branch
+// that come after the given upstream commit onto the given branch.
+type RebaseOntoStep struct {
+	Onto        domain.LocalBranchName
+	Upstream    domain.SHA
+	previousSHA domain.SHA `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *RebaseOntoStep) CreateAbortSteps() []Step {
+	return []Step{&AbortRebaseStep{}}
+}
+
+func (step *RebaseOntoStep) CreateContinueSteps() []Step {
+	return []Step{&ContinueRebaseStep{}}
+}
+
+func (step *RebaseOntoStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&ResetCurrentBranchToSHAStep{Hard: true, SHA: step.previousSHA}}, nil
+}
+
+func (step *RebaseOntoStep) Run(args RunArgs) error {
+	var err error
+	step.previousSHA, err = args.Runner.Backend.CurrentSHA()
+	if err != nil {
+		return err
+	}
+	return args.Runner.Frontend.RebaseOnto(step.Onto, step.Upstream)
+}
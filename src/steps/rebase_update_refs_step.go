@@ -0,0 +1,55 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// RebaseUpdateRefsStep rebases the current branch against the branch with the given name
+// and lets Git move the given branches, whose commits are part of the current branch, along with it.
+type RebaseUpdateRefsStep struct {
+	Branch        domain.BranchName
+	MovedBranches domain.LocalBranchNames
+	previousSHA   domain.SHA   `exhaustruct:"optional"`
+	previousSHAs  []domain.SHA `exhaustruct:"optional"` // the SHAs of the MovedBranches before the rebase
+	EmptyStep
+}
+
+func (step *RebaseUpdateRefsStep) CreateAbortSteps() []Step {
+	result := []Step{&AbortRebaseStep{}}
+	return append(result, resetLocalBranchesSteps(step.MovedBranches, step.previousSHAs)...)
+}
+
+func (step *RebaseUpdateRefsStep) CreateContinueSteps() []Step {
+	return []Step{&ContinueRebaseUpdateRefsStep{MovedBranches: step.MovedBranches, PreviousSHAs: step.previousSHAs}}
+}
+
+func (step *RebaseUpdateRefsStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	result := []Step{&ResetCurrentBranchToSHAStep{Hard: true, SHA: step.previousSHA}}
+	return append(result, resetLocalBranchesSteps(step.MovedBranches, step.previousSHAs)...), nil
+}
+
+func (step *RebaseUpdateRefsStep) Run(args RunArgs) error {
+	var err error
+	step.previousSHA, err = args.Runner.Backend.CurrentSHA()
+	if err != nil {
+		return err
+	}
+	step.previousSHAs = make([]domain.SHA, len(step.MovedBranches))
+	for b, branch := range step.MovedBranches {
+		step.previousSHAs[b], err = args.Runner.Backend.SHAForBranch(branch.BranchName())
+		if err != nil {
+			return err
+		}
+	}
+	return args.Runner.Frontend.RebaseUpdateRefs(step.Branch)
+}
+
+// resetLocalBranchesSteps provides the steps to move the given branches back to the given SHAs.
+func resetLocalBranchesSteps(branches domain.LocalBranchNames, shas []domain.SHA) []Step {
+	result := []Step{}
+	for b, sha := range shas {
+		result = append(result, &ResetLocalBranchToSHAStep{Branch: branches[b], SHA: sha})
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// RecordParentSHAStep registers the current commit of the given parent branch
+// as the commit that the given branch is based on.
+type RecordParentSHAStep struct {
+	Branch      domain.LocalBranchName
+	Parent      domain.LocalBranchName
+	previousSHA domain.SHA `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *RecordParentSHAStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&SetParentSHAStep{Branch: step.Branch, SHA: step.previousSHA}}, nil
+}
+
+func (step *RecordParentSHAStep) Run(args RunArgs) error {
+	step.previousSHA = args.Runner.Config.ParentSHA(step.Branch)
+	parentSHA, err := args.Runner.Backend.SHAForBranch(step.Parent.BranchName())
+	if err != nil {
+		return err
+	}
+	return args.Runner.Config.SetParentSHA(step.Branch, parentSHA)
+}
@@ -0,0 +1,8 @@
+package steps
+
+// ReleaseSavepoint is a mock step that ends the part of a Git Town command
+// that started with the last SetSavepoint step.
+// Skipping a rolled back command resumes it after this step.
+type ReleaseSavepoint struct {
+	EmptyStep
+}
@@ -0,0 +1,30 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// RemoveConfigValueStep removes the given entry from the local or global Git configuration.
+type RemoveConfigValueStep struct {
+	Global        bool
+	Key           config.Key
+	previousValue string `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *RemoveConfigValueStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	if step.previousValue == "" {
+		return []Step{}, nil
+	}
+	return []Step{&SetConfigValueStep{Key: step.Key, Global: step.Global, Value: step.previousValue}}, nil
+}
+
+func (step *RemoveConfigValueStep) Run(args RunArgs) error {
+	if step.Global {
+		step.previousValue = args.Runner.Config.GlobalConfigValue(step.Key)
+		return args.Runner.Config.RemoveGlobalConfigValue(step.Key)
+	}
+	step.previousValue = args.Runner.Config.LocalConfigValue(step.Key)
+	return args.Runner.Config.RemoveLocalConfigValue(step.Key)
+}
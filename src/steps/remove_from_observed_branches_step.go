@@ -0,0 +1,20 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// RemoveFromObservedBranchesStep removes the branch with the given name as an observed branch.
+type RemoveFromObservedBranchesStep struct {
+	Branch domain.LocalBranchName
+	EmptyStep
+}
+
+func (step *RemoveFromObservedBranchesStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&AddToObservedBranchesStep{Branch: step.Branch}}, nil
+}
+
+func (step *RemoveFromObservedBranchesStep) Run(args RunArgs) error {
+	return args.Runner.Config.RemoveFromObservedBranches(step.Branch)
+}
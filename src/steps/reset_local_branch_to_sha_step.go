@@ -0,0 +1,23 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// ResetLocalBranchToSHAStep sets the given local branch, which is not checked out, to the given SHA.
+type ResetLocalBranchToSHAStep struct {
+	Branch domain.LocalBranchName
+	SHA    domain.SHA
+	EmptyStep
+}
+
+func (step *ResetLocalBranchToSHAStep) Run(args RunArgs) error {
+	currentSHA, err := args.Runner.Backend.SHAForBranch(step.Branch.BranchName())
+	if err != nil {
+		return err
+	}
+	if step.SHA == currentSHA {
+		return nil
+	}
+	return args.Runner.Frontend.ResetLocalBranchToSHA(step.Branch, step.SHA)
+}
@@ -0,0 +1,16 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// ResetRemoteBranchToSHAStep sets the given branch at the origin remote to the given SHA.
+type ResetRemoteBranchToSHAStep struct {
+	Branch domain.LocalBranchName
+	SHA    domain.SHA
+	EmptyStep
+}
+
+func (step *ResetRemoteBranchToSHAStep) Run(args RunArgs) error {
+	return args.Runner.Frontend.ResetRemoteBranchToSHA(step.Branch, step.SHA)
+}
@@ -0,0 +1,31 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// ResetSharedLineageStep restores the shared lineage at origin to the one with the given SHA
+// if origin still has the shared lineage with the given pushed SHA.
+// An empty SHA removes the shared lineage from origin.
+type ResetSharedLineageStep struct {
+	SHA       domain.SHA
+	PushedSHA domain.SHA
+	EmptyStep
+}
+
+func (step *ResetSharedLineageStep) Run(args RunArgs) error {
+	err := args.Runner.Backend.SetSharedLineageRef(config.SharedLineageRef, step.SHA)
+	if err != nil {
+		return err
+	}
+	if step.SHA.IsEmpty() {
+		err = args.Runner.Frontend.RemoveSharedLineage(step.PushedSHA)
+	} else {
+		err = args.Runner.Frontend.PushSharedLineage(step.PushedSHA.String())
+	}
+	if err != nil {
+		return err
+	}
+	return args.Runner.Backend.SetSharedLineageRef(config.SharedLineageTrackingRef, step.SHA)
+}
@@ -0,0 +1,16 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// RevertMergeCommitStep adds a commit to the current branch
+// that reverts the merge commit with the given SHA.
+type RevertMergeCommitStep struct {
+	SHA domain.SHA
+	EmptyStep
+}
+
+func (step *RevertMergeCommitStep) Run(args RunArgs) error {
+	return args.Runner.Frontend.RevertMergeCommit(step.SHA)
+}
@@ -0,0 +1,31 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// SetConfigValueStep sets the given entry in the local or global Git configuration.
+type SetConfigValueStep struct {
+	Global        bool
+	Key           config.Key
+	Value         string
+	previousValue string `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *SetConfigValueStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	if step.previousValue == "" {
+		return []Step{&RemoveConfigValueStep{Key: step.Key, Global: step.Global}}, nil
+	}
+	return []Step{&SetConfigValueStep{Key: step.Key, Global: step.Global, Value: step.previousValue}}, nil
+}
+
+func (step *SetConfigValueStep) Run(args RunArgs) error {
+	if step.Global {
+		step.previousValue = args.Runner.Config.GlobalConfigValue(step.Key)
+		return args.Runner.Config.SetGlobalConfigValue(step.Key, step.Value)
+	}
+	step.previousValue = args.Runner.Config.LocalConfigValue(step.Key)
+	return args.Runner.Config.SetLocalConfigValue(step.Key, step.Value)
+}
@@ -0,0 +1,17 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// SetMergedSharedLineageStep records the shared lineage with the given SHA
+// as the one that Git Town merged into the local lineage last.
+type SetMergedSharedLineageStep struct {
+	SHA domain.SHA
+	EmptyStep
+}
+
+func (step *SetMergedSharedLineageStep) Run(args RunArgs) error {
+	return args.Runner.Backend.SetSharedLineageRef(config.SharedLineageRef, step.SHA)
+}
@@ -0,0 +1,28 @@
+package steps
+
+import (
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+)
+
+// SetParentSHAStep registers the given commit of the parent branch
+// as the commit that the given branch is based on.
+// An empty SHA removes this registration.
+type SetParentSHAStep struct {
+	Branch      domain.LocalBranchName
+	SHA         domain.SHA
+	previousSHA domain.SHA `exhaustruct:"optional"`
+	EmptyStep
+}
+
+func (step *SetParentSHAStep) CreateUndoSteps(_ *git.BackendCommands) ([]Step, error) {
+	return []Step{&SetParentSHAStep{Branch: step.Branch, SHA: step.previousSHA}}, nil
+}
+
+func (step *SetParentSHAStep) Run(args RunArgs) error {
+	step.previousSHA = args.Runner.Config.ParentSHA(step.Branch)
+	if step.SHA.IsEmpty() {
+		return args.Runner.Config.RemoveParentSHA(step.Branch)
+	}
+	return args.Runner.Config.SetParentSHA(step.Branch, step.SHA)
+}
@@ -0,0 +1,9 @@
+package steps
+
+// SetSavepoint is a mock step that marks the point of a Git Town command
+// that the command rolls back to when a step after it fails
+// that would otherwise abort the entire command.
+// Continuing the command resumes it at this point.
+type SetSavepoint struct {
+	EmptyStep
+}
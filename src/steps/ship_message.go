@@ -0,0 +1,65 @@
+package steps
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v9/src/changelog"
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+)
+
+// shipTrailers provides the trailers for the commit that ships the given branch with the given proposal number:
+// the trailers that record the shipped branch for "git town changelog" if the "ship-changelog-trailers" setting allows it
+// and "Co-authored-by" trailers for all given branch authors except the given commit author.
+func shipTrailers(args RunArgs, branch domain.LocalBranchName, proposalNumber int, branchAuthors []string, commitAuthor string) (string, error) {
+	trailers := []string{}
+	changelogTrailers, err := args.Runner.Config.ShouldShipChangelogTrailers()
+	if err != nil {
+		return "", err
+	}
+	if changelogTrailers {
+		trailers = changelog.ShipTrailers(branch, proposalNumber)
+	}
+	for _, branchAuthor := range branchAuthors {
+		if branchAuthor != commitAuthor {
+			trailers = append(trailers, "Co-authored-by: "+branchAuthor)
+		}
+	}
+	return strings.Join(trailers, "\n"), nil
+}
+
+// withTrailers provides the given commit message with the given trailers appended.
+// Trailers that the message already contains don't get added again.
+func withTrailers(message, trailers string) string {
+	missing := []string{}
+	for _, trailer := range strings.Split(trailers, "\n") {
+		if trailer != "" && !strings.Contains(message, trailer) {
+			missing = append(missing, trailer)
+		}
+	}
+	if len(missing) == 0 {
+		return message
+	}
+	return strings.TrimRight(message, "\n") + "\n\n" + strings.Join(missing, "\n")
+}
+
+// templatedShipMessage provides the commit message that the "ship-message-template" setting defines
+// for shipping the given branch into the given parent branch,
+// or an empty string if this setting doesn't exist.
+func templatedShipMessage(args RunArgs, branch, parent domain.LocalBranchName, proposalNumber int, proposalTitle string) (string, error) {
+	template := args.Runner.Config.ShipMessageTemplate()
+	if template == "" {
+		return "", nil
+	}
+	commits, err := args.Runner.Backend.CommitMessagesInBranch(branch, parent)
+	if err != nil {
+		return "", err
+	}
+	return config.RenderShipMessage(template, config.ShipMessageData{
+		Branch:         branch.String(),
+		Commits:        commits,
+		Parent:         parent.String(),
+		ProposalNumber: proposalNumber,
+		ProposalTitle:  proposalTitle,
+	})
+}
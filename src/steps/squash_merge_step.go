@@ -50,13 +50,27 @@ func (step *SquashMergeStep) Run(args RunArgs) error {
 	if err != nil {
 		return fmt.Errorf(messages.GitUserProblem, err)
 	}
-	if err = args.Runner.Backend.CommentOutSquashCommitMessage(""); err != nil {
-		return fmt.Errorf(messages.SquashMessageProblem, err)
+	commitMessage := step.CommitMessage
+	if commitMessage == "" {
+		commitMessage, err = templatedShipMessage(args, step.Branch, step.Parent, 0, "")
+		if err != nil {
+			return err
+		}
+	}
+	trailers, err := shipTrailers(args, step.Branch, 0, branchAuthors, author)
+	if err != nil {
+		return err
 	}
 	if repoAuthor == author {
 		author = ""
 	}
-	return args.Runner.Frontend.Commit(step.CommitMessage, author)
+	if commitMessage != "" {
+		return args.Runner.Frontend.Commit(withTrailers(commitMessage, trailers), author)
+	}
+	if err = args.Runner.Backend.CommentOutSquashCommitMessage("", trailers); err != nil {
+		return fmt.Errorf(messages.SquashMessageProblem, err)
+	}
+	return args.Runner.Frontend.CommitSquashMerge(author)
 }
 
 func (step *SquashMergeStep) ShouldAutomaticallyAbortOnError() bool {
@@ -5,10 +5,13 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/acarl005/stripansi"
 	"github.com/fatih/color"
+	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/statistics"
 )
 
 // BackendRunner executes backend shell commands without output to the CLI.
@@ -37,7 +40,6 @@ func (r BackendRunner) Run(executable string, args ...string) error {
 }
 
 func (r BackendRunner) execute(executable string, args ...string) ([]byte, error) {
-	r.Stats.RegisterRun()
 	if r.Verbose {
 		printHeader(executable, args...)
 	}
@@ -45,7 +47,11 @@ func (r BackendRunner) execute(executable string, args ...string) ([]byte, error
 	if r.Dir != nil {
 		subProcess.Dir = *r.Dir
 	}
+	start := time.Now()
 	outputBytes, err := subProcess.CombinedOutput()
+	run := statistics.NewCommandRun(FormatCommand(domain.LocalBranchName{}, true, executable, args...), start, err, false)
+	run.Output = string(outputBytes)
+	r.Stats.RegisterRun(run)
 	if err != nil {
 		err = ErrorDetails(executable, args, err, outputBytes)
 	}
@@ -1,6 +1,8 @@
 // Package subshell provides facilities to execute CLI commands in subshells.
 package subshell
 
+import "github.com/git-town/git-town/v9/src/statistics"
+
 type Statistics interface {
-	RegisterRun()
+	RegisterRun(statistics.CommandRun)
 }
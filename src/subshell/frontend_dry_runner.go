@@ -24,6 +24,11 @@ func (r *FrontendDryRunner) Run(executable string, args ...string) error {
 	return nil
 }
 
+// RunInteractive runs the given interactive command in this ShellRunner's directory.
+func (r *FrontendDryRunner) RunInteractive(executable string, args ...string) error {
+	return r.Run(executable, args...)
+}
+
 // RunMany runs all given commands in current directory.
 // Commands are provided as a list of argv-style strings.
 // Failed commands abort immediately with the encountered error.
@@ -1,19 +1,24 @@
 package subshell
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/messages"
+	"github.com/git-town/git-town/v9/src/statistics"
 )
 
 // FrontendRunner executes frontend shell commands.
 type FrontendRunner struct {
+	CaptureOutput    bool // whether to record the output of the commands in addition to printing it
 	GetCurrentBranch GetCurrentBranchFunc
 	OmitBranchNames  bool
 	Stats            Statistics
@@ -22,8 +27,17 @@ type FrontendRunner struct {
 type GetCurrentBranchFunc func() (domain.LocalBranchName, error)
 
 // Run runs the given command in this ShellRunner's directory.
-func (r *FrontendRunner) Run(cmd string, args ...string) (err error) {
-	r.Stats.RegisterRun()
+func (r *FrontendRunner) Run(cmd string, args ...string) error {
+	return r.execute(r.CaptureOutput, cmd, args...)
+}
+
+// RunInteractive runs the given command, which interacts with the user through the terminal, for example by opening the editor.
+// It never captures the output of such commands because that would disconnect them from the terminal.
+func (r *FrontendRunner) RunInteractive(cmd string, args ...string) error {
+	return r.execute(false, cmd, args...)
+}
+
+func (r *FrontendRunner) execute(captureOutput bool, cmd string, args ...string) (err error) {
 	var branchName domain.LocalBranchName
 	if !r.OmitBranchNames {
 		branchName, err = r.GetCurrentBranch()
@@ -32,6 +46,7 @@ func (r *FrontendRunner) Run(cmd string, args ...string) (err error) {
 		}
 	}
 	PrintCommand(branchName, r.OmitBranchNames, cmd, args...)
+	command := FormatCommand(domain.LocalBranchName{}, true, cmd, args...)
 	// Windows commands run inside CMD
 	// because opening browsers is done via "start"
 	// TODO: do this only when actually running the "start" command
@@ -40,10 +55,22 @@ func (r *FrontendRunner) Run(cmd string, args ...string) (err error) {
 		cmd = "cmd"
 	}
 	subProcess := exec.Command(cmd, args...) // #nosec
+	var output bytes.Buffer
 	subProcess.Stderr = os.Stderr
 	subProcess.Stdin = os.Stdin
 	subProcess.Stdout = os.Stdout
-	return subProcess.Run()
+	if captureOutput {
+		// capturing the output disconnects the subprocess from the terminal,
+		// so we do this only when the output actually gets recorded
+		subProcess.Stderr = io.MultiWriter(os.Stderr, &output)
+		subProcess.Stdout = io.MultiWriter(os.Stdout, &output)
+	}
+	start := time.Now()
+	err = subProcess.Run()
+	run := statistics.NewCommandRun(command, start, err, true)
+	run.Output = output.String()
+	r.Stats.RegisterRun(run)
+	return err
 }
 
 // RunMany runs all given commands in current directory.
@@ -77,8 +104,8 @@ func FormatCommand(currentBranch domain.LocalBranchName, omitBranch bool, execut
 		result = executable + " "
 	}
 	for index, part := range args {
-		if strings.Contains(part, " ") {
-			part = `"` + part + `"`
+		if strings.ContainsAny(part, " \n") {
+			part = `"` + strings.ReplaceAll(part, "\n", `\n`) + `"`
 		}
 		if index != 0 {
 			result += " "
@@ -25,3 +25,22 @@ func TestIsAcceptableGitVersion(t *testing.T) {
 		assert.Equal(t, test.want, have, fmt.Sprintf("%d.%d --> %t", test.major, test.minor, test.want))
 	}
 }
+
+func TestSupportsRebaseUpdateRefs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		major int
+		minor int
+		want  bool
+	}{
+		{2, 38, true},
+		{2, 42, true},
+		{3, 0, true},
+		{2, 37, false},
+		{1, 40, false},
+	}
+	for _, test := range tests {
+		have := validate.SupportsRebaseUpdateRefs(test.major, test.minor)
+		assert.Equal(t, test.want, have, fmt.Sprintf("%d.%d --> %t", test.major, test.minor, test.want))
+	}
+}
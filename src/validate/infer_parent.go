@@ -0,0 +1,160 @@
+package validate
+
+import (
+	"sort"
+
+	"github.com/git-town/git-town/v9/src/config"
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/hosting"
+)
+
+// ParentConfidence describes how certain Git Town is about an inferred parent branch.
+type ParentConfidence struct {
+	name string
+}
+
+func (c ParentConfidence) String() string { return c.name }
+
+var (
+	// the nearest branch is unambiguous or a proposal confirms it
+	ParentConfidenceHigh = ParentConfidence{"high"} //nolint:gochecknoglobals
+	// several branches are equally near
+	ParentConfidenceMedium = ParentConfidence{"medium"} //nolint:gochecknoglobals
+	// there is no suitable branch or the commit history and the proposals disagree
+	ParentConfidenceLow = ParentConfidence{"low"} //nolint:gochecknoglobals
+)
+
+// ParentCandidate describes a branch that could be the parent of another branch.
+type ParentCandidate struct {
+	// how many commits the branch has that the candidate doesn't have
+	Ahead int
+	// how many commits the candidate has that the branch doesn't have
+	Behind int
+	// whether the candidate is the main branch or a perennial branch
+	IsPerennial bool
+	Name        domain.LocalBranchName
+}
+
+// ParentInference describes the parent branch that Git Town inferred for a branch.
+type ParentInference struct {
+	Candidate  ParentCandidate
+	Confidence ParentConfidence
+	// the branch nearest to the branch in the commit history
+	Nearest domain.LocalBranchName
+	// the proposal that confirms or contradicts the inferred parent, if any
+	Proposal *hosting.Proposal
+	// the other branches that are as near as the nearest branch
+	Tied domain.LocalBranchNames
+}
+
+// IsContained indicates whether the branch contains all commits of this candidate.
+func (c ParentCandidate) IsContained() bool {
+	return c.Behind == 0
+}
+
+// SortParentCandidates orders the given candidates so that the most likely parent comes first.
+// The most likely parent is the branch that the fewest commits separate from the branch.
+// Ties prefer branches whose tip is part of the branch, then the main and perennial branches.
+func SortParentCandidates(candidates []ParentCandidate) {
+	sort.SliceStable(candidates, func(a, b int) bool {
+		first, second := candidates[a], candidates[b]
+		if first.Ahead != second.Ahead {
+			return first.Ahead < second.Ahead
+		}
+		if first.IsContained() != second.IsContained() {
+			return first.IsContained()
+		}
+		if first.IsPerennial != second.IsPerennial {
+			return first.IsPerennial
+		}
+		if first.Behind != second.Behind {
+			return first.Behind < second.Behind
+		}
+		return first.Name.String() < second.Name.String()
+	})
+}
+
+// ChooseParent determines the parent branch from the given sorted candidates
+// and the given proposal of the branch, which can be nil.
+func ChooseParent(candidates []ParentCandidate, proposal *hosting.Proposal, mainBranch domain.LocalBranchName) ParentInference {
+	if len(candidates) == 0 {
+		return ParentInference{
+			Candidate:  ParentCandidate{Ahead: 0, Behind: 0, IsPerennial: true, Name: mainBranch},
+			Confidence: ParentConfidenceLow,
+			Nearest:    mainBranch,
+			Proposal:   nil,
+			Tied:       domain.LocalBranchNames{},
+		}
+	}
+	nearest := candidates[0]
+	tied := domain.LocalBranchNames{}
+	for _, candidate := range candidates[1:] {
+		if candidate.Ahead == nearest.Ahead && candidate.IsContained() == nearest.IsContained() {
+			tied = append(tied, candidate.Name)
+		}
+	}
+	if proposal != nil {
+		for _, candidate := range candidates {
+			if candidate.Name != proposal.Target {
+				continue
+			}
+			confidence := ParentConfidenceHigh
+			if candidate.Name != nearest.Name {
+				// the author of the proposal knows best, but the commit history disagrees
+				confidence = ParentConfidenceLow
+			}
+			return ParentInference{Candidate: candidate, Confidence: confidence, Nearest: nearest.Name, Proposal: proposal, Tied: tied}
+		}
+	}
+	confidence := ParentConfidenceHigh
+	if len(tied) > 0 {
+		confidence = ParentConfidenceMedium
+	}
+	return ParentInference{Candidate: nearest, Confidence: confidence, Nearest: nearest.Name, Proposal: nil, Tied: tied}
+}
+
+// InferParent infers the parent branch of the given branch from the commit history
+// and the proposals at the code hosting platform.
+func InferParent(branch domain.LocalBranchName, args InferParentArgs) (ParentInference, error) {
+	candidates := []ParentCandidate{}
+	for _, candidate := range args.AllBranches.LocalBranches().Names() {
+		if candidate == branch || args.Lineage.IsAncestor(branch, candidate) {
+			continue
+		}
+		ahead, behind, err := args.Backend.CommitsAheadAndBehind(branch, candidate)
+		if err != nil {
+			return ParentInference{}, err
+		}
+		if ahead == 0 && behind > 0 {
+			// the candidate contains all commits of the branch, so it is a descendant of the branch
+			continue
+		}
+		candidates = append(candidates, ParentCandidate{
+			Ahead:       ahead,
+			Behind:      behind,
+			IsPerennial: !args.BranchTypes.IsFeatureBranch(candidate),
+			Name:        candidate,
+		})
+	}
+	SortParentCandidates(candidates)
+	var proposal *hosting.Proposal
+	branchInfo := args.AllBranches.FindLocalBranch(branch)
+	if args.Connector != nil && branchInfo != nil && branchInfo.HasTrackingBranch() {
+		var err error
+		proposal, err = args.Connector.FindProposal(branch, domain.LocalBranchName{})
+		if err != nil {
+			return ParentInference{}, err
+		}
+	}
+	return ChooseParent(candidates, proposal, args.BranchTypes.MainBranch), nil
+}
+
+type InferParentArgs struct {
+	AllBranches domain.BranchInfos
+	Backend     *git.BackendCommands
+	BranchTypes domain.BranchTypes
+	// optional
+	Connector hosting.Connector
+	Lineage   config.Lineage
+}
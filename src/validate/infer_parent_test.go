@@ -0,0 +1,121 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v9/src/domain"
+	"github.com/git-town/git-town/v9/src/hosting"
+	"github.com/git-town/git-town/v9/src/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferParent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SortParentCandidates", func(t *testing.T) {
+		t.Parallel()
+		t.Run("nearest candidates first", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 3, Behind: 0, IsPerennial: true, Name: domain.NewLocalBranchName("main")},
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: domain.NewLocalBranchName("parent")},
+			}
+			validate.SortParentCandidates(candidates)
+			assert.Equal(t, domain.NewLocalBranchName("parent"), candidates[0].Name)
+		})
+		t.Run("equally near candidates: contained branches first", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 2, IsPerennial: true, Name: domain.NewLocalBranchName("main")},
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: domain.NewLocalBranchName("feature")},
+			}
+			validate.SortParentCandidates(candidates)
+			assert.Equal(t, domain.NewLocalBranchName("feature"), candidates[0].Name)
+		})
+		t.Run("equally near contained candidates: perennial branches first", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: domain.NewLocalBranchName("feature")},
+				{Ahead: 1, Behind: 0, IsPerennial: true, Name: domain.NewLocalBranchName("main")},
+			}
+			validate.SortParentCandidates(candidates)
+			assert.Equal(t, domain.NewLocalBranchName("main"), candidates[0].Name)
+		})
+		t.Run("equally near feature branches: fewer missing commits first", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 3, IsPerennial: false, Name: domain.NewLocalBranchName("alpha")},
+				{Ahead: 1, Behind: 2, IsPerennial: false, Name: domain.NewLocalBranchName("beta")},
+			}
+			validate.SortParentCandidates(candidates)
+			assert.Equal(t, domain.NewLocalBranchName("beta"), candidates[0].Name)
+		})
+	})
+
+	t.Run("ChooseParent", func(t *testing.T) {
+		t.Parallel()
+		main := domain.NewLocalBranchName("main")
+		parent := domain.NewLocalBranchName("parent")
+		other := domain.NewLocalBranchName("other")
+		t.Run("unique nearest branch", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: parent},
+				{Ahead: 2, Behind: 0, IsPerennial: true, Name: main},
+			}
+			have := validate.ChooseParent(candidates, nil, main)
+			assert.Equal(t, parent, have.Candidate.Name)
+			assert.Equal(t, validate.ParentConfidenceHigh, have.Confidence)
+		})
+		t.Run("equally near branches", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: parent},
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: other},
+			}
+			have := validate.ChooseParent(candidates, nil, main)
+			assert.Equal(t, parent, have.Candidate.Name)
+			assert.Equal(t, validate.ParentConfidenceMedium, have.Confidence)
+			assert.Equal(t, domain.LocalBranchNames{other}, have.Tied)
+		})
+		t.Run("equally near branches that the branch doesn't contain", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: true, Name: main},
+				{Ahead: 1, Behind: 2, IsPerennial: false, Name: other},
+			}
+			have := validate.ChooseParent(candidates, nil, main)
+			assert.Equal(t, main, have.Candidate.Name)
+			assert.Equal(t, validate.ParentConfidenceHigh, have.Confidence)
+		})
+		t.Run("proposal confirms the nearest branch", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: parent},
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: other},
+			}
+			proposal := hosting.Proposal{Number: 1, Target: parent, Title: "title", CanMergeWithAPI: false}
+			have := validate.ChooseParent(candidates, &proposal, main)
+			assert.Equal(t, parent, have.Candidate.Name)
+			assert.Equal(t, validate.ParentConfidenceHigh, have.Confidence)
+		})
+		t.Run("proposal targets another branch", func(t *testing.T) {
+			t.Parallel()
+			candidates := []validate.ParentCandidate{
+				{Ahead: 1, Behind: 0, IsPerennial: false, Name: parent},
+				{Ahead: 3, Behind: 0, IsPerennial: true, Name: main},
+			}
+			proposal := hosting.Proposal{Number: 1, Target: main, Title: "title", CanMergeWithAPI: false}
+			have := validate.ChooseParent(candidates, &proposal, main)
+			assert.Equal(t, main, have.Candidate.Name)
+			assert.Equal(t, parent, have.Nearest)
+			assert.Equal(t, validate.ParentConfidenceLow, have.Confidence)
+		})
+		t.Run("no candidates", func(t *testing.T) {
+			t.Parallel()
+			have := validate.ChooseParent([]validate.ParentCandidate{}, nil, main)
+			assert.Equal(t, main, have.Candidate.Name)
+			assert.Equal(t, validate.ParentConfidenceLow, have.Confidence)
+		})
+	})
+}
@@ -9,7 +9,8 @@ import (
 )
 
 // IsConfigured verifies that the given Git repo contains necessary Git Town configuration.
-func IsConfigured(backend *git.BackendCommands, branches domain.Branches) (domain.BranchTypes, error) {
+// With reparentOrphans, it makes branches whose parent branch no longer exists children of their closest existing ancestor.
+func IsConfigured(backend *git.BackendCommands, branches domain.Branches, reparentOrphans bool) (domain.BranchTypes, error) {
 	mainBranch := backend.Config.MainBranch()
 	if mainBranch.IsEmpty() {
 		fmt.Print("Git Town needs to be configured\n\n")
@@ -20,5 +21,5 @@ func IsConfigured(backend *git.BackendCommands, branches domain.Branches) (domai
 		branches.Types.MainBranch = newMainBranch
 		return dialog.EnterPerennialBranches(backend, branches)
 	}
-	return branches.Types, backend.RemoveOutdatedConfiguration(branches.All)
+	return branches.Types, backend.RemoveOutdatedConfiguration(branches.All, reparentOrphans)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/git-town/git-town/v9/src/dialog"
 	"github.com/git-town/git-town/v9/src/domain"
 	"github.com/git-town/git-town/v9/src/git"
+	"github.com/git-town/git-town/v9/src/slice"
 )
 
 // KnowsBranchesAncestors asserts that the entire lineage for all given branches
@@ -49,6 +50,7 @@ func KnowsBranchAncestors(branch domain.LocalBranchName, args KnowsBranchAncesto
 		return false, nil
 	}
 	updated := false
+	visited := domain.LocalBranchNames{}
 	for {
 		// TODO: reload the lineage at the end of the loop
 		parent, hasParent := args.Backend.Config.Lineage()[currentBranch] // need to reload the lineage here because ancestry data was changed
@@ -58,7 +60,11 @@ func KnowsBranchAncestors(branch domain.LocalBranchName, args KnowsBranchAncesto
 				printParentBranchHeader(args.MainBranch)
 				headerShown = true
 			}
-			parent, err = dialog.EnterParent(currentBranch, args.DefaultBranch, args.Lineage, args.AllBranches)
+			parent, err = suggestedParent(currentBranch, args)
+			if err != nil {
+				return false, err
+			}
+			parent, err = dialog.EnterParent(currentBranch, parent, args.Lineage, args.AllBranches)
 			if err != nil {
 				return false, err
 			}
@@ -76,7 +82,9 @@ func KnowsBranchAncestors(branch domain.LocalBranchName, args KnowsBranchAncesto
 			}
 			updated = true
 		}
-		if !args.BranchTypes.IsFeatureBranch(parent) {
+		visited = append(visited, currentBranch)
+		if !args.BranchTypes.IsFeatureBranch(parent) || slice.Contains(visited, parent) {
+			// "git town doctor" repairs cycles in the lineage
 			break
 		}
 		currentBranch = parent
@@ -93,6 +101,26 @@ type KnowsBranchAncestorsArgs struct {
 	MainBranch    domain.LocalBranchName
 }
 
+// suggestedParent provides the parent branch that the prompt for the parent of the given branch preselects.
+// Unless the caller provides a default branch other than the main branch,
+// this is the parent inferred from the commit history of local branches.
+func suggestedParent(branch domain.LocalBranchName, args KnowsBranchAncestorsArgs) (domain.LocalBranchName, error) {
+	if args.DefaultBranch != args.MainBranch || args.AllBranches.FindLocalBranch(branch) == nil {
+		return args.DefaultBranch, nil
+	}
+	inference, err := InferParent(branch, InferParentArgs{
+		AllBranches: args.AllBranches,
+		Backend:     args.Backend,
+		BranchTypes: args.BranchTypes,
+		Connector:   nil,
+		Lineage:     args.Backend.Config.Lineage(), // contains the parents entered so far
+	})
+	if err != nil || inference.Confidence == ParentConfidenceLow {
+		return args.DefaultBranch, err
+	}
+	return inference.Candidate.Name, nil
+}
+
 func printParentBranchHeader(mainBranch domain.LocalBranchName) {
 	cli.Printf(parentBranchHeaderTemplate, mainBranch)
 }
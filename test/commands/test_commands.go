@@ -148,6 +148,19 @@ func (r *TestCommands) CommitsInBranch(branch domain.LocalBranchName, fields []s
 }
 
 // CommitStagedChanges commits the currently staged changes.
+// CommitMessage provides the full message of the most recent commit in the given branch that has the given subject.
+func (r *TestCommands) CommitMessage(branch domain.LocalBranchName, subject string) string {
+	output := r.MustQuery("git", "log", branch.String(), "--format=%h %s")
+	for _, line := range strings.Split(output, "\n") {
+		sha, message, found := strings.Cut(line, " ")
+		if found && message == subject {
+			return r.MustQuery("git", "log", "-1", "--format=%B", sha)
+		}
+	}
+	log.Fatalf("branch %q has no commit with message %q", branch, subject)
+	return ""
+}
+
 func (r *TestCommands) CommitStagedChanges(message string) {
 	r.MustRun("git", "commit", "-m", message)
 }
@@ -316,6 +329,23 @@ func (r *TestCommands) SHAForCommit(name string) string {
 	return strings.Split(output, "\n")[0]
 }
 
+// SHAsByCommitMessage provides the SHAs of the commits reachable from the refs in this repo, by commit message.
+// If several commits have the same message, it provides the SHA of the most recent one.
+func (r *TestCommands) SHAsByCommitMessage() map[string]string {
+	result := map[string]string{}
+	output := r.MustQuery("git", "log", "--all", "--format=%h %s")
+	for _, line := range strings.Split(output, "\n") {
+		sha, message, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+		if _, has := result[message]; !has {
+			result[message] = sha
+		}
+	}
+	return result
+}
+
 // StageFiles adds the file with the given name to the Git index.
 func (r *TestCommands) StageFiles(names ...string) {
 	args := append([]string{"add"}, names...)
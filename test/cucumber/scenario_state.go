@@ -25,6 +25,12 @@ type ScenarioState struct {
 	// initialCommits describes the commits in this Git environment before the WHEN steps ran.
 	initialCommits *messages.PickleStepArgument_PickleTable
 
+	// initialCommitSHAs contains the SHAs of the local commits, by commit message, before the WHEN steps ran.
+	initialCommitSHAs map[string]string
+
+	// originSHAsBeforeRun contains the SHAs of the commits in the origin repo, by commit message, right before the last run of Git Town.
+	originSHAsBeforeRun map[string]string
+
 	// initialBranchHierarchy describes the branch hierarchy before the WHEN steps ran.
 	initialBranchHierarchy datatable.DataTable
 
@@ -53,6 +59,8 @@ func (state *ScenarioState) Reset(gitEnv fixture.Fixture) {
 	state.initialLocalBranches = domain.NewLocalBranchNames("main")
 	state.initialRemoteBranches = domain.NewLocalBranchNames("main")
 	state.initialCommits = nil
+	state.initialCommitSHAs = map[string]string{}
+	state.originSHAsBeforeRun = map[string]string{}
 	state.initialBranchHierarchy = datatable.DataTable{Cells: [][]string{{"BRANCH", "PARENT"}}}
 	state.initialCurrentBranch = domain.LocalBranchName{}
 	state.runOutput = ""
@@ -83,6 +91,15 @@ func (state *ScenarioState) InitialBranches() datatable.DataTable {
 	return result
 }
 
+// recordOriginSHAs remembers the SHAs that the commits in the origin repo have right before Git Town runs.
+func (state *ScenarioState) recordOriginSHAs() {
+	if state.fixture.OriginRepo == nil {
+		state.originSHAsBeforeRun = map[string]string{}
+		return
+	}
+	state.originSHAsBeforeRun = state.fixture.OriginRepo.SHAsByCommitMessage()
+}
+
 // compareExistingCommits compares the commits in the Git environment of the given ScenarioState
 // against the given Gherkin table.
 func (state *ScenarioState) compareTable(table *messages.PickleStepArgument_PickleTable) error {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -120,6 +121,117 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is a prototype branch$`, func(branch string) error {
+		return state.fixture.DevRepo.Config.SetLocalConfigValue(config.NewPrototypeKey(domain.NewLocalBranchName(branch)), "true")
+	})
+
+	suite.Step(`^branch "([^"]+)" is (?:now|still) a prototype branch$`, func(branch string) error {
+		state.fixture.DevRepo.Config.Reload()
+		if !state.fixture.DevRepo.Config.BranchTypes().IsPrototypeBranch(domain.NewLocalBranchName(branch)) {
+			return fmt.Errorf("expected branch %q to be a prototype branch", branch)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" is no longer a prototype branch$`, func(branch string) error {
+		state.fixture.DevRepo.Config.Reload()
+		if state.fixture.DevRepo.Config.BranchTypes().IsPrototypeBranch(domain.NewLocalBranchName(branch)) {
+			return fmt.Errorf("expected branch %q to no longer be a prototype branch", branch)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" ships with the "([^"]+)" strategy$`, func(branch, value string) error {
+		return state.fixture.DevRepo.Config.SetLocalConfigValue(config.NewShipStrategyKey(domain.NewLocalBranchName(branch)), value)
+	})
+
+	suite.Step(`^branch "([^"]+)" (?:now|still) ships with the "([^"]+)" strategy$`, func(branch, want string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewShipStrategyKey(domain.NewLocalBranchName(branch)))
+		if have != want {
+			return fmt.Errorf("expected branch %q to ship with the %q strategy, but it ships with %q", branch, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" no longer has its own ship strategy$`, func(branch string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewShipStrategyKey(domain.NewLocalBranchName(branch)))
+		if have != "" {
+			return fmt.Errorf("expected branch %q to no longer have its own ship strategy, but it ships with %q", branch, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^shipping into branch "([^"]+)" (deletes|keeps) the remote branch$`, func(branch, value string) error {
+		return state.fixture.DevRepo.Config.SetShipDeleteRemoteBranch(domain.NewLocalBranchName(branch), value == "deletes")
+	})
+
+	suite.Step(`^shipping into branch "([^"]+)" now (deletes|keeps) the remote branch$`, func(branch, value string) error {
+		state.fixture.DevRepo.Config.Reload()
+		want := strconv.FormatBool(value == "deletes")
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewShipDeleteRemoteBranchKey(domain.NewLocalBranchName(branch)))
+		if have != want {
+			return fmt.Errorf("expected shipping into branch %q to have setting %q for deleting the remote branch, but it has %q", branch, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^shipping into branch "([^"]+)" no longer has its own setting for deleting the remote branch$`, func(branch string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewShipDeleteRemoteBranchKey(domain.NewLocalBranchName(branch)))
+		if have != "" {
+			return fmt.Errorf("expected shipping into branch %q to no longer have its own setting for deleting the remote branch, but it has %q", branch, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" uses the "(merge|rebase)" sync strategy$`, func(branch, value string) error {
+		return state.fixture.DevRepo.Config.SetLocalConfigValue(config.NewSyncStrategyKey(domain.NewLocalBranchName(branch)), value)
+	})
+
+	suite.Step(`^branch "([^"]+)" (?:now|still) uses the "(merge|rebase)" sync strategy$`, func(branch, want string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewSyncStrategyKey(domain.NewLocalBranchName(branch)))
+		if have != want {
+			return fmt.Errorf("expected branch %q to use the %q sync strategy, but it uses %q", branch, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" no longer has its own sync strategy$`, func(branch string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewSyncStrategyKey(domain.NewLocalBranchName(branch)))
+		if have != "" {
+			return fmt.Errorf("expected branch %q to no longer have its own sync strategy, but it uses %q", branch, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" was last synced onto commit "([^"]+)"$`, func(branch, sha string) error {
+		return state.fixture.DevRepo.Config.SetLocalConfigValue(config.NewParentSHAKey(domain.NewLocalBranchName(branch)), sha)
+	})
+
+	suite.Step(`^branch "([^"]+)" was now last synced onto the "([^"]+)" commit$`, func(branch, commit string) error {
+		state.fixture.DevRepo.Config.Reload()
+		have := state.fixture.DevRepo.Config.LocalConfigValue(config.NewParentSHAKey(domain.NewLocalBranchName(branch)))
+		want := state.fixture.DevRepo.SHAForCommit(commit)
+		if have != want {
+			return fmt.Errorf("expected branch %q to be last synced onto commit %q, but it was synced onto %q", branch, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^an observed branch "([^"]+)"$`, func(branchText string) error {
+		branch := domain.NewLocalBranchName(branchText)
+		state.fixture.DevRepo.CreateBranch(branch, domain.NewLocalBranchName("main"))
+		asserts.NoError(state.fixture.DevRepo.Config.AddToObservedBranches(branch))
+		state.initialLocalBranches = append(state.initialLocalBranches, branch)
+		state.initialRemoteBranches = append(state.initialRemoteBranches, branch)
+		state.fixture.DevRepo.PushBranchToRemote(branch, domain.OriginRemote)
+		return nil
+	})
+
 	suite.Step(`^a perennial branch "([^"]+)"$`, func(branchText string) error {
 		branch := domain.NewLocalBranchName(branchText)
 		state.fixture.DevRepo.CreatePerennialBranches(branch)
@@ -210,6 +322,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^Git Town believes the parent of "([^"]*)" is "([^"]*)"$`, func(branch, parent string) error {
+		return state.fixture.DevRepo.Config.SetParent(domain.NewLocalBranchName(branch), domain.NewLocalBranchName(parent))
+	})
+
+	suite.Step(`^Git Town does not know the parent of "([^"]*)"$`, func(branch string) error {
+		return state.fixture.DevRepo.Config.RemoveParent(domain.NewLocalBranchName(branch))
+	})
+
 	suite.Step(`^Git Town is no longer configured$`, func() error {
 		if state.fixture.DevRepo.HasGitTownConfigNow() {
 			return fmt.Errorf("unexpected Git Town configuration")
@@ -259,12 +379,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 	})
 
 	suite.Step(`^I (?:run|ran) "(.+)"$`, func(command string) error {
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCode(command)
 		state.fixture.DevRepo.Config.Reload()
 		return nil
 	})
 
 	suite.Step(`^I (?:run|ran) "([^"]+)" and answer(?:ed)? the prompts:$`, func(cmd string, input *messages.PickleStepArgument_PickleTable) error {
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Input: helpers.TableToInput(input)})
 		state.fixture.DevRepo.Config.Reload()
 		return nil
@@ -272,6 +394,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^I run "([^"]*)" and close the editor$`, func(cmd string) error {
 		env := append(os.Environ(), "GIT_EDITOR=true")
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Env: env})
 		state.fixture.DevRepo.Config.Reload()
 		return nil
@@ -279,6 +402,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^I run "([^"]*)" and enter an empty commit message$`, func(cmd string) error {
 		state.fixture.DevRepo.MockCommitMessage("")
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCode(cmd)
 		state.fixture.DevRepo.Config.Reload()
 		return nil
@@ -286,6 +410,19 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^I run "([^"]*)" and enter "([^"]*)" for the commit message$`, func(cmd, message string) error {
 		state.fixture.DevRepo.MockCommitMessage(message)
+		state.recordOriginSHAs()
+		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCode(cmd)
+		state.fixture.DevRepo.Config.Reload()
+		return nil
+	})
+
+	suite.Step(`^I run "([^"]*)" and enter these commit messages:$`, func(cmd string, input *messages.PickleStepArgument_PickleTable) error {
+		commitMessages := []string{}
+		for _, row := range input.Rows[1:] {
+			commitMessages = append(commitMessages, row.Cells[0].Value)
+		}
+		state.fixture.DevRepo.MockCommitMessages(commitMessages)
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCode(cmd)
 		state.fixture.DevRepo.Config.Reload()
 		return nil
@@ -293,12 +430,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^I run "([^"]*)", answer the prompts, and close the next editor:$`, func(cmd string, input *messages.PickleStepArgument_PickleTable) error {
 		env := append(os.Environ(), "GIT_EDITOR=true")
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Env: env, Input: helpers.TableToInput(input)})
 		state.fixture.DevRepo.Config.Reload()
 		return nil
 	})
 
 	suite.Step(`^I run "([^"]+)" in the "([^"]+)" folder$`, func(cmd, folderName string) error {
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.DevRepo.MustQueryStringCodeWith(cmd, &subshell.Options{Dir: folderName})
 		state.fixture.DevRepo.Config.Reload()
 		return nil
@@ -392,6 +531,8 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		expanded := dataTable.Expand(
 			&state.fixture.DevRepo,
 			state.fixture.OriginRepo,
+			state.initialCommitSHAs,
+			state.originSHAsBeforeRun,
 		)
 		diff, errorCount := table.EqualDataTable(expanded)
 		if errorCount != 0 {
@@ -501,6 +642,10 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^origin rejects force-pushes$`, func() error {
+		return state.fixture.OriginRepo.Run("git", "config", "receive.denyNonFastForwards", "true")
+	})
+
 	suite.Step(`^Git setting "color.ui" is "([^"]*)"$`, func(value string) error {
 		return state.fixture.DevRepo.Config.SetColorUI(value)
 	})
@@ -510,6 +655,11 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return state.fixture.DevRepo.Config.SetLocalConfigValue(*configKey, value)
 	})
 
+	suite.Step(`^(?:local )?setting "([^"]*)" is:$`, func(name string, value *messages.PickleStepArgument_PickleDocString) error {
+		configKey := config.ParseKey("git-town." + name)
+		return state.fixture.DevRepo.Config.SetLocalConfigValue(*configKey, value.Content)
+	})
+
 	suite.Step(`^global setting "([^"]*)" is "([^"]*)"$`, func(name, value string) error {
 		configKey := config.ParseKey("git-town." + name)
 		err := state.fixture.DevRepo.Config.SetGlobalConfigValue(*configKey, value)
@@ -581,11 +731,24 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the commit "([^"]*)" in branch "([^"]*)" now has the message:$`, func(subject, branch string, expected *messages.PickleStepArgument_PickleDocString) error {
+		have := state.fixture.DevRepo.CommitMessage(domain.NewLocalBranchName(branch), subject)
+		if have != expected.Content {
+			return fmt.Errorf("mismatching commit message\n\nEXPECTED:\n%s\n\nACTUAL:\n%s", expected.Content, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^the commits$`, func(table *messages.PickleStepArgument_PickleTable) error {
 		state.initialCommits = table
 		// create the commits
 		commits := git.FromGherkinTable(table)
 		state.fixture.CreateCommits(commits)
+		for _, commit := range commits {
+			if slice.Contains(commit.Locations, "local") || slice.Contains(commit.Locations, "local, origin") {
+				state.initialCommitSHAs[commit.Message] = state.fixture.DevRepo.SHAForCommit(commit.Message)
+			}
+		}
 		// restore the initial branch
 		if state.initialCurrentBranch.IsEmpty() {
 			state.fixture.DevRepo.CheckoutBranch(domain.NewLocalBranchName("main")) // TODO: extract into a global constant variable (possibly in the test namespace)
@@ -599,6 +762,20 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the configuration file:$`, func(content *messages.PickleStepArgument_PickleDocString) error {
+		state.fixture.DevRepo.CreateFile(config.ConfigFileName, content.Content+"\n")
+		return nil
+	})
+
+	suite.Step(`^the configuration file is now:$`, func(expected *messages.PickleStepArgument_PickleDocString) error {
+		have := state.fixture.DevRepo.FileContent(config.ConfigFileName)
+		want := expected.Content + "\n"
+		if have != want {
+			return fmt.Errorf("configuration file does not match\n\nEXPECTED:\n%s\n\nACTUAL:\n%s", want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^the coworker fetches updates$`, func() error {
 		state.fixture.CoworkerRepo.Fetch()
 		return nil
@@ -610,10 +787,27 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 	})
 
 	suite.Step(`^the coworker runs "([^"]+)"$`, func(command string) error {
+		state.recordOriginSHAs()
 		state.runOutput, state.runExitCode = state.fixture.CoworkerRepo.MustQueryStringCode(command)
 		return nil
 	})
 
+	suite.Step(`^the coworker now has this branch lineage$`, func(input *messages.PickleStepArgument_PickleTable) error {
+		table := state.fixture.CoworkerRepo.BranchHierarchyTable()
+		diff, errCount := table.EqualGherkin(input)
+		if errCount > 0 {
+			fmt.Printf("\nERROR! Found %d differences in the branch hierarchy of the coworker\n\n", errCount)
+			fmt.Println(diff)
+			return fmt.Errorf("mismatching branches found, see the diff above")
+		}
+		return nil
+	})
+
+	suite.Step(`^the coworker's setting "([^"]*)" is "([^"]*)"$`, func(name, value string) error {
+		configKey := config.ParseKey("git-town." + name)
+		return state.fixture.CoworkerRepo.Config.SetLocalConfigValue(*configKey, value)
+	})
+
 	suite.Step(`^the coworker sets the parent branch of "([^"]*)" as "([^"]*)"$`, func(childBranch, parentBranch string) error {
 		_ = state.fixture.CoworkerRepo.Config.SetParent(domain.NewLocalBranchName(childBranch), domain.NewLocalBranchName(parentBranch))
 		return nil
@@ -822,6 +1016,18 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^perennial branch "([^"]+)" merges into "([^"]+)"$`, func(branch, downstream string) error {
+		return state.fixture.DevRepo.Config.SetDownstream(domain.NewLocalBranchName(branch), domain.NewLocalBranchNames(strings.Fields(downstream)...))
+	})
+
+	suite.Step(`^perennial branch "([^"]+)" now merges into "([^"]*)"$`, func(branch, want string) error {
+		have := state.fixture.DevRepo.Config.Downstream()[domain.NewLocalBranchName(branch)].Join(" ")
+		if have != want {
+			return fmt.Errorf("expected perennial branch %q to merge into %q, but it merges into %q", branch, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^the perennial branches are "([^"]+)"$`, func(name string) error {
 		return state.fixture.DevRepo.Config.AddToPerennialBranches(domain.NewLocalBranchName(name))
 	})
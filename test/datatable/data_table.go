@@ -73,7 +73,9 @@ func (table *DataTable) EqualGherkin(other *messages.PickleStepArgument_PickleTa
 }
 
 // Expand returns a new DataTable instance with the placeholders in this datatable replaced with the given values.
-func (table *DataTable) Expand(localRepo runner, remoteRepo runner) DataTable {
+// The initialSHAs contain the SHAs that the commits with the given messages had before Git Town ran.
+// The originSHAsBeforeRun contain the SHAs that the commits in the origin repo had right before the last run of Git Town.
+func (table *DataTable) Expand(localRepo runner, remoteRepo runner, initialSHAs map[string]string, originSHAsBeforeRun map[string]string) DataTable {
 	var templateRE *regexp.Regexp
 	var templateOnce sync.Once
 	result := DataTable{}
@@ -89,10 +91,24 @@ func (table *DataTable) Expand(localRepo runner, remoteRepo runner) DataTable {
 					commitName := match[8 : len(match)-4]
 					sha := localRepo.SHAForCommit(commitName)
 					cell = strings.Replace(cell, match, sha, 1)
+				case strings.HasPrefix(match, "{{ sha-in-origin-before-run "):
+					commitName := match[29 : len(match)-4]
+					sha, has := originSHAsBeforeRun[commitName]
+					if !has {
+						log.Fatalf("DataTable.Expand: unknown origin commit %q", commitName)
+					}
+					cell = strings.Replace(cell, match, sha, 1)
 				case strings.HasPrefix(match, "{{ sha-in-origin "):
 					commitName := match[18 : len(match)-4]
 					sha := remoteRepo.SHAForCommit(commitName)
 					cell = strings.Replace(cell, match, sha, 1)
+				case strings.HasPrefix(match, "{{ sha-initial "):
+					commitName := match[16 : len(match)-4]
+					sha, has := initialSHAs[commitName]
+					if !has {
+						log.Fatalf("DataTable.Expand: unknown initial commit %q", commitName)
+					}
+					cell = strings.Replace(cell, match, sha, 1)
 				default:
 					log.Fatalf("DataTable.Expand: unknown template expression %q", cell)
 				}
@@ -0,0 +1,64 @@
+package subshell
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cursorPositionQuery is the ANSI escape sequence that asks the terminal for the position of the cursor.
+const cursorPositionQuery = "\x1b[6n"
+
+// cursorPositionReport is the answer to cursorPositionQuery that terminalOutput sends.
+// It describes a terminal with 24 rows and 80 columns and the cursor in its bottom-right corner.
+const cursorPositionReport = "\x1b[24;80R"
+
+// terminalOutput collects the output of a subprocess
+// and answers the cursor position queries in it the way a terminal would.
+// Text prompts ask the terminal for the cursor position before reading the user input.
+type terminalOutput struct {
+	buffer   bytes.Buffer
+	answered int
+	mutex    sync.Mutex
+}
+
+// AnswerCursorQueries sends a cursor position report for each cursor position query
+// that the subprocess has sent since the last call.
+// Text prompts send the next query only after receiving the answer to the previous one,
+// so this waits a bit after each answer.
+func (to *terminalOutput) AnswerCursorQueries(input io.Writer) error {
+	for {
+		to.mutex.Lock()
+		queries := strings.Count(to.buffer.String(), cursorPositionQuery)
+		to.mutex.Unlock()
+		if queries == to.answered {
+			return nil
+		}
+		_, err := input.Write([]byte(cursorPositionReport))
+		if err != nil {
+			return err
+		}
+		to.answered++
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (to *terminalOutput) Bytes() []byte {
+	to.mutex.Lock()
+	defer to.mutex.Unlock()
+	return to.buffer.Bytes()
+}
+
+func (to *terminalOutput) String() string {
+	to.mutex.Lock()
+	defer to.mutex.Unlock()
+	return to.buffer.String()
+}
+
+func (to *terminalOutput) Write(data []byte) (int, error) {
+	to.mutex.Lock()
+	defer to.mutex.Unlock()
+	return to.buffer.Write(data)
+}
@@ -1,7 +1,6 @@
 package subshell
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"log"
@@ -90,6 +89,20 @@ func (r *TestRunner) MockCommitMessage(message string) {
 	r.createMockBinary(r.gitEditor, fmt.Sprintf("#!/usr/bin/env bash\n\necho %q > $1", message))
 }
 
+// MockCommitMessages sets up this runner with an editor that enters the given commit messages,
+// one after the other, each time Git opens it.
+func (r *TestRunner) MockCommitMessages(messages []string) {
+	r.gitEditor = "git_editor"
+	quoted := make([]string, len(messages))
+	for m, message := range messages {
+		quoted[m] = fmt.Sprintf("%q", message)
+	}
+	counterPath := filepath.Join(r.BinDir, "git_editor_count")
+	_ = os.Remove(counterPath)
+	content := fmt.Sprintf("#!/usr/bin/env bash\n\nmessages=(%s)\ncount=$(cat %q 2> /dev/null || echo 0)\necho \"${messages[$count]}\" > $1\necho $((count + 1)) > %q", strings.Join(quoted, " "), counterPath, counterPath)
+	r.createMockBinary(r.gitEditor, content)
+}
+
 // MockGit pretends that this repo has Git in the given version installed.
 func (r *TestRunner) MockGit(version string) {
 	if runtime.GOOS == "windows" {
@@ -234,7 +247,7 @@ func (r *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string) (s
 	if opts.Env != nil {
 		subProcess.Env = opts.Env
 	}
-	var output bytes.Buffer
+	var output terminalOutput
 	subProcess.Stdout = &output
 	subProcess.Stderr = &output
 	input, err := subProcess.StdinPipe()
@@ -247,7 +260,10 @@ func (r *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string) (s
 		// for not enough gains.
 		// https://github.com/git-town/go-execplus could help make this more robust.
 		time.Sleep(500 * time.Millisecond)
-		_, err := input.Write([]byte(userInput))
+		err := output.AnswerCursorQueries(input)
+		if err == nil {
+			_, err = input.Write([]byte(userInput))
+		}
 		if err != nil {
 			fmt.Printf("\nERROR: can't write %q to subprocess '%s %s': %v\n\n", userInput, cmd, strings.Join(args, " "), err)
 			fmt.Printf("OUTPUT: %s\n", output.String())
@@ -63,6 +63,7 @@ func New(workingDir, homeDir, binDir string) TestRuntime {
 	}
 	backendCommands := git.BackendCommands{
 		BackendRunner:      &runner,
+		BranchInfosCache:   &cache.BranchInfos{},
 		Config:             &config,
 		CurrentBranchCache: &cache.LocalBranch{},
 		RemoteBranchCache:  &cache.RemoteBranch{}, // TODO: remove this? Seems unused...